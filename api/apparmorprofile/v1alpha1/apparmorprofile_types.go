@@ -47,6 +47,41 @@ type AppArmorFsRules struct {
 	WriteOnlyPaths *[]string `json:"writeOnlyPaths,omitempty"`
 	// ReadWritePaths list of allowed read write file paths.
 	ReadWritePaths *[]string `json:"readWritePaths,omitempty"`
+	// FileRules list of file paths with fine-grained permissions.
+	FileRules []AppArmorFileRule `json:"fileRules,omitempty"`
+}
+
+// AppArmorFilePermission is a fine-grained permission for file access.
+// +kubebuilder:validation:Enum=read;write;append;lock;link;mmap;exec
+type AppArmorFilePermission string
+
+const (
+	// AppArmorFilePermissionRead allows reading the file.
+	AppArmorFilePermissionRead AppArmorFilePermission = "read"
+	// AppArmorFilePermissionWrite allows writing the file, which includes appending.
+	AppArmorFilePermissionWrite AppArmorFilePermission = "write"
+	// AppArmorFilePermissionAppend allows appending to the file.
+	AppArmorFilePermissionAppend AppArmorFilePermission = "append"
+	// AppArmorFilePermissionLock allows locking the file.
+	AppArmorFilePermissionLock AppArmorFilePermission = "lock"
+	// AppArmorFilePermissionLink allows creating hard links to the file.
+	AppArmorFilePermissionLink AppArmorFilePermission = "link"
+	// AppArmorFilePermissionMmap allows mapping the file as executable memory.
+	AppArmorFilePermissionMmap AppArmorFilePermission = "mmap"
+	// AppArmorFilePermissionExec allows executing the file within the same profile.
+	AppArmorFilePermissionExec AppArmorFilePermission = "exec"
+)
+
+// AppArmorFileRule stores the fine-grained permissions for a file path.
+type AppArmorFileRule struct {
+	// Path is the file path, which can contain AppArmor globbing.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Path string `json:"path"`
+	// Permissions list of allowed permissions for the path.
+	// +kubebuilder:validation:MinItems=1
+	Permissions []AppArmorFilePermission `json:"permissions"`
+	// Owner restricts the rule to files owned by the user of the process.
+	Owner bool `json:"owner,omitempty"`
 }
 
 // AppArmorAllowedProtocols stores the rules for allowed networking protocols.
@@ -68,9 +103,143 @@ type AppArmorNetworkRules struct {
 // AllowedCapabilities stores the rules of allowed Linux capabilities.
 type AppArmorCapabilityRules struct {
 	// AllowedCapabilities lost of allowed capabilities.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
 	AllowedCapabilities []string `json:"allowedCapabilities,omitempty"`
 }
 
+// AppArmorSignalRule stores a rule for sending or receiving signals.
+type AppArmorSignalRule struct {
+	// Access list of allowed access, all access is allowed if empty.
+	Access []AppArmorSignalAccess `json:"access,omitempty"`
+	// Signals list of allowed signals like hup, term or usr1, all signals
+	// are allowed if empty.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Signals []string `json:"signals,omitempty"`
+	// Peer is the AppArmor label of the other process, all peers are
+	// allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Peer string `json:"peer,omitempty"`
+}
+
+// AppArmorSignalAccess is the access of a signal rule.
+// +kubebuilder:validation:Enum=send;receive
+type AppArmorSignalAccess string
+
+// AppArmorPtraceRule stores a rule for tracing processes.
+type AppArmorPtraceRule struct {
+	// Access list of allowed access, all access is allowed if empty.
+	Access []AppArmorPtraceAccess `json:"access,omitempty"`
+	// Peer is the AppArmor label of the other process, all peers are
+	// allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Peer string `json:"peer,omitempty"`
+}
+
+// AppArmorPtraceAccess is the access of a ptrace rule.
+// +kubebuilder:validation:Enum=read;trace;readby;tracedby
+type AppArmorPtraceAccess string
+
+// AppArmorMountRules stores the rules for mount operations.
+type AppArmorMountRules struct {
+	// Mounts list of allowed mounts.
+	Mounts []AppArmorMountRule `json:"mounts,omitempty"`
+	// Umounts list of allowed mount points to unmount.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Umounts []string `json:"umounts,omitempty"`
+	// PivotRoots list of allowed new root directories for pivot_root.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	PivotRoots []string `json:"pivotRoots,omitempty"`
+}
+
+// AppArmorMountRule stores a rule for mounting file systems.
+type AppArmorMountRule struct {
+	// FsTypes list of allowed file system types, all types are allowed if empty.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	FsTypes []string `json:"fsTypes,omitempty"`
+	// Options list of allowed mount options, all options are allowed if empty.
+	// +kubebuilder:validation:items:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Options []string `json:"options,omitempty"`
+	// Source is the allowed mount source, all sources are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Source string `json:"source,omitempty"`
+	// MountPoint is the allowed mount point, all mount points are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	MountPoint string `json:"mountPoint,omitempty"`
+}
+
+// AppArmorUnixRule stores a rule for unix domain sockets.
+type AppArmorUnixRule struct {
+	// Access list of allowed access, all access is allowed if empty.
+	Access []AppArmorUnixAccess `json:"access,omitempty"`
+	// Type is the allowed socket type, all types are allowed if empty.
+	// +kubebuilder:validation:Enum=stream;dgram;seqpacket
+	Type string `json:"type,omitempty"`
+	// Address is the allowed socket address, for example @name for
+	// abstract sockets. All addresses are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Address string `json:"address,omitempty"`
+	// Peer is the AppArmor label of the other process, all peers are
+	// allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Peer string `json:"peer,omitempty"`
+	// PeerAddress is the socket address of the other process, all
+	// addresses are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	PeerAddress string `json:"peerAddress,omitempty"`
+}
+
+// AppArmorUnixAccess is the access of a unix socket rule.
+//
+// +kubebuilder:validation:Enum=create;bind;listen;accept;connect;shutdown;getattr;setattr;getopt;setopt;send;receive
+//
+//nolint:lll // required for kubebuilder
+type AppArmorUnixAccess string
+
+// AppArmorDBusRule stores a rule for D-Bus messages and services.
+type AppArmorDBusRule struct {
+	// Access list of allowed access, all access is allowed if empty.
+	Access []AppArmorDBusAccess `json:"access,omitempty"`
+	// Bus is the allowed bus like system or session, all buses are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Bus string `json:"bus,omitempty"`
+	// Name is the allowed well-known name to bind, all names are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Name string `json:"name,omitempty"`
+	// Path is the allowed object path, all paths are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Path string `json:"path,omitempty"`
+	// Interface is the allowed interface, all interfaces are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Interface string `json:"interface,omitempty"`
+	// Member is the allowed method or signal, all members are allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Member string `json:"member,omitempty"`
+	// PeerName is the well-known name of the other process, all names are
+	// allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	PeerName string `json:"peerName,omitempty"`
+	// Peer is the AppArmor label of the other process, all peers are
+	// allowed if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Peer string `json:"peer,omitempty"`
+}
+
+// AppArmorDBusAccess is the access of a D-Bus rule.
+// +kubebuilder:validation:Enum=send;receive;bind;eavesdrop
+type AppArmorDBusAccess string
+
+// AppArmorChangeProfileRule stores a rule for changing the profile.
+type AppArmorChangeProfileRule struct {
+	// Executable restricts the profile change to the execution of this
+	// path, the change is allowed for every execution if empty.
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Executable string `json:"executable,omitempty"`
+	// Target is the profile to change to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`
+	Target string `json:"target"`
+}
+
 // AppArmorAbstract AppArmor profile which stores various allowed list for
 // executable, file, network, capabilities access.
 type AppArmorAbstract struct {
@@ -82,6 +251,19 @@ type AppArmorAbstract struct {
 	Network *AppArmorNetworkRules `json:"network,omitempty"`
 	// Capability rules for Linux capabilities.
 	Capability *AppArmorCapabilityRules `json:"capability,omitempty"`
+	// Signal rules for sending and receiving signals.
+	Signal []AppArmorSignalRule `json:"signal,omitempty"`
+	// Ptrace rules for tracing processes.
+	Ptrace []AppArmorPtraceRule `json:"ptrace,omitempty"`
+	// Mount rules for mount, umount and pivot_root. The default profile
+	// denies all mounts if not set.
+	Mount *AppArmorMountRules `json:"mount,omitempty"`
+	// Unix rules for unix domain sockets.
+	Unix []AppArmorUnixRule `json:"unix,omitempty"`
+	// DBus rules for D-Bus messages and services.
+	DBus []AppArmorDBusRule `json:"dbus,omitempty"`
+	// ChangeProfile rules for changing to other profiles.
+	ChangeProfile []AppArmorChangeProfileRule `json:"changeProfile,omitempty"`
 }
 
 // AppArmorProfileSpec defines the desired state of AppArmorProfile.
@@ -101,6 +283,9 @@ type AppArmorProfileSpec struct {
 // AppArmorProfileStatus defines the observed state of AppArmorProfile.
 type AppArmorProfileStatus struct {
 	profilebasev1alpha1.StatusBase `json:",inline"`
+	// Revision is the revision of the profile spec, which can be used to
+	// roll back to it.
+	Revision int64 `json:"revision,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -109,6 +294,7 @@ type AppArmorProfileStatus struct {
 // +kubebuilder:resource:shortName=aa
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Revision",type=integer,priority=10,JSONPath=`.status.revision`
 type AppArmorProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
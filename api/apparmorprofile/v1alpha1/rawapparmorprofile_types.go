@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+)
+
+var (
+	// Ensure RawAppArmorProfile implements the StatusBaseUser and SecurityProfileBase interfaces.
+	_ profilebasev1alpha1.StatusBaseUser      = &RawAppArmorProfile{}
+	_ profilebasev1alpha1.SecurityProfileBase = &RawAppArmorProfile{}
+)
+
+// RawAppArmorProfileSpec defines the desired state of RawAppArmorProfile.
+type RawAppArmorProfileSpec struct {
+	// Common spec fields for all profiles.
+	profilebasev1alpha1.SpecBase `json:",inline"`
+
+	// Policy is the AppArmor profile in its raw syntax, which gets loaded
+	// verbatim. It must define exactly one profile named like the
+	// RawAppArmorProfile.
+	// +kubebuilder:validation:MinLength=1
+	Policy string `json:"policy"`
+}
+
+// +kubebuilder:object:root=true
+
+// RawAppArmorProfile is a cluster level specification for an AppArmor profile
+// in its raw syntax.
+// +kubebuilder:resource:shortName=raa
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+type RawAppArmorProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RawAppArmorProfileSpec `json:"spec,omitempty"`
+	Status AppArmorProfileStatus  `json:"status,omitempty"`
+}
+
+func (sp *RawAppArmorProfile) GetStatusBase() *profilebasev1alpha1.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *RawAppArmorProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *RawAppArmorProfile) SetImplementationStatus() {
+}
+
+func (sp *RawAppArmorProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebasev1alpha1.ListProfilesByRecording(ctx, cli, recording, sp.Namespace, &RawAppArmorProfileList{})
+}
+
+func (sp *RawAppArmorProfile) IsPartial() bool {
+	return profilebasev1alpha1.IsPartial(sp)
+}
+
+func (sp *RawAppArmorProfile) IsDisabled() bool {
+	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
+}
+
+func (sp *RawAppArmorProfile) IsReconcilable() bool {
+	return profilebasev1alpha1.IsReconcilable(sp)
+}
+
+// +kubebuilder:object:root=true
+
+// RawAppArmorProfileList contains a list of RawAppArmorProfile.
+type RawAppArmorProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RawAppArmorProfile `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&RawAppArmorProfile{}, &RawAppArmorProfileList{})
+}
+
+func (sp *RawAppArmorProfile) GetProfileName() string {
+	return sp.GetName()
+}
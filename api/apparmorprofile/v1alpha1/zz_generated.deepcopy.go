@@ -47,6 +47,44 @@ func (in *AppArmorAbstract) DeepCopyInto(out *AppArmorAbstract) {
 		*out = new(AppArmorCapabilityRules)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signal != nil {
+		in, out := &in.Signal, &out.Signal
+		*out = make([]AppArmorSignalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ptrace != nil {
+		in, out := &in.Ptrace, &out.Ptrace
+		*out = make([]AppArmorPtraceRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(AppArmorMountRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Unix != nil {
+		in, out := &in.Unix, &out.Unix
+		*out = make([]AppArmorUnixRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DBus != nil {
+		in, out := &in.DBus, &out.DBus
+		*out = make([]AppArmorDBusRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChangeProfile != nil {
+		in, out := &in.ChangeProfile, &out.ChangeProfile
+		*out = make([]AppArmorChangeProfileRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorAbstract.
@@ -104,6 +142,41 @@ func (in *AppArmorCapabilityRules) DeepCopy() *AppArmorCapabilityRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorChangeProfileRule) DeepCopyInto(out *AppArmorChangeProfileRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorChangeProfileRule.
+func (in *AppArmorChangeProfileRule) DeepCopy() *AppArmorChangeProfileRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorChangeProfileRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorDBusRule) DeepCopyInto(out *AppArmorDBusRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]AppArmorDBusAccess, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorDBusRule.
+func (in *AppArmorDBusRule) DeepCopy() *AppArmorDBusRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorDBusRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorExecutablesRules) DeepCopyInto(out *AppArmorExecutablesRules) {
 	*out = *in
@@ -137,6 +210,26 @@ func (in *AppArmorExecutablesRules) DeepCopy() *AppArmorExecutablesRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorFileRule) DeepCopyInto(out *AppArmorFileRule) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]AppArmorFilePermission, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorFileRule.
+func (in *AppArmorFileRule) DeepCopy() *AppArmorFileRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorFileRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorFsRules) DeepCopyInto(out *AppArmorFsRules) {
 	*out = *in
@@ -167,6 +260,13 @@ func (in *AppArmorFsRules) DeepCopyInto(out *AppArmorFsRules) {
 			copy(*out, *in)
 		}
 	}
+	if in.FileRules != nil {
+		in, out := &in.FileRules, &out.FileRules
+		*out = make([]AppArmorFileRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorFsRules.
@@ -179,6 +279,63 @@ func (in *AppArmorFsRules) DeepCopy() *AppArmorFsRules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorMountRule) DeepCopyInto(out *AppArmorMountRule) {
+	*out = *in
+	if in.FsTypes != nil {
+		in, out := &in.FsTypes, &out.FsTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorMountRule.
+func (in *AppArmorMountRule) DeepCopy() *AppArmorMountRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorMountRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorMountRules) DeepCopyInto(out *AppArmorMountRules) {
+	*out = *in
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]AppArmorMountRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Umounts != nil {
+		in, out := &in.Umounts, &out.Umounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PivotRoots != nil {
+		in, out := &in.PivotRoots, &out.PivotRoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorMountRules.
+func (in *AppArmorMountRules) DeepCopy() *AppArmorMountRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorMountRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorNetworkRules) DeepCopyInto(out *AppArmorNetworkRules) {
 	*out = *in
@@ -295,3 +452,143 @@ func (in *AppArmorProfileStatus) DeepCopy() *AppArmorProfileStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorPtraceRule) DeepCopyInto(out *AppArmorPtraceRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]AppArmorPtraceAccess, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorPtraceRule.
+func (in *AppArmorPtraceRule) DeepCopy() *AppArmorPtraceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorPtraceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorSignalRule) DeepCopyInto(out *AppArmorSignalRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]AppArmorSignalAccess, len(*in))
+		copy(*out, *in)
+	}
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorSignalRule.
+func (in *AppArmorSignalRule) DeepCopy() *AppArmorSignalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorSignalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorUnixRule) DeepCopyInto(out *AppArmorUnixRule) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]AppArmorUnixAccess, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorUnixRule.
+func (in *AppArmorUnixRule) DeepCopy() *AppArmorUnixRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorUnixRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RawAppArmorProfile) DeepCopyInto(out *RawAppArmorProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawAppArmorProfile.
+func (in *RawAppArmorProfile) DeepCopy() *RawAppArmorProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(RawAppArmorProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RawAppArmorProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RawAppArmorProfileList) DeepCopyInto(out *RawAppArmorProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RawAppArmorProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawAppArmorProfileList.
+func (in *RawAppArmorProfileList) DeepCopy() *RawAppArmorProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(RawAppArmorProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RawAppArmorProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RawAppArmorProfileSpec) DeepCopyInto(out *RawAppArmorProfileSpec) {
+	*out = *in
+	out.SpecBase = in.SpecBase
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawAppArmorProfileSpec.
+func (in *RawAppArmorProfileSpec) DeepCopy() *RawAppArmorProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RawAppArmorProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
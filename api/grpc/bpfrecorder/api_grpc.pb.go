@@ -1,21 +1,6 @@
-//
-//Copyright 2021 The Kubernetes Authors.
-//
-//Licensed under the Apache License, Version 2.0 (the "License");
-//you may not use this file except in compliance with the License.
-//You may obtain a copy of the License at
-//
-//http://www.apache.org/licenses/LICENSE-2.0
-//
-//Unless required by applicable law or agreed to in writing, software
-//distributed under the License is distributed on an "AS IS" BASIS,
-//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-//See the License for the specific language governing permissions and
-//limitations under the License.
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.4.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v5.27.0
 // source: api/grpc/bpfrecorder/api.proto
 
@@ -30,14 +15,15 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.62.0 or later.
-const _ = grpc.SupportPackageIsVersion8
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
 	BpfRecorder_Start_FullMethodName              = "/api_bpfrecorder.BpfRecorder/Start"
 	BpfRecorder_Stop_FullMethodName               = "/api_bpfrecorder.BpfRecorder/Stop"
 	BpfRecorder_SyscallsForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
 	BpfRecorder_ApparmorForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/ApparmorForProfile"
+	BpfRecorder_WatchProfile_FullMethodName       = "/api_bpfrecorder.BpfRecorder/WatchProfile"
 )
 
 // BpfRecorderClient is the client API for BpfRecorder service.
@@ -48,6 +34,7 @@ type BpfRecorderClient interface {
 	Stop(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	SyscallsForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
 	ApparmorForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ApparmorResponse, error)
+	WatchProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProgressResponse], error)
 }
 
 type bpfRecorderClient struct {
@@ -98,20 +85,43 @@ func (c *bpfRecorderClient) ApparmorForProfile(ctx context.Context, in *ProfileR
 	return out, nil
 }
 
+func (c *bpfRecorderClient) WatchProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProgressResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BpfRecorder_ServiceDesc.Streams[0], BpfRecorder_WatchProfile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProfileRequest, ProgressResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BpfRecorder_WatchProfileClient = grpc.ServerStreamingClient[ProgressResponse]
+
 // BpfRecorderServer is the server API for BpfRecorder service.
 // All implementations must embed UnimplementedBpfRecorderServer
-// for forward compatibility
+// for forward compatibility.
 type BpfRecorderServer interface {
 	Start(context.Context, *EmptyRequest) (*EmptyResponse, error)
 	Stop(context.Context, *EmptyRequest) (*EmptyResponse, error)
 	SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error)
 	ApparmorForProfile(context.Context, *ProfileRequest) (*ApparmorResponse, error)
+	WatchProfile(*ProfileRequest, grpc.ServerStreamingServer[ProgressResponse]) error
 	mustEmbedUnimplementedBpfRecorderServer()
 }
 
-// UnimplementedBpfRecorderServer must be embedded to have forward compatible implementations.
-type UnimplementedBpfRecorderServer struct {
-}
+// UnimplementedBpfRecorderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBpfRecorderServer struct{}
 
 func (UnimplementedBpfRecorderServer) Start(context.Context, *EmptyRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
@@ -125,7 +135,11 @@ func (UnimplementedBpfRecorderServer) SyscallsForProfile(context.Context, *Profi
 func (UnimplementedBpfRecorderServer) ApparmorForProfile(context.Context, *ProfileRequest) (*ApparmorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApparmorForProfile not implemented")
 }
+func (UnimplementedBpfRecorderServer) WatchProfile(*ProfileRequest, grpc.ServerStreamingServer[ProgressResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProfile not implemented")
+}
 func (UnimplementedBpfRecorderServer) mustEmbedUnimplementedBpfRecorderServer() {}
+func (UnimplementedBpfRecorderServer) testEmbeddedByValue()                     {}
 
 // UnsafeBpfRecorderServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BpfRecorderServer will
@@ -135,6 +149,13 @@ type UnsafeBpfRecorderServer interface {
 }
 
 func RegisterBpfRecorderServer(s grpc.ServiceRegistrar, srv BpfRecorderServer) {
+	// If the following call pancis, it indicates UnimplementedBpfRecorderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&BpfRecorder_ServiceDesc, srv)
 }
 
@@ -210,6 +231,17 @@ func _BpfRecorder_ApparmorForProfile_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BpfRecorder_WatchProfile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BpfRecorderServer).WatchProfile(m, &grpc.GenericServerStream[ProfileRequest, ProgressResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BpfRecorder_WatchProfileServer = grpc.ServerStreamingServer[ProgressResponse]
+
 // BpfRecorder_ServiceDesc is the grpc.ServiceDesc for BpfRecorder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +266,12 @@ var BpfRecorder_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BpfRecorder_ApparmorForProfile_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchProfile",
+			Handler:       _BpfRecorder_WatchProfile_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/grpc/bpfrecorder/api.proto",
 }
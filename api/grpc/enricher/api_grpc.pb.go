@@ -1,21 +1,6 @@
-//
-//Copyright 2021 The Kubernetes Authors.
-//
-//Licensed under the Apache License, Version 2.0 (the "License");
-//you may not use this file except in compliance with the License.
-//You may obtain a copy of the License at
-//
-//http://www.apache.org/licenses/LICENSE-2.0
-//
-//Unless required by applicable law or agreed to in writing, software
-//distributed under the License is distributed on an "AS IS" BASIS,
-//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-//See the License for the specific language governing permissions and
-//limitations under the License.
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.4.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             v5.27.0
 // source: api/grpc/enricher/api.proto
 
@@ -30,14 +15,16 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.62.0 or later.
-const _ = grpc.SupportPackageIsVersion8
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Enricher_Syscalls_FullMethodName      = "/api_enricher.Enricher/Syscalls"
 	Enricher_ResetSyscalls_FullMethodName = "/api_enricher.Enricher/ResetSyscalls"
 	Enricher_Avcs_FullMethodName          = "/api_enricher.Enricher/Avcs"
 	Enricher_ResetAvcs_FullMethodName     = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_Violations_FullMethodName    = "/api_enricher.Enricher/Violations"
+	Enricher_AckViolations_FullMethodName = "/api_enricher.Enricher/AckViolations"
 )
 
 // EnricherClient is the client API for Enricher service.
@@ -48,6 +35,8 @@ type EnricherClient interface {
 	ResetSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
 	ResetAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Violations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*ViolationsResponse, error)
+	AckViolations(ctx context.Context, in *AckViolationsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type enricherClient struct {
@@ -98,20 +87,45 @@ func (c *enricherClient) ResetAvcs(ctx context.Context, in *AvcRequest, opts ...
 	return out, nil
 }
 
+func (c *enricherClient) Violations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*ViolationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViolationsResponse)
+	err := c.cc.Invoke(ctx, Enricher_Violations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) AckViolations(ctx context.Context, in *AckViolationsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, Enricher_AckViolations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnricherServer is the server API for Enricher service.
 // All implementations must embed UnimplementedEnricherServer
-// for forward compatibility
+// for forward compatibility.
 type EnricherServer interface {
 	Syscalls(context.Context, *SyscallsRequest) (*SyscallsResponse, error)
 	ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error)
 	Avcs(context.Context, *AvcRequest) (*AvcResponse, error)
 	ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error)
+	Violations(context.Context, *ViolationsRequest) (*ViolationsResponse, error)
+	AckViolations(context.Context, *AckViolationsRequest) (*EmptyResponse, error)
 	mustEmbedUnimplementedEnricherServer()
 }
 
-// UnimplementedEnricherServer must be embedded to have forward compatible implementations.
-type UnimplementedEnricherServer struct {
-}
+// UnimplementedEnricherServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEnricherServer struct{}
 
 func (UnimplementedEnricherServer) Syscalls(context.Context, *SyscallsRequest) (*SyscallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Syscalls not implemented")
@@ -125,7 +139,14 @@ func (UnimplementedEnricherServer) Avcs(context.Context, *AvcRequest) (*AvcRespo
 func (UnimplementedEnricherServer) ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetAvcs not implemented")
 }
+func (UnimplementedEnricherServer) Violations(context.Context, *ViolationsRequest) (*ViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Violations not implemented")
+}
+func (UnimplementedEnricherServer) AckViolations(context.Context, *AckViolationsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckViolations not implemented")
+}
 func (UnimplementedEnricherServer) mustEmbedUnimplementedEnricherServer() {}
+func (UnimplementedEnricherServer) testEmbeddedByValue()                  {}
 
 // UnsafeEnricherServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EnricherServer will
@@ -135,6 +156,13 @@ type UnsafeEnricherServer interface {
 }
 
 func RegisterEnricherServer(s grpc.ServiceRegistrar, srv EnricherServer) {
+	// If the following call pancis, it indicates UnimplementedEnricherServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Enricher_ServiceDesc, srv)
 }
 
@@ -210,6 +238,42 @@ func _Enricher_ResetAvcs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_Violations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).Violations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_Violations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).Violations(ctx, req.(*ViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_AckViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).AckViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_AckViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).AckViolations(ctx, req.(*AckViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Enricher_ServiceDesc is the grpc.ServiceDesc for Enricher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +297,14 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetAvcs",
 			Handler:    _Enricher_ResetAvcs_Handler,
 		},
+		{
+			MethodName: "Violations",
+			Handler:    _Enricher_Violations_Handler,
+		},
+		{
+			MethodName: "AckViolations",
+			Handler:    _Enricher_AckViolations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/enricher/api.proto",
@@ -32,6 +32,19 @@ import (
 
 const ProfilePartialLabel = "spo.x-k8s.io/partial"
 
+const (
+	// ProfileRevisionOfLabel identifies the profile of a revision ConfigMap.
+	ProfileRevisionOfLabel = "spo.x-k8s.io/revision-of"
+	// ProfileRevisionLabel contains the revision of a revision ConfigMap.
+	ProfileRevisionLabel = "spo.x-k8s.io/revision"
+	// ProfileRevisionSpecKey is the key of the profile spec in a revision
+	// ConfigMap.
+	ProfileRevisionSpecKey = "spec.json"
+	// RollbackToRevisionAnnotation requests the operator to restore the spec
+	// of the profile from the given revision.
+	RollbackToRevisionAnnotation = "spo.x-k8s.io/rollback-to-revision"
+)
+
 type SecurityProfileBase interface {
 	client.Object
 
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterProfileBindingsAnnotation is set on every pod mutated by cluster
+// bindings and contains the comma separated names of the applied bindings.
+const ClusterProfileBindingsAnnotation = "spo.x-k8s.io/cluster-profile-bindings"
+
+// ClusterProfileBindingSpec defines the desired state of ClusterProfileBinding.
+//
+// The referenced profile is applied to the pod security context of all pods
+// in the selected namespaces, unless the pod or one of its containers already
+// uses a profile of the same kind. If several cluster bindings of the same
+// profile kind match a pod, bindings with a pod selector take precedence over
+// bindings without one, and the binding name decides on ties.
+type ClusterProfileBindingSpec struct {
+	// ProfileRef references the profile to bind to the selected pods.
+	ProfileRef ClusterProfileRef `json:"profileRef"`
+	// NamespaceSelector selects the namespaces of the pods to bind. All
+	// namespaces are selected if not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// PodSelector restricts the binding to pods matching the label selector.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// ClusterProfileRef contains information that points to the profile being used.
+type ClusterProfileRef struct {
+	// Kind of object to be bound.
+	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile;AppArmorProfile
+	Kind ProfileBindingKind `json:"kind"`
+	// Name of the profile to which to bind the selected pods.
+	Name string `json:"name"`
+	// Namespace of the profile.
+	Namespace string `json:"namespace"`
+}
+
+// ClusterProfileBindingStatus contains status of the ClusterProfileBinding.
+type ClusterProfileBindingStatus struct {
+	// BoundNamespaces are the namespaces in which pods got bound to the
+	// profile. Namespaces are not removed when their pods get deleted.
+	// +optional
+	// +listType=set
+	BoundNamespaces []string `json:"boundNamespaces,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBinding is the Schema for the clusterprofilebindings API.
+// +kubebuilder:resource:scope=Cluster,shortName=cpb
+// +kubebuilder:subresource:status
+type ClusterProfileBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterProfileBindingSpec   `json:"spec,omitempty"`
+	Status ClusterProfileBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBindingList contains a list of ClusterProfileBinding.
+type ClusterProfileBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProfileBinding `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to register the scheme
+	SchemeBuilder.Register(&ClusterProfileBinding{}, &ClusterProfileBindingList{})
+}
@@ -24,23 +24,42 @@ type ProfileBindingKind string
 
 const (
 	ProfileBindingKindSeccompProfile ProfileBindingKind = "SeccompProfile"
-	ProfileBindingKindSelinuxProfile ProfileBindingKind = "SelinuxProfile"
-	SelectAllContainersImage         string             = "*"
+	ProfileBindingKindSelinuxProfile  ProfileBindingKind = "SelinuxProfile"
+	ProfileBindingKindAppArmorProfile ProfileBindingKind = "AppArmorProfile"
+	SelectAllContainersImage          string             = "*"
 )
 
 // ProfileBindingSpec defines the desired state of ProfileBinding.
+//
+// If several bindings of the same profile kind match a container, the most
+// specific one wins: a binding selecting the container name takes precedence
+// over one matching the exact image, which takes precedence over one matching
+// the image repository, followed by image globs and bindings without image.
+// Bindings with a pod selector take precedence over bindings without one
+// otherwise, and the binding name decides on ties. The "*" image bindings only
+// apply if no other binding matched a container of the pod.
 type ProfileBindingSpec struct {
 	// ProfileRef references a SeccompProfile or other profile type in the current namespace.
 	ProfileRef ProfileRef `json:"profileRef"`
 	// Image name within pod containers to match to the profile.
+	// An image without tag or digest matches all tags and digests of the
+	// repository, and glob patterns like "quay.io/my-org/*" are supported.
 	// Use the "*" string to bind the profile to all pods.
-	Image string `json:"image"`
+	// +optional
+	Image string `json:"image,omitempty"`
+	// PodSelector restricts the binding to pods matching the label selector.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// ContainerNames restricts the binding to containers with one of the
+	// given names.
+	// +optional
+	ContainerNames []string `json:"containerNames,omitempty"`
 }
 
 // ProfileRef contains information that points to the profile being used.
 type ProfileRef struct {
 	// Kind of object to be bound.
-	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
+	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile;AppArmorProfile
 	Kind ProfileBindingKind `json:"kind"`
 	// Name of the profile within the current namespace to which to bind the selected pods.
 	Name string `json:"name"`
@@ -18,11 +18,15 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -48,6 +52,58 @@ const (
 	ProfileMergeContainers ProfileMergeStrategy = "containers"
 )
 
+type ProfileRecordingMode string
+
+const (
+	ProfileRecordingModeOneShot    ProfileRecordingMode = "OneShot"
+	ProfileRecordingModeContinuous ProfileRecordingMode = "Continuous"
+)
+
+type ProfileRecordingStopReason string
+
+const (
+	// ProfileRecordingStopReasonMaxDuration indicates that the recording
+	// reached its maximum duration.
+	ProfileRecordingStopReasonMaxDuration ProfileRecordingStopReason = "MaxDuration"
+	// ProfileRecordingStopReasonConverged indicates that the recorded profiles
+	// did not change for the convergence timeout.
+	ProfileRecordingStopReasonConverged ProfileRecordingStopReason = "Converged"
+	// ProfileRecordingStopReasonStopped indicates that the recording got
+	// stopped explicitly by the StopRecordingAnnotation.
+	ProfileRecordingStopReasonStopped ProfileRecordingStopReason = "Stopped"
+)
+
+// Condition types of a ProfileRecording.
+const (
+	// ConditionTypeRecording indicates whether workloads are being recorded.
+	ConditionTypeRecording spodv1alpha1.ConditionType = "Recording"
+	// ConditionTypeCollecting indicates whether recorded profiles are being
+	// collected.
+	ConditionTypeCollecting spodv1alpha1.ConditionType = "Collecting"
+	// ConditionTypeMerged indicates whether the partial profiles have been
+	// merged.
+	ConditionTypeMerged spodv1alpha1.ConditionType = "Merged"
+	// ConditionTypeFailed indicates whether the last collection or merge
+	// failed.
+	ConditionTypeFailed spodv1alpha1.ConditionType = "Failed"
+)
+
+// Reasons of the ProfileRecording conditions.
+const (
+	ReasonWorkloadsActive  spodv1alpha1.ConditionReason = "WorkloadsActive"
+	ReasonNoWorkloads      spodv1alpha1.ConditionReason = "NoActiveWorkloads"
+	ReasonFinished         spodv1alpha1.ConditionReason = "Finished"
+	ReasonCollecting       spodv1alpha1.ConditionReason = "Collecting"
+	ReasonCollected        spodv1alpha1.ConditionReason = "Collected"
+	ReasonCollectionFailed spodv1alpha1.ConditionReason = "CollectionFailed"
+	ReasonMerged           spodv1alpha1.ConditionReason = "Merged"
+	ReasonMergeFailed      spodv1alpha1.ConditionReason = "MergeFailed"
+)
+
+// DefaultSnapshotInterval is the interval in which the profiles of running
+// containers get updated in the Continuous recording mode.
+const DefaultSnapshotInterval = 5 * time.Minute
+
 const (
 	// ProfileToRecordingLabel is the name of the ProfileRecording CR that produced this profile.
 	ProfileToRecordingLabel = "spo.x-k8s.io/recording-id"
@@ -56,6 +112,9 @@ const (
 	// RecordingHasUnmergedProfiles is a finalizer that indicates that the recording has partial policies. Its
 	// main use is to hold off the deletion of the recording until all partial profiles are merged.
 	RecordingHasUnmergedProfiles = "spo.x-k8s.io/has-unmerged-profiles"
+	// StopRecordingAnnotation can be set on a ProfileRecording to finish the
+	// recording without deleting it.
+	StopRecordingAnnotation = "spo.x-k8s.io/stop-recording"
 )
 
 // ProfileRecordingSpec defines the desired state of ProfileRecording.
@@ -93,11 +152,213 @@ type ProfileRecordingSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	DisableProfileAfterRecording bool `json:"disableProfileAfterRecording,omitempty"`
+
+	// RecordSyscallArgs is a list of syscalls for which the argument values
+	// should be recorded. The resulting seccomp profile will only allow the
+	// selected syscalls for the observed argument values by using EQ or
+	// MASKED_EQ rules. This is only supported by the bpf recorder for
+	// seccomp profiles.
+	// +optional
+	// +kubebuilder:validation:items:Enum=clone;ioctl;personality;prctl;socket
+	RecordSyscallArgs []string `json:"recordSyscallArgs,omitempty"`
+
+	// Mode of the recording. In the OneShot mode, the profiles get created
+	// when the recorded containers exit. In the Continuous mode, the profiles
+	// of the still running containers are additionally updated every
+	// snapshotInterval, which allows to record long-running workloads.
+	// Merged profiles are kept current while the recording exists.
+	// Default is "OneShot".
+	// +optional
+	// +kubebuilder:default="OneShot"
+	// +kubebuilder:validation:Enum=OneShot;Continuous
+	Mode ProfileRecordingMode `json:"mode,omitempty"`
+
+	// SnapshotInterval is the interval in which the profiles of the running
+	// containers get updated in the Continuous mode. Defaults to 5m.
+	// +optional
+	SnapshotInterval *metav1.Duration `json:"snapshotInterval,omitempty"`
+
+	// MaxDuration is the maximum duration of the recording since its
+	// creation. Afterwards, the profiles of the running containers get
+	// collected and the recording is finished.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// ConvergenceTimeout finishes the recording when the recorded profiles
+	// did not change for the given duration. This is only supported in the
+	// Continuous mode, because the profiles do not change before the
+	// recorded containers exit otherwise.
+	// +optional
+	ConvergenceTimeout *metav1.Duration `json:"convergenceTimeout,omitempty"`
 }
 
 // ProfileRecordingStatus contains status of the ProfileRecording.
 type ProfileRecordingStatus struct {
+	spodv1alpha1.ConditionedStatus `json:",inline"`
+
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+
+	// Profiles contains the profiles collected by the recording. Only the
+	// 100 most recently collected profiles are kept.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Profiles []RecordedProfile `json:"profiles,omitempty"`
+
+	// LastError is the last error which occurred while collecting or merging
+	// the recorded profiles.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastProfileChange is the last time one of the recorded profiles
+	// changed. It is only tracked if a ConvergenceTimeout is set.
+	// +optional
+	LastProfileChange *metav1.Time `json:"lastProfileChange,omitempty"`
+
+	// FinishedAt is the time when the recording got finished by one of its
+	// stop conditions. Finished recordings do not record new workloads.
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// StopReason is the stop condition which finished the recording.
+	// +optional
+	StopReason ProfileRecordingStopReason `json:"stopReason,omitempty"`
+}
+
+// RecordedProfile is a profile collected by a ProfileRecording.
+type RecordedProfile struct {
+	// Name of the profile.
+	Name string `json:"name"`
+
+	// NodeName is the node on which the profile got recorded.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// Pod is the name of the recorded pod.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Container is the name of the recorded container.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Replica is the suffix of the recorded replica of a workload.
+	// +optional
+	Replica string `json:"replica,omitempty"`
+
+	// Syscalls is the number of recorded syscalls of a seccomp profile.
+	// +optional
+	Syscalls int32 `json:"syscalls,omitempty"`
+
+	// Paths is the number of recorded paths of an AppArmor profile.
+	// +optional
+	Paths int32 `json:"paths,omitempty"`
+
+	// Permissions is the number of recorded permissions of a SELinux profile.
+	// +optional
+	Permissions int32 `json:"permissions,omitempty"`
+
+	// LastCollected is the time when the profile got collected the last time.
+	LastCollected metav1.Time `json:"lastCollected"`
+}
+
+// MaxRecordedProfiles is the maximum number of recorded profiles kept in the
+// status, which would otherwise grow with every recorded replica.
+const MaxRecordedProfiles = 100
+
+// SetRecordedProfile adds the recorded profile to the status or replaces the
+// one with the same name. The least recently collected profile gets removed
+// if the status exceeds MaxRecordedProfiles.
+func (s *ProfileRecordingStatus) SetRecordedProfile(profile RecordedProfile) {
+	for i := range s.Profiles {
+		if s.Profiles[i].Name == profile.Name {
+			s.Profiles[i] = profile
+			return
+		}
+	}
+	s.Profiles = append(s.Profiles, profile)
+
+	for len(s.Profiles) > MaxRecordedProfiles {
+		oldest := 0
+		for i := range s.Profiles {
+			if s.Profiles[i].LastCollected.Before(&s.Profiles[oldest].LastCollected) {
+				oldest = i
+			}
+		}
+		s.Profiles = append(s.Profiles[:oldest], s.Profiles[oldest+1:]...)
+	}
+}
+
+// GetCondition returns the condition of the given type if it exists.
+func (s *ProfileRecordingStatus) GetCondition(t spodv1alpha1.ConditionType) (spodv1alpha1.Condition, bool) {
+	for _, c := range s.Conditions {
+		if c.Type == t {
+			return c, true
+		}
+	}
+	return spodv1alpha1.Condition{}, false
+}
+
+func condition(
+	t spodv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	reason spodv1alpha1.ConditionReason,
+	message string,
+) spodv1alpha1.Condition {
+	return spodv1alpha1.Condition{
+		Type:               t,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// RecordingCondition returns a condition that indicates whether workloads
+// are being recorded.
+func RecordingCondition(activeWorkloads int) spodv1alpha1.Condition {
+	if activeWorkloads == 0 {
+		return condition(ConditionTypeRecording, corev1.ConditionFalse, ReasonNoWorkloads, "")
+	}
+	return condition(ConditionTypeRecording, corev1.ConditionTrue, ReasonWorkloadsActive,
+		fmt.Sprintf("recording %d workloads", activeWorkloads))
+}
+
+// FinishedCondition returns a condition that indicates that a stop condition
+// finished the recording.
+func FinishedCondition(reason ProfileRecordingStopReason) spodv1alpha1.Condition {
+	return condition(ConditionTypeRecording, corev1.ConditionFalse, ReasonFinished, string(reason))
+}
+
+// CollectingCondition returns a condition that indicates that the profiles of
+// the pod are being collected.
+func CollectingCondition(pod string) spodv1alpha1.Condition {
+	return condition(ConditionTypeCollecting, corev1.ConditionTrue, ReasonCollecting, pod)
+}
+
+// CollectedCondition returns a condition that indicates that the profiles of
+// the pod got collected.
+func CollectedCondition(pod string) spodv1alpha1.Condition {
+	return condition(ConditionTypeCollecting, corev1.ConditionFalse, ReasonCollected, pod)
+}
+
+// MergedCondition returns a condition that indicates that the partial
+// profiles got merged into the given profiles.
+func MergedCondition(profiles []string) spodv1alpha1.Condition {
+	sorted := slices.Sorted(slices.Values(profiles))
+	return condition(ConditionTypeMerged, corev1.ConditionTrue, ReasonMerged, strings.Join(sorted, ", "))
+}
+
+// FailedCondition returns a condition that indicates that collecting or
+// merging the profiles failed.
+func FailedCondition(reason spodv1alpha1.ConditionReason, err error) spodv1alpha1.Condition {
+	return condition(ConditionTypeFailed, corev1.ConditionTrue, reason, err.Error())
+}
+
+// SucceededCondition returns a condition that indicates that the last
+// collection or merge of the profiles succeeded.
+func SucceededCondition(reason spodv1alpha1.ConditionReason) spodv1alpha1.Condition {
+	return condition(ConditionTypeFailed, corev1.ConditionFalse, reason, "")
 }
 
 // +kubebuilder:object:root=true
@@ -128,6 +389,27 @@ func (pr *ProfileRecording) CtrAnnotation(ctrName string) (key, value string, er
 	}
 }
 
+// IsContinuous returns true if the profiles of running containers should be
+// updated periodically.
+func (pr *ProfileRecording) IsContinuous() bool {
+	return pr.Spec.Mode == ProfileRecordingModeContinuous
+}
+
+// GetSnapshotInterval returns the interval in which the profiles of the
+// running containers get updated.
+func (pr *ProfileRecording) GetSnapshotInterval() time.Duration {
+	if pr.Spec.SnapshotInterval == nil || pr.Spec.SnapshotInterval.Duration <= 0 {
+		return DefaultSnapshotInterval
+	}
+	return pr.Spec.SnapshotInterval.Duration
+}
+
+// IsFinished returns true if one of the stop conditions finished the
+// recording.
+func (pr *ProfileRecording) IsFinished() bool {
+	return pr.Status.FinishedAt != nil
+}
+
 func (pr *ProfileRecording) IsKindSupported() bool {
 	switch pr.Spec.Kind {
 	case ProfileRecordingKindSelinuxProfile,
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -92,6 +93,26 @@ func (in *ProfileRecordingSpec) DeepCopyInto(out *ProfileRecordingSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecordSyscallArgs != nil {
+		in, out := &in.RecordSyscallArgs, &out.RecordSyscallArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotInterval != nil {
+		in, out := &in.SnapshotInterval, &out.SnapshotInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConvergenceTimeout != nil {
+		in, out := &in.ConvergenceTimeout, &out.ConvergenceTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingSpec.
@@ -107,11 +128,27 @@ func (in *ProfileRecordingSpec) DeepCopy() *ProfileRecordingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileRecordingStatus) DeepCopyInto(out *ProfileRecordingStatus) {
 	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	if in.ActiveWorkloads != nil {
 		in, out := &in.ActiveWorkloads, &out.ActiveWorkloads
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]RecordedProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastProfileChange != nil {
+		in, out := &in.LastProfileChange, &out.LastProfileChange
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingStatus.
@@ -123,3 +160,19 @@ func (in *ProfileRecordingStatus) DeepCopy() *ProfileRecordingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedProfile) DeepCopyInto(out *RecordedProfile) {
+	*out = *in
+	in.LastCollected.DeepCopyInto(&out.LastCollected)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedProfile.
+func (in *RecordedProfile) DeepCopy() *RecordedProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedProfile)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"cmp"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Common labels of the violation report objects.
+const (
+	// ReportToWorkloadLabel identifies the workload report a partial per-node
+	// report gets merged into.
+	ReportToWorkloadLabel = "spo.x-k8s.io/violation-report"
+	// ReportToNodeLabel identifies the node on which the violations of a
+	// partial report got observed.
+	ReportToNodeLabel = "spo.x-k8s.io/node-name"
+	// SuggestionForProfileLabel identifies the profile a partial profile
+	// suggested from the observed violations applies to.
+	SuggestionForProfileLabel = "spo.x-k8s.io/suggestion-for"
+)
+
+// MaxViolationsPerContainer is the maximum number of distinct violations
+// of each kind stored per container. The violations with the lowest count
+// get evicted first, where the least recently seen ones are evicted on ties.
+const MaxViolationsPerContainer = 256
+
+// WorkloadReference identifies the workload which caused the violations.
+type WorkloadReference struct {
+	// Kind of the workload, for example Deployment, DaemonSet or Pod.
+	Kind string `json:"kind"`
+	// Name of the workload.
+	Name string `json:"name"`
+}
+
+// ViolationCount counts how often a violation got observed.
+type ViolationCount struct {
+	// Count is the number of times the violation got observed.
+	Count int64 `json:"count"`
+	// FirstSeen is the time when the violation got observed first.
+	FirstSeen metav1.Time `json:"firstSeen"`
+	// LastSeen is the time when the violation got observed last.
+	LastSeen metav1.Time `json:"lastSeen"`
+}
+
+// SyscallViolation is a system call denied by seccomp.
+type SyscallViolation struct {
+	// Name of the system call.
+	Name string `json:"name"`
+	// Action is the seccomp action which got applied, for example
+	// SCMP_ACT_ERRNO or SCMP_ACT_KILL_PROCESS.
+	Action string `json:"action"`
+
+	ViolationCount `json:",inline"`
+}
+
+// AVCViolation is a SELinux access vector cache denial.
+type AVCViolation struct {
+	// Perm is the denied permission.
+	Perm string `json:"perm"`
+	// Scontext is the source context of the access.
+	Scontext string `json:"scontext"`
+	// Tcontext is the target context of the access.
+	Tcontext string `json:"tcontext"`
+	// Tclass is the target class of the access.
+	Tclass string `json:"tclass"`
+
+	ViolationCount `json:",inline"`
+}
+
+// AppArmorViolation is an operation denied by AppArmor.
+type AppArmorViolation struct {
+	// Profile is the AppArmor profile which denied the operation.
+	Profile string `json:"profile"`
+	// Operation is the denied operation, for example open or exec.
+	Operation string `json:"operation"`
+	// Name is what the operation wanted to access.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// DeniedMask is the denied access mask, for example r or wc.
+	// +optional
+	DeniedMask string `json:"deniedMask,omitempty"`
+
+	ViolationCount `json:",inline"`
+}
+
+// ContainerViolations contains the violations of a single container.
+type ContainerViolations struct {
+	// Name of the container.
+	Name string `json:"name"`
+	// SeccompProfile is the seccomp profile the container is running with.
+	// +optional
+	SeccompProfile string `json:"seccompProfile,omitempty"`
+	// Syscalls are the system calls denied by seccomp.
+	// +optional
+	// +listType=atomic
+	Syscalls []SyscallViolation `json:"syscalls,omitempty"`
+	// AVCs are the SELinux denials.
+	// +optional
+	// +listType=atomic
+	AVCs []AVCViolation `json:"avcs,omitempty"`
+	// AppArmor are the operations denied by AppArmor.
+	// +optional
+	// +listType=atomic
+	AppArmor []AppArmorViolation `json:"apparmor,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProfileViolationReport summarizes the security profile violations of a
+// workload, which got observed by the log enricher. Every node reports its
+// observations as partial report, which the operator merges into a single
+// report per workload.
+// +kubebuilder:resource:shortName=pvr
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.workload.kind`
+// +kubebuilder:printcolumn:name="Workload",type=string,JSONPath=`.workload.name`
+// +kubebuilder:printcolumn:name="Violations",type=integer,JSONPath=`.totalCount`
+// +kubebuilder:printcolumn:name="Last Seen",type=date,JSONPath=`.lastSeen`
+// +kubebuilder:printcolumn:name="Node",type=string,priority=10,JSONPath=`.nodeName`
+type ProfileViolationReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Workload is the workload which caused the violations.
+	Workload WorkloadReference `json:"workload"`
+	// NodeName is only set for partial reports and contains the node on
+	// which the violations got observed.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// TotalCount is the sum of all violation counts.
+	TotalCount int64 `json:"totalCount"`
+	// LastSeen is the time when the last violation got observed.
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+	// Containers contains the violations per container.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Containers []ContainerViolations `json:"containers,omitempty"`
+	// MergedPartials contains the UIDs of the partial reports which got
+	// merged into the workload report but may not be deleted yet. It
+	// prevents merging a partial report twice.
+	// +optional
+	// +listType=set
+	MergedPartials []types.UID `json:"mergedPartials,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProfileViolationReportList contains a list of ProfileViolationReport.
+type ProfileViolationReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProfileViolationReport `json:"items"`
+}
+
+// Merge adds the violations of another report to the report, where the
+// counts of equal violations get summed up. Every container keeps at most
+// MaxViolationsPerContainer violations of each kind.
+func (r *ProfileViolationReport) Merge(other *ProfileViolationReport) {
+	for i := range other.Containers {
+		src := &other.Containers[i]
+		dst := r.container(src.Name)
+		if src.SeccompProfile != "" {
+			dst.SeccompProfile = src.SeccompProfile
+		}
+		dst.Syscalls = mergeViolations(dst.Syscalls, src.Syscalls,
+			func(v *SyscallViolation) *ViolationCount { return &v.ViolationCount },
+			func(a, b SyscallViolation) bool { return a.Name == b.Name && a.Action == b.Action },
+			func(a, b SyscallViolation) int {
+				return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Action, b.Action))
+			},
+		)
+		dst.AVCs = mergeViolations(dst.AVCs, src.AVCs,
+			func(v *AVCViolation) *ViolationCount { return &v.ViolationCount },
+			func(a, b AVCViolation) bool {
+				return a.Perm == b.Perm && a.Scontext == b.Scontext &&
+					a.Tcontext == b.Tcontext && a.Tclass == b.Tclass
+			},
+			func(a, b AVCViolation) int {
+				return cmp.Or(
+					cmp.Compare(a.Tclass, b.Tclass), cmp.Compare(a.Perm, b.Perm),
+					cmp.Compare(a.Scontext, b.Scontext), cmp.Compare(a.Tcontext, b.Tcontext),
+				)
+			},
+		)
+		dst.AppArmor = mergeViolations(dst.AppArmor, src.AppArmor,
+			func(v *AppArmorViolation) *ViolationCount { return &v.ViolationCount },
+			func(a, b AppArmorViolation) bool {
+				return a.Profile == b.Profile && a.Operation == b.Operation &&
+					a.Name == b.Name && a.DeniedMask == b.DeniedMask
+			},
+			func(a, b AppArmorViolation) int {
+				return cmp.Or(
+					cmp.Compare(a.Profile, b.Profile), cmp.Compare(a.Operation, b.Operation),
+					cmp.Compare(a.Name, b.Name), cmp.Compare(a.DeniedMask, b.DeniedMask),
+				)
+			},
+		)
+	}
+
+	slices.SortFunc(r.Containers, func(a, b ContainerViolations) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	r.updateSummary()
+}
+
+// container returns the violations of the container with the provided name,
+// which get added if they do not exist yet.
+func (r *ProfileViolationReport) container(name string) *ContainerViolations {
+	for i := range r.Containers {
+		if r.Containers[i].Name == name {
+			return &r.Containers[i]
+		}
+	}
+	r.Containers = append(r.Containers, ContainerViolations{Name: name})
+	return &r.Containers[len(r.Containers)-1]
+}
+
+// updateSummary sets the total count and last seen time of the report.
+func (r *ProfileViolationReport) updateSummary() {
+	r.TotalCount = 0
+	r.LastSeen = nil
+
+	add := func(c *ViolationCount) {
+		r.TotalCount += c.Count
+		if r.LastSeen == nil || r.LastSeen.Before(&c.LastSeen) {
+			r.LastSeen = c.LastSeen.DeepCopy()
+		}
+	}
+	for i := range r.Containers {
+		c := &r.Containers[i]
+		for j := range c.Syscalls {
+			add(&c.Syscalls[j].ViolationCount)
+		}
+		for j := range c.AVCs {
+			add(&c.AVCs[j].ViolationCount)
+		}
+		for j := range c.AppArmor {
+			add(&c.AppArmor[j].ViolationCount)
+		}
+	}
+}
+
+// add sums up the other count and extends the seen time range.
+func (c *ViolationCount) add(other *ViolationCount) {
+	c.Count += other.Count
+	if other.FirstSeen.Before(&c.FirstSeen) {
+		c.FirstSeen = other.FirstSeen
+	}
+	if c.LastSeen.Before(&other.LastSeen) {
+		c.LastSeen = other.LastSeen
+	}
+}
+
+// mergeViolations adds the src violations to the dst ones and returns the
+// sorted result, which is limited to MaxViolationsPerContainer entries.
+func mergeViolations[T any](
+	dst, src []T,
+	count func(*T) *ViolationCount,
+	equal func(a, b T) bool,
+	compare func(a, b T) int,
+) []T {
+	for i := range src {
+		idx := slices.IndexFunc(dst, func(v T) bool { return equal(v, src[i]) })
+		if idx < 0 {
+			dst = append(dst, src[i])
+			continue
+		}
+		count(&dst[idx]).add(count(&src[i]))
+	}
+
+	if excess := len(dst) - MaxViolationsPerContainer; excess > 0 {
+		slices.SortFunc(dst, func(a, b T) int {
+			ca, cb := count(&a), count(&b)
+			return cmp.Or(cmp.Compare(ca.Count, cb.Count), ca.LastSeen.Compare(cb.LastSeen.Time))
+		})
+		dst = dst[excess:]
+	}
+	slices.SortFunc(dst, compare)
+	return dst
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&ProfileViolationReport{}, &ProfileViolationReportList{})
+}
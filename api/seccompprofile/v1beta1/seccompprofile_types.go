@@ -48,12 +48,22 @@ type SeccompProfileSpec struct {
 	// remote OCI artifacts as well when prefixed with `oci://`.
 	BaseProfileName string `json:"baseProfileName,omitempty"`
 
+	// ComplainMode installs the profile with all denying actions replaced by
+	// SCMP_ACT_LOG, while the profile itself stays unchanged. System calls
+	// which would have been denied are logged and reported by the log
+	// enricher instead. By default, the profile is enforced.
+	ComplainMode bool `json:"complainMode,omitempty"`
+
 	// Properties from containers/common/pkg/seccomp.Seccomp type
 
 	// the default action for seccomp
 	//nolint:lll // required for kubebuilder
 	// +kubebuilder:validation:Enum=SCMP_ACT_KILL;SCMP_ACT_KILL_PROCESS;SCMP_ACT_KILL_THREAD;SCMP_ACT_TRAP;SCMP_ACT_ERRNO;SCMP_ACT_TRACE;SCMP_ACT_ALLOW;SCMP_ACT_LOG;SCMP_ACT_NOTIFY
 	DefaultAction seccomp.Action `json:"defaultAction"`
+	// the errno return code to use for the default action. Some actions
+	// like SCMP_ACT_ERRNO and SCMP_ACT_TRACE allow to specify the errno code
+	// to return
+	DefaultErrnoRet *uint `json:"defaultErrnoRet,omitempty"`
 	// the architecture used for system calls
 	Architectures []Arch `json:"architectures,omitempty"`
 	// path of UNIX domain socket to contact a seccomp agent for SCMP_ACT_NOTIFY
@@ -123,6 +133,9 @@ type SeccompProfileStatus struct {
 	// The path that should be provided to the `securityContext.seccompProfile.localhostProfile`
 	// field of a Pod or container spec
 	LocalhostProfile string `json:"localhostProfile,omitempty"`
+	// Revision is the revision of the profile spec, which can be used to
+	// roll back to it.
+	Revision int64 `json:"revision,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -134,6 +147,7 @@ type SeccompProfileStatus struct {
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="LocalhostProfile",type=string,priority=10,JSONPath=`.status.localhostProfile`
+// +kubebuilder:printcolumn:name="Revision",type=integer,priority=10,JSONPath=`.status.revision`
 type SeccompProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
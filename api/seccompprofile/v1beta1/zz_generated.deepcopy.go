@@ -102,6 +102,11 @@ func (in *SeccompProfileList) DeepCopyObject() runtime.Object {
 func (in *SeccompProfileSpec) DeepCopyInto(out *SeccompProfileSpec) {
 	*out = *in
 	out.SpecBase = in.SpecBase
+	if in.DefaultErrnoRet != nil {
+		in, out := &in.DefaultErrnoRet, &out.DefaultErrnoRet
+		*out = new(uint)
+		**out = **in
+	}
 	if in.Architectures != nil {
 		in, out := &in.Architectures, &out.Architectures
 		*out = make([]Arch, len(*in))
@@ -56,6 +56,10 @@ const (
 	StatusKindLabel = "spo.x-k8s.io/profile-kind"
 )
 
+// ProfileInstalledNodeLabelPrefix is the prefix of the node labels which mark
+// the profiles installed on a node.
+const ProfileInstalledNodeLabelPrefix = "installed.spo.x-k8s.io/"
+
 // LowestState defines the "lowest" state for the profiles to be at.
 // All of the statuses would need to reach this for us to get here.
 const LowestState ProfileState = ProfileStateInstalled
@@ -86,6 +90,7 @@ func LowerOfTwoStates(currentLowest, candidate ProfileState) ProfileState {
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Node",type=string,priority=10,JSONPath=`.nodeName`
+// +kubebuilder:printcolumn:name="Generation",type=integer,priority=10,JSONPath=`.installedGeneration`
 type SecurityProfileNodeStatus struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -94,9 +99,22 @@ type SecurityProfileNodeStatus struct {
 
 	NodeName string       `json:"nodeName"`
 	Status   ProfileState `json:"status,omitempty"`
+	// InstalledGeneration is the generation of the profile installed on the
+	// node.
+	// +optional
+	InstalledGeneration int64 `json:"installedGeneration,omitempty"`
 }
 
-type SecurityProfileNodeStatusSpec struct{}
+type SecurityProfileNodeStatusSpec struct {
+	// RolloutGeneration is the generation of the profile which the node is
+	// allowed to install during a staged rollout.
+	// +optional
+	RolloutGeneration int64 `json:"rolloutGeneration,omitempty"`
+	// RolloutTime is the time when the node got allowed to install the
+	// RolloutGeneration.
+	// +optional
+	RolloutTime *metav1.Time `json:"rolloutTime,omitempty"`
+}
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -29,7 +29,7 @@ func (in *SecurityProfileNodeStatus) DeepCopyInto(out *SecurityProfileNodeStatus
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfileNodeStatus.
@@ -85,6 +85,10 @@ func (in *SecurityProfileNodeStatusList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityProfileNodeStatusSpec) DeepCopyInto(out *SecurityProfileNodeStatusSpec) {
 	*out = *in
+	if in.RolloutTime != nil {
+		in, out := &in.RolloutTime, &out.RolloutTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfileNodeStatusSpec.
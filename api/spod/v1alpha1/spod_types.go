@@ -249,6 +249,124 @@ type WebhookOptions struct {
 	// ObjectSelector sets webhook's object selector
 	// +optional
 	ObjectSelector *metav1.LabelSelector `json:"objectSelector,omitempty"`
+	// ValidationAction sets the action of the profile-validation.spo.io
+	// webhook for pods referencing profiles which are not ready to be used.
+	// Defaults to Deny.
+	// +optional
+	ValidationAction *ValidationAction `json:"validationAction,omitempty"`
+}
+
+// ValidationAction is the action of the profile validation webhook.
+// +kubebuilder:validation:Enum=Deny;Warn
+type ValidationAction string
+
+const (
+	// ValidationActionDeny rejects pods referencing profiles which are not
+	// ready to be used.
+	ValidationActionDeny ValidationAction = "Deny"
+
+	// ValidationActionWarn admits pods referencing profiles which are not
+	// ready to be used, but returns a warning to the client.
+	ValidationActionWarn ValidationAction = "Warn"
+)
+
+// LogEnricherSource is the source from which the log enricher reads the
+// audit lines.
+// +kubebuilder:validation:Enum=file;journald;netlink
+type LogEnricherSource string
+
+const (
+	// LogEnricherSourceFile tails the auditd log file or falls back to
+	// syslog if auditd is not available.
+	LogEnricherSourceFile LogEnricherSource = "file"
+
+	// LogEnricherSourceJournald follows the audit and kernel messages of the
+	// host journal.
+	LogEnricherSourceJournald LogEnricherSource = "journald"
+
+	// LogEnricherSourceNetlink reads the audit records directly from the
+	// kernel by using the NETLINK_AUDIT multicast group.
+	LogEnricherSourceNetlink LogEnricherSource = "netlink"
+)
+
+// LogEnricherExportOptions defines where the log enricher exports the
+// enriched audit events to, in addition to its own log output.
+type LogEnricherExportOptions struct {
+	// File writes the events as JSON lines into a rotating file on the host.
+	// +optional
+	File *LogEnricherFileExport `json:"file,omitempty"`
+	// Webhook sends the events in batches to a HTTP(S) endpoint.
+	// +optional
+	Webhook *LogEnricherWebhookExport `json:"webhook,omitempty"`
+}
+
+// LogEnricherFileExport defines the JSON lines file export of the log
+// enricher.
+type LogEnricherFileExport struct {
+	// Path is the absolute path of the file on the host. Its directory
+	// gets mounted into the log enricher and therefore cannot be the root
+	// directory.
+	// +kubebuilder:validation:Pattern=`^/[^/]+/(?:[^/]+/)*[^/]+$`
+	Path string `json:"path"`
+	// MaxSizeMB is the size in megabytes after which the file gets rotated.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	MaxSizeMB int32 `json:"maxSizeMB,omitempty"`
+	// MaxBackups is the number of rotated files to keep.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
+// LogEnricherWebhookExport defines the webhook export of the log enricher.
+// The events are sent as JSON array via POST requests.
+type LogEnricherWebhookExport struct {
+	// URL is the HTTP(S) endpoint receiving the events.
+	// +kubebuilder:validation:Pattern=`^https?://.+`
+	URL string `json:"url"`
+	// AuthorizationSecretRef references a key of a secret in the operator
+	// namespace, which contains the value of the Authorization header.
+	// +optional
+	AuthorizationSecretRef *corev1.SecretKeySelector `json:"authorizationSecretRef,omitempty"`
+	// BatchSize is the maximum number of events per request.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=1
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// FlushInterval is the interval after which pending events get sent,
+	// even if the batch is not full.
+	// +optional
+	// +kubebuilder:default="5s"
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+	// MaxRetries is the number of retries of a failed request, before the
+	// events get dropped.
+	// +optional
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// ProfileRolloutStrategy defines the staged rollout of changed
+// SeccompProfiles and AppArmorProfiles across the nodes.
+type ProfileRolloutStrategy struct {
+	// MaxUnavailable is the number of nodes which install a changed profile
+	// at the same time.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty"`
+	// CanaryNodeSelector selects the nodes which install a changed profile
+	// before all other nodes.
+	// +optional
+	CanaryNodeSelector *metav1.LabelSelector `json:"canaryNodeSelector,omitempty"`
+	// ObservationPeriod is the time to wait for violations after a batch of
+	// nodes got allowed to install a changed profile, before the next batch
+	// is allowed.
+	// +optional
+	// +kubebuilder:default="5m"
+	ObservationPeriod *metav1.Duration `json:"observationPeriod,omitempty"`
 }
 
 // SPODStatus defines the desired state of SPOD.
@@ -263,6 +381,25 @@ type SPODSpec struct {
 	// This will make the controller loading in the cache memory only the pods
 	// labelled explicitly for profile recording with 'spo.x-k8s.io/enable-recording=true'.
 	EnableMemoryOptimization bool `json:"enableMemoryOptimization,omitempty"`
+	// EnableNodeAffinity labels the nodes with the profiles installed on them
+	// and lets the binding webhook require these labels in the node affinity
+	// of the pods it binds profiles to. This way, the pods are only scheduled
+	// onto nodes where their profiles are installed.
+	// +optional
+	EnableNodeAffinity bool `json:"enableNodeAffinity,omitempty"`
+	// ProfileRollout enables the staged rollout of changed SeccompProfiles
+	// and AppArmorProfiles. If set, nodes which have a previous version of a
+	// profile installed only install the changed profile once the operator
+	// allows them to.
+	// +optional
+	ProfileRollout *ProfileRolloutStrategy `json:"profileRollout,omitempty"`
+	// ProfileRevisionHistoryLimit is the number of revisions of each
+	// SeccompProfile and AppArmorProfile which are kept for rollbacks.
+	// Setting it to 0 disables the revision history.
+	// +optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	ProfileRevisionHistoryLimit *int32 `json:"profileRevisionHistoryLimit,omitempty"`
 	// tells the operator whether or not to enable SELinux support for this
 	// SPOD instance.
 	EnableSelinux *bool `json:"enableSelinux,omitempty"`
@@ -273,9 +410,24 @@ type SPODSpec struct {
 	// tells the operator whether or not to enable log enrichment support for this
 	// SPOD instance.
 	EnableLogEnricher bool `json:"enableLogEnricher,omitempty"`
+	// LogEnricherSource selects where the log enricher reads the audit
+	// lines from. Defaults to "file" if not set.
+	// +optional
+	LogEnricherSource LogEnricherSource `json:"logEnricherSource,omitempty"`
+	// LogEnricherExport configures additional destinations for the enriched
+	// audit events of the log enricher, for example to feed a SIEM.
+	// +optional
+	LogEnricherExport *LogEnricherExportOptions `json:"logEnricherExport,omitempty"`
 	// tells the operator whether or not to enable bpf recorder support for this
 	// SPOD instance.
 	EnableBpfRecorder bool `json:"enableBpfRecorder,omitempty"`
+	// BpfRecorderSyscallArgs enables the recording of the argument values of
+	// the listed syscalls by the bpf recorder, which can be selected by
+	// ProfileRecordings via recordSyscallArgs. Argument values are not
+	// recorded if not set.
+	// +optional
+	// +kubebuilder:validation:items:Enum=clone;ioctl;personality;prctl;socket
+	BpfRecorderSyscallArgs []string `json:"bpfRecorderSyscallArgs,omitempty"`
 	// tells the operator whether or not to enable AppArmor support for this
 	// SPOD instance.
 	EnableAppArmor bool `json:"enableAppArmor,omitempty"`
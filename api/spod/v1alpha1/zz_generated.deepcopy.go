@@ -66,14 +66,134 @@ func (in *ConditionedStatus) DeepCopy() *ConditionedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEnricherExportOptions) DeepCopyInto(out *LogEnricherExportOptions) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(LogEnricherFileExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(LogEnricherWebhookExport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEnricherExportOptions.
+func (in *LogEnricherExportOptions) DeepCopy() *LogEnricherExportOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEnricherExportOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEnricherFileExport) DeepCopyInto(out *LogEnricherFileExport) {
+	*out = *in
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEnricherFileExport.
+func (in *LogEnricherFileExport) DeepCopy() *LogEnricherFileExport {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEnricherFileExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEnricherWebhookExport) DeepCopyInto(out *LogEnricherWebhookExport) {
+	*out = *in
+	if in.AuthorizationSecretRef != nil {
+		in, out := &in.AuthorizationSecretRef, &out.AuthorizationSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEnricherWebhookExport.
+func (in *LogEnricherWebhookExport) DeepCopy() *LogEnricherWebhookExport {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEnricherWebhookExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileRolloutStrategy) DeepCopyInto(out *ProfileRolloutStrategy) {
+	*out = *in
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObservationPeriod != nil {
+		in, out := &in.ObservationPeriod, &out.ObservationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRolloutStrategy.
+func (in *ProfileRolloutStrategy) DeepCopy() *ProfileRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 	*out = *in
+	if in.ProfileRollout != nil {
+		in, out := &in.ProfileRollout, &out.ProfileRollout
+		*out = new(ProfileRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileRevisionHistoryLimit != nil {
+		in, out := &in.ProfileRevisionHistoryLimit, &out.ProfileRevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.EnableSelinux != nil {
 		in, out := &in.EnableSelinux, &out.EnableSelinux
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LogEnricherExport != nil {
+		in, out := &in.LogEnricherExport, &out.LogEnricherExport
+		*out = new(LogEnricherExportOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BpfRecorderSyscallArgs != nil {
+		in, out := &in.BpfRecorderSyscallArgs, &out.BpfRecorderSyscallArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]corev1.Toleration, len(*in))
@@ -239,6 +359,11 @@ func (in *WebhookOptions) DeepCopyInto(out *WebhookOptions) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValidationAction != nil {
+		in, out := &in.ValidationAction, &out.ValidationAction
+		*out = new(ValidationAction)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookOptions.
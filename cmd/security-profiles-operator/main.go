@@ -30,11 +30,13 @@ import (
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/go-logr/logr"
 	configv1 "github.com/openshift/api/config/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/urfave/cli/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/textlogger"
@@ -59,20 +61,27 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/export"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/violationreporter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/nodelabeler"
 	nodestatus "sigs.k8s.io/security-profiles-operator/internal/pkg/manager/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/revision"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/rollout"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/violationreport"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/workloadannotator"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nonrootenabler"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/version"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/recording"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/validation"
 )
 
 const (
@@ -84,6 +93,7 @@ const (
 	webhookFlag        string = "webhook"
 	memOptimFlag       string = "with-mem-optim"
 	defaultWebhookPort int    = 9443
+	megabyte           int64  = 1024 * 1024
 )
 
 var (
@@ -195,12 +205,73 @@ func main() {
 			Action: func(ctx *cli.Context) error {
 				return runLogEnricher(ctx, info)
 			},
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "audit-source",
+					Aliases: []string{"s"},
+					Value:   string(spodv1alpha1.LogEnricherSourceFile),
+					Usage:   "the source of the audit lines (values: file, journald, netlink)",
+					EnvVars: []string{config.LogEnricherSourceEnvKey},
+				},
+				&cli.StringFlag{
+					Name:    "export-file",
+					Usage:   "the path of a JSON lines file to export the enriched events to",
+					EnvVars: []string{config.LogEnricherExportFileEnvKey},
+				},
+				&cli.IntFlag{
+					Name:    "export-file-max-size",
+					Value:   int(export.DefaultFileMaxSize / megabyte),
+					Usage:   "the size in megabytes after which the export file gets rotated",
+					EnvVars: []string{config.LogEnricherExportFileMaxSizeEnvKey},
+				},
+				&cli.IntFlag{
+					Name:    "export-file-max-backups",
+					Value:   export.DefaultFileMaxBackups,
+					Usage:   "the number of rotated export files to keep",
+					EnvVars: []string{config.LogEnricherExportFileMaxBackupsEnvKey},
+				},
+				&cli.StringFlag{
+					Name:    "export-webhook-url",
+					Usage:   "the HTTP(S) endpoint to export the enriched events to",
+					EnvVars: []string{config.LogEnricherExportWebhookURLEnvKey},
+				},
+				&cli.StringFlag{
+					Name:    "export-webhook-authorization",
+					Usage:   "the Authorization header value of the export webhook requests",
+					EnvVars: []string{config.LogEnricherExportWebhookAuthorizationEnvKey},
+				},
+				&cli.IntFlag{
+					Name:    "export-webhook-batch-size",
+					Value:   export.DefaultWebhookBatchSize,
+					Usage:   "the maximum number of events per export webhook request",
+					EnvVars: []string{config.LogEnricherExportWebhookBatchSizeEnvKey},
+				},
+				&cli.DurationFlag{
+					Name:    "export-webhook-flush-interval",
+					Value:   export.DefaultWebhookFlushInterval,
+					Usage:   "the interval after which pending events get sent to the export webhook",
+					EnvVars: []string{config.LogEnricherExportWebhookFlushIntervalEnvKey},
+				},
+				&cli.IntFlag{
+					Name:    "export-webhook-max-retries",
+					Value:   export.DefaultWebhookMaxRetries,
+					Usage:   "the number of retries of failed export webhook requests",
+					EnvVars: []string{config.LogEnricherExportWebhookMaxRetriesEnvKey},
+				},
+			},
 		},
 		&cli.Command{
 			Before:  initialize,
 			Name:    "bpf-recorder",
 			Aliases: []string{"b"},
 			Usage:   "run the bpf recorder",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:    "record-syscall-args",
+					Usage:   "the syscalls whose argument values get recorded",
+					EnvVars: []string{config.BpfRecorderSyscallArgsEnvKey},
+				},
+			},
 			Action: func(ctx *cli.Context) error {
 				return runBPFRecorder(ctx, info)
 			},
@@ -346,9 +417,16 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 		context.WithValue(ctx.Context, spod.ManageWebhookKey, manageWebhook(ctx)),
 		[]controller.Controller{
 			nodestatus.NewController(),
+			nodelabeler.NewController(),
+			rollout.NewSeccompController(),
+			rollout.NewAppArmorController(),
+			rollout.NewRawAppArmorController(),
+			revision.NewSeccompController(),
+			revision.NewAppArmorController(),
 			spod.NewController(),
 			workloadannotator.NewController(),
 			recordingmerger.NewController(),
+			violationreport.NewController(),
 		}, mgr, nil); err != nil {
 		return fmt.Errorf("enable controllers: %w", err)
 	}
@@ -404,7 +482,9 @@ func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	}
 
 	if ctx.Bool(recordingFlag) {
-		controllers = append(controllers, profilerecorder.NewController())
+		controllers = append(controllers,
+			profilerecorder.NewController(),
+			violationreporter.NewController())
 	}
 
 	if ctx.Bool(selinuxFlag) {
@@ -414,7 +494,9 @@ func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	}
 
 	if ctx.Bool(apparmorFlag) {
-		controllers = append(controllers, apparmorprofile.NewController())
+		controllers = append(controllers,
+			apparmorprofile.NewController(),
+			apparmorprofile.NewRawController())
 	}
 
 	return controllers
@@ -508,17 +590,65 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 	return nil
 }
 
-func runBPFRecorder(_ *cli.Context, info *version.Info) error {
+func runBPFRecorder(ctx *cli.Context, info *version.Info) error {
 	const component = "bpf-recorder"
 	printInfo(component, info)
-	return bpfrecorder.New("", ctrl.Log.WithName(component), true, true).Run()
+	recorder := bpfrecorder.New("", ctrl.Log.WithName(component), true, true)
+	if syscalls := ctx.StringSlice("record-syscall-args"); len(syscalls) > 0 {
+		if err := recorder.SetSyscallArgs(syscalls); err != nil {
+			return fmt.Errorf("set syscall args: %w", err)
+		}
+	}
+	return recorder.Run()
 }
 
-func runLogEnricher(_ *cli.Context, info *version.Info) error {
+func runLogEnricher(ctx *cli.Context, info *version.Info) error {
 	const component = "log-enricher"
 	printInfo(component, info)
 
-	return enricher.New(ctrl.Log.WithName(component)).Run()
+	logger := ctrl.Log.WithName(component)
+	sinks, err := logEnricherSinks(ctx, logger)
+	if err != nil {
+		return fmt.Errorf("create export sinks: %w", err)
+	}
+
+	source := spodv1alpha1.LogEnricherSource(ctx.String("audit-source"))
+	return enricher.New(logger, source, sinks).Run()
+}
+
+func logEnricherSinks(ctx *cli.Context, logger logr.Logger) ([]export.Sink, error) {
+	sinks := []export.Sink{}
+
+	if path := ctx.String("export-file"); path != "" {
+		sink, err := export.NewFileSink(
+			path,
+			int64(ctx.Int("export-file-max-size"))*megabyte,
+			ctx.Int("export-file-max-backups"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create file sink: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if url := ctx.String("export-webhook-url"); url != "" {
+		sink, err := export.NewWebhookSink(logger, &export.WebhookOptions{
+			URL:           url,
+			Authorization: ctx.String("export-webhook-authorization"),
+			BatchSize:     ctx.Int("export-webhook-batch-size"),
+			FlushInterval: ctx.Duration("export-webhook-flush-interval"),
+			MaxRetries:    ctx.Int("export-webhook-max-retries"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create webhook sink: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	for _, sink := range sinks {
+		logger.Info("Exporting events to " + sink.Name())
+	}
+	return sinks, nil
 }
 
 func runNonRootEnabler(ctx *cli.Context, info *version.Info) error {
@@ -586,11 +716,22 @@ func runWebhook(ctx *cli.Context, info *version.Info) error {
 	if err := profilerecording1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add profilerecording API to scheme: %w", err)
 	}
+	if err := secprofnodestatusv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add per-node Status API to scheme: %w", err)
+	}
+	if err := spodv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add SPOD config API to scheme: %w", err)
+	}
 
 	setupLog.Info("registering webhooks")
 	hookserver := mgr.GetWebhookServer()
-	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("create discovery client: %w", err)
+	}
+	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient(), dc)
 	recording.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetEventRecorderFor("recording-webhook"), mgr.GetClient())
+	validation.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
 
 	sigHandler := ctrl.SetupSignalHandler()
 	setupLog.Info("starting webhook")
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"runtime"
 	"strings"
 
 	"github.com/urfave/cli/v2"
@@ -27,11 +28,15 @@ import (
 	"sigs.k8s.io/security-profiles-operator/cmd"
 	spocli "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/converter"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/linter"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/merger"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/puller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/pusher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/recorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/rollback"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/runner"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 )
 
 func main() {
@@ -83,6 +88,12 @@ func main() {
 					Name:  recorder.FlagNoProcStart,
 					Usage: "do not start the target command and record until ctrl+c/SIGINT.",
 				},
+				&cli.StringSliceFlag{
+					Name: recorder.FlagRecordSyscallArgs,
+					Usage: "syscalls for which the argument values should be recorded " +
+						"and restricted in the resulting seccomp profile, supported are: " +
+						strings.Join(bpfrecorder.SupportedSyscallArgs(), ", "),
+				},
 				&cli.BoolFlag{
 					Name:  recorder.FlagPrivileged,
 					Usage: "do not drop sudo privileges when running the target command.",
@@ -116,7 +127,7 @@ func main() {
 		&cli.Command{
 			Name:      "convert",
 			Aliases:   []string{"c"},
-			Usage:     "convert a security profile to its raw format",
+			Usage:     "convert a security profile CRD to its raw format and back",
 			Action:    convert,
 			ArgsUsage: "PROFILE",
 			Flags: []cli.Flag{
@@ -132,6 +143,78 @@ func main() {
 					Aliases: []string{"p"},
 					Usage:   "AppArmor only: the path to the program that is confined.",
 				},
+				&cli.StringFlag{
+					Name:    converter.FlagFrom,
+					Aliases: []string{"f"},
+					Usage: fmt.Sprintf(
+						"the format of the input profile, either %q, or %q and %q for a raw profile file or directory",
+						converter.FromCRD, converter.FromSeccomp, converter.FromAppArmor,
+					),
+					DefaultText: converter.FromCRD,
+				},
+				&cli.StringFlag{
+					Name:    converter.FlagNamespace,
+					Aliases: []string{"n"},
+					Usage:   "raw profiles only: the namespace of the generated profile",
+				},
+				&cli.StringFlag{
+					Name:  converter.FlagName,
+					Usage: "raw profiles only: the name of the generated profile, defaults to the profile or input file name",
+				},
+				&cli.StringFlag{
+					Name:        converter.FlagArch,
+					Usage:       "seccomp only: the Go architecture used to resolve conditional rules",
+					DefaultText: runtime.GOARCH,
+				},
+				&cli.StringSliceFlag{
+					Name:  converter.FlagCapabilities,
+					Usage: "seccomp only: the capabilities used to resolve conditional rules",
+				},
+				&cli.StringFlag{
+					Name:  converter.FlagKernelVersion,
+					Usage: "seccomp only: the kernel version used to resolve conditional rules, minimum kernel versions are ignored if unset",
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "diff",
+			Aliases:   []string{"d"},
+			Usage:     "print the semantic difference between two security profiles",
+			Action:    diff,
+			ArgsUsage: "OLD NEW",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        differ.FlagFormat,
+					Aliases:     []string{"f"},
+					Usage:       fmt.Sprintf("the output format, either %q or %q", differ.FormatText, differ.FormatJSON),
+					DefaultText: differ.FormatText,
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "lint",
+			Aliases:   []string{"i"},
+			Usage:     "check security profiles for dangerous content",
+			Action:    lint,
+			ArgsUsage: "PROFILE...",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:      linter.FlagConfig,
+					Aliases:   []string{"c"},
+					Usage:     "the YAML file to configure the severities and values of the rules",
+					TakesFile: true,
+				},
+				&cli.StringFlag{
+					Name:        linter.FlagFailOn,
+					Usage:       "exit with an error for findings of this severity or higher, either info, warning or error",
+					DefaultText: string(linter.SeverityError),
+				},
+				&cli.StringFlag{
+					Name:        linter.FlagFormat,
+					Aliases:     []string{"f"},
+					Usage:       fmt.Sprintf("the output format, either %q or %q", linter.FormatText, linter.FormatJSON),
+					DefaultText: linter.FormatText,
+				},
 			},
 		},
 		&cli.Command{
@@ -227,6 +310,31 @@ func main() {
 				},
 			},
 		},
+		&cli.Command{
+			Name:      "rollback",
+			Aliases:   []string{"b"},
+			Usage:     "roll back a profile in the cluster to a previous revision",
+			Action:    rollbackProfile,
+			ArgsUsage: "KIND NAME",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        rollback.FlagNamespace,
+					Aliases:     []string{"n"},
+					Usage:       "the namespace of the profile",
+					DefaultText: rollback.DefaultNamespace,
+				},
+				&cli.Int64Flag{
+					Name:    rollback.FlagRevision,
+					Aliases: []string{"r"},
+					Usage:   "the revision to roll back to, defaults to the previous revision",
+				},
+				&cli.BoolFlag{
+					Name:    rollback.FlagList,
+					Aliases: []string{"l"},
+					Usage:   "list the available revisions instead of rolling back",
+				},
+			},
+		},
 	)
 
 	if err := app.Run(os.Args); err != nil {
@@ -276,6 +384,34 @@ func convert(ctx *cli.Context) error {
 	return nil
 }
 
+// diff runs the `spoc diff` subcommand.
+func diff(ctx *cli.Context) error {
+	options, err := differ.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := differ.New(options).Run(); err != nil {
+		return fmt.Errorf("run differ: %w", err)
+	}
+
+	return nil
+}
+
+// lint runs the `spoc lint` subcommand.
+func lint(ctx *cli.Context) error {
+	options, err := linter.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := linter.New(options).Run(); err != nil {
+		return fmt.Errorf("run linter: %w", err)
+	}
+
+	return nil
+}
+
 // run runs the `spoc run` subcommand.
 func run(ctx *cli.Context) error {
 	options, err := runner.FromContext(ctx)
@@ -317,3 +453,17 @@ func pull(ctx *cli.Context) error {
 
 	return nil
 }
+
+// rollbackProfile runs the `spoc rollback` subcommand.
+func rollbackProfile(ctx *cli.Context) error {
+	options, err := rollback.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := rollback.New(options).Run(); err != nil {
+		return fmt.Errorf("run rollback: %w", err)
+	}
+
+	return nil
+}
@@ -25,4 +25,37 @@ const (
 	// FlagOutputFile is the flag for defining the output file location.
 	FlagOutputFile  string = cli.FlagOutputFile
 	FlagProgramName string = "program-name"
+
+	// FlagFrom is the flag for defining the format of the input profile.
+	FlagFrom string = "from"
+
+	// FlagNamespace is the flag for defining the namespace of the generated CRD.
+	FlagNamespace string = "namespace"
+
+	// FlagName is the flag for defining the name of the generated CRD.
+	FlagName string = "name"
+
+	// FlagArch is the flag for defining the architecture used to resolve
+	// conditional seccomp rules.
+	FlagArch string = "arch"
+
+	// FlagCapabilities is the flag for defining the capabilities used to
+	// resolve conditional seccomp rules.
+	FlagCapabilities string = "capabilities"
+
+	// FlagKernelVersion is the flag for defining the kernel version used to
+	// resolve conditional seccomp rules.
+	FlagKernelVersion string = "kernel-version"
+)
+
+const (
+	// FromCRD converts a profile CRD into its raw format.
+	FromCRD string = "crd"
+
+	// FromSeccomp converts a raw OCI or Docker seccomp profile into a
+	// SeccompProfile CRD.
+	FromSeccomp string = "seccomp"
+
+	// FromAppArmor converts a raw AppArmor profile into AppArmorProfile CRDs.
+	FromAppArmor string = "apparmor"
 )
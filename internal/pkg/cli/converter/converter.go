@@ -17,13 +17,23 @@ limitations under the License.
 package converter
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/armor2crd"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
 )
 
@@ -41,8 +51,14 @@ func New(options *Options) *Converter {
 	}
 }
 
+const filePermissions = 0o600
+
 // Run the Converter.
 func (p *Converter) Run() error {
+	if p.options.from != FromCRD {
+		return p.runFromRaw()
+	}
+
 	log.Printf("Converting %s to raw profile", p.options.inputFile)
 
 	content, err := p.ReadFile(p.options.inputFile)
@@ -84,7 +100,6 @@ func (p *Converter) Run() error {
 		return fmt.Errorf("cannot convert %T to raw profile", obj)
 	}
 
-	const filePermissions = 0o600
 	if err := p.WriteFile(p.options.outputFile, out, filePermissions); err != nil {
 		return fmt.Errorf("failed to write output file: %w", err)
 	}
@@ -92,3 +107,209 @@ func (p *Converter) Run() error {
 	log.Printf("Successfully wrote raw profile to %s.", p.options.outputFile)
 	return nil
 }
+
+// runFromRaw converts raw profiles into profile CRDs. If the input is a
+// directory, then all profiles within it get converted.
+func (p *Converter) runFromRaw() error {
+	info, err := p.Stat(p.options.inputFile)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", p.options.inputFile, err)
+	}
+
+	inputFiles := []string{p.options.inputFile}
+	if info.IsDir() {
+		if p.options.name != "" {
+			return fmt.Errorf("flag --%s cannot be used for a directory", FlagName)
+		}
+
+		entries, err := p.ReadDir(p.options.inputFile)
+		if err != nil {
+			return fmt.Errorf("read directory %s: %w", p.options.inputFile, err)
+		}
+
+		inputFiles = nil
+		for _, entry := range entries {
+			if entry.IsDir() || !p.isRawProfile(entry.Name()) {
+				continue
+			}
+			inputFiles = append(inputFiles, filepath.Join(p.options.inputFile, entry.Name()))
+		}
+		if len(inputFiles) == 0 {
+			return fmt.Errorf("no profiles found in %s", p.options.inputFile)
+		}
+	}
+
+	// Write one file per profile if the output is a directory, otherwise
+	// write all profiles as multiple YAML documents into the output file.
+	outputDir := ""
+	if info, err := p.Stat(p.options.outputFile); err == nil && info.IsDir() {
+		outputDir = p.options.outputFile
+	}
+
+	// Convert all profiles before writing any of them, because profiles with
+	// the same name would overwrite each other.
+	profiles := []client.Object{}
+	sources := map[string]string{}
+	for _, inputFile := range inputFiles {
+		converted, err := p.rawProfiles(inputFile)
+		if err != nil {
+			return err
+		}
+
+		for _, profile := range converted {
+			if source, ok := sources[profile.GetName()]; ok {
+				return fmt.Errorf(
+					"profile name %q of %s is already used by %s",
+					profile.GetName(), inputFile, source,
+				)
+			}
+			sources[profile.GetName()] = inputFile
+			profiles = append(profiles, profile)
+		}
+	}
+
+	printer := &printers.YAMLPrinter{}
+	out := &bytes.Buffer{}
+	for _, profile := range profiles {
+		if outputDir == "" {
+			if err := printer.PrintObj(profile, out); err != nil {
+				return fmt.Errorf("print YAML: %w", err)
+			}
+			continue
+		}
+
+		profileOut := &bytes.Buffer{}
+		if err := (&printers.YAMLPrinter{}).PrintObj(profile, profileOut); err != nil {
+			return fmt.Errorf("print YAML: %w", err)
+		}
+		outputFile := filepath.Join(outputDir, profile.GetName()+".yaml")
+		if err := p.WriteFile(outputFile, profileOut.Bytes(), filePermissions); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		log.Printf("Successfully wrote profile to %s.", outputFile)
+	}
+
+	if outputDir == "" {
+		if err := p.WriteFile(p.options.outputFile, out.Bytes(), filePermissions); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		log.Printf("Successfully wrote %d profile(s) to %s.", len(profiles), p.options.outputFile)
+	}
+
+	return nil
+}
+
+// isRawProfile returns true if the file within a directory should be
+// converted.
+func (p *Converter) isRawProfile(name string) bool {
+	if p.options.from == FromSeccomp {
+		return filepath.Ext(name) == ".json"
+	}
+	return !strings.HasPrefix(name, ".")
+}
+
+func (p *Converter) rawProfiles(inputFile string) ([]client.Object, error) {
+	content, err := p.ReadFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("read profile file %s: %w", inputFile, err)
+	}
+
+	if p.options.from == FromAppArmor {
+		log.Printf("Converting %s to AppArmorProfile", inputFile)
+		return p.appArmorProfiles(inputFile, content)
+	}
+
+	log.Printf("Converting %s to SeccompProfile", inputFile)
+	spec, err := seccompSpecFromRaw(content, p.options)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s: %w", inputFile, err)
+	}
+
+	name, err := p.profileName("", inputFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return []client.Object{&seccompprofileapi.SeccompProfile{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SeccompProfile",
+			APIVersion: seccompprofileapi.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: p.options.namespace,
+		},
+		Spec: *spec,
+	}}, nil
+}
+
+func (p *Converter) appArmorProfiles(inputFile string, content []byte) ([]client.Object, error) {
+	parsed, err := armor2crd.ParseProfiles(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("converting %s: %w", inputFile, err)
+	}
+	if p.options.name != "" && len(parsed) > 1 {
+		return nil, fmt.Errorf(
+			"flag --%s cannot be used for %s, which contains %d profiles",
+			FlagName, inputFile, len(parsed),
+		)
+	}
+
+	profiles := make([]client.Object, 0, len(parsed))
+	for _, profile := range parsed {
+		for _, rule := range profile.Unsupported {
+			log.Printf("Unsupported rule in profile %s of %s: %s", profile.Name, inputFile, rule)
+		}
+
+		name, err := p.profileName(profile.Name, inputFile)
+		if err != nil {
+			return nil, err
+		}
+
+		profiles = append(profiles, &apparmorprofileapi.AppArmorProfile{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "AppArmorProfile",
+				APIVersion: apparmorprofileapi.GroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: p.options.namespace,
+			},
+			Spec: apparmorprofileapi.AppArmorProfileSpec{
+				Abstract:     profile.Abstract,
+				ComplainMode: profile.ComplainMode,
+			},
+		})
+	}
+
+	return profiles, nil
+}
+
+// profileName returns the name of the profile CRD, which is either set via
+// the options, the name within the raw profile or the input file name.
+func (p *Converter) profileName(rawName, inputFile string) (string, error) {
+	name := p.options.name
+	if name == "" {
+		name = sanitizeName(filepath.Base(rawName))
+	}
+	if name == "" {
+		name = profileNameFromFile(inputFile)
+	}
+	if name == "" {
+		return "", errors.New("cannot derive a profile name from " + inputFile)
+	}
+	return name, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// profileNameFromFile returns a valid resource name from the base name of
+// the file, for example docker_default.json results in docker-default.
+func profileNameFromFile(file string) string {
+	return sanitizeName(strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)))
+}
+
+func sanitizeName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-")
+}
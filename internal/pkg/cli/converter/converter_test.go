@@ -21,6 +21,8 @@ package converter
 
 import (
 	"errors"
+	"io/fs"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -160,3 +162,345 @@ spec:
 		})
 	}
 }
+
+type fakeFileInfo struct {
+	fs.FileInfo
+	name string
+	dir  bool
+}
+
+func (f fakeFileInfo) Name() string { return f.name }
+
+func (f fakeFileInfo) IsDir() bool { return f.dir }
+
+const dockerProfile = `{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "defaultErrnoRet": 1,
+  "archMap": [
+    {
+      "architecture": "SCMP_ARCH_X86_64",
+      "subArchitectures": ["SCMP_ARCH_X86", "SCMP_ARCH_X32"]
+    },
+    {
+      "architecture": "SCMP_ARCH_AARCH64",
+      "subArchitectures": ["SCMP_ARCH_ARM"]
+    }
+  ],
+  "syscalls": [
+    {
+      "names": ["read", "write"],
+      "action": "SCMP_ACT_ALLOW",
+      "args": [],
+      "comment": "",
+      "includes": {},
+      "excludes": {}
+    },
+    {
+      "names": ["arch_prctl"],
+      "action": "SCMP_ACT_ALLOW",
+      "includes": {"arches": ["amd64", "x32"]}
+    },
+    {
+      "names": ["mount"],
+      "action": "SCMP_ACT_ALLOW",
+      "includes": {"caps": ["CAP_SYS_ADMIN"]}
+    },
+    {
+      "names": ["clone"],
+      "action": "SCMP_ACT_ALLOW",
+      "args": [{"index": 0, "value": 2114060288, "op": "SCMP_CMP_MASKED_EQ"}],
+      "excludes": {"caps": ["CAP_SYS_ADMIN"]}
+    },
+    {
+      "names": ["ptrace"],
+      "action": "SCMP_ACT_ALLOW",
+      "includes": {"minKernel": "4.8"}
+    },
+    {
+      "name": "personality",
+      "action": "SCMP_ACT_ERRNO",
+      "errnoRet": 38
+    }
+  ]
+}`
+
+func TestRunFromSeccomp(t *testing.T) {
+	t.Parallel()
+
+	defaultOptions := func() *Options {
+		options := Default()
+		options.inputFile = "docker.json"
+		options.from = FromSeccomp
+		options.arch = "amd64"
+		return options
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*converterfakes.FakeImpl) *Options
+		assert  func(*converterfakes.FakeImpl, error)
+	}{
+		{
+			name: "docker profile with defaults",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte(dockerProfile), nil)
+				options := defaultOptions()
+				options.namespace = "team-a"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.WriteFileCallCount())
+				file, out, _ := mock.WriteFileArgsForCall(0)
+				require.Equal(t, DefaultOutputFile, file)
+				require.Contains(t, string(out), "kind: SeccompProfile")
+				require.Contains(t, string(out), "name: docker\n")
+				require.Contains(t, string(out), "namespace: team-a")
+				require.Contains(t, string(out), "defaultErrnoRet: 1\n")
+				require.Contains(t, string(out), "- SCMP_ARCH_X86_64\n  - SCMP_ARCH_X86\n  - SCMP_ARCH_X32")
+				require.NotContains(t, string(out), "SCMP_ARCH_AARCH64")
+				require.Contains(t, string(out), "- arch_prctl")
+				require.NotContains(t, string(out), "- mount")
+				require.Contains(t, string(out), "- clone")
+				require.Contains(t, string(out), "op: SCMP_CMP_MASKED_EQ")
+				require.Contains(t, string(out), "- ptrace")
+				require.Contains(t, string(out), "- personality")
+				require.Contains(t, string(out), "errnoRet: 38")
+			},
+		},
+		{
+			name: "docker profile with capabilities, arch and kernel",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte(dockerProfile), nil)
+				options := defaultOptions()
+				options.name = "custom"
+				options.arch = "arm64"
+				options.capabilities = []string{"CAP_SYS_ADMIN"}
+				options.kernelVersion = "4.4.0-210-generic"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, out, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(out), "name: custom")
+				require.Contains(t, string(out), "- SCMP_ARCH_AARCH64\n  - SCMP_ARCH_ARM")
+				require.NotContains(t, string(out), "arch_prctl")
+				require.Contains(t, string(out), "- mount")
+				require.NotContains(t, string(out), "- clone")
+				require.NotContains(t, string(out), "- ptrace")
+			},
+		},
+		{
+			name: "directory into single file",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturnsOnCall(0, fakeFileInfo{dir: true}, nil)
+				mock.StatReturnsOnCall(1, fakeFileInfo{}, nil)
+				mock.ReadDirReturns([]os.DirEntry{
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "Docker_Default.json"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "README.md"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "nested", dir: true}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "containerd.json"}),
+				}, nil)
+				mock.ReadFileReturns([]byte(dockerProfile), nil)
+				options := defaultOptions()
+				options.inputFile = "profiles"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.ReadFileCallCount())
+				require.Equal(t, "profiles/Docker_Default.json", mock.ReadFileArgsForCall(0))
+				require.Equal(t, 1, mock.WriteFileCallCount())
+				_, out, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(out), "name: docker-default\n")
+				require.Contains(t, string(out), "---\n")
+				require.Contains(t, string(out), "name: containerd\n")
+			},
+		},
+		{
+			name: "directory into output directory",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{dir: true}, nil)
+				mock.ReadDirReturns([]os.DirEntry{
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "docker.json"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "containerd.json"}),
+				}, nil)
+				mock.ReadFileReturns([]byte(dockerProfile), nil)
+				options := defaultOptions()
+				options.inputFile = "profiles"
+				options.outputFile = "crds"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.WriteFileCallCount())
+				file, out, _ := mock.WriteFileArgsForCall(1)
+				require.Equal(t, "crds/containerd.yaml", file)
+				require.NotContains(t, string(out), "---")
+			},
+		},
+		{
+			name: "directory with conflicting names",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{dir: true}, nil)
+				mock.ReadDirReturns([]os.DirEntry{
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "Docker.json"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "docker.json"}),
+				}, nil)
+				mock.ReadFileReturns([]byte(dockerProfile), nil)
+				options := defaultOptions()
+				options.inputFile = "profiles"
+				options.outputFile = "crds"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err,
+					`profile name "docker" of profiles/docker.json is already used by profiles/Docker.json`)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "name for directory",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{dir: true}, nil)
+				options := defaultOptions()
+				options.name = "custom"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "cannot be used for a directory")
+			},
+		},
+		{
+			name: "directory without profiles",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{dir: true}, nil)
+				return defaultOptions()
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "no profiles found")
+			},
+		},
+		{
+			name: "both architectures and archMap",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte(`{
+  "defaultAction": "SCMP_ACT_ERRNO",
+  "architectures": ["SCMP_ARCH_X86_64"],
+  "archMap": [{"architecture": "SCMP_ARCH_X86_64"}]
+}`), nil)
+				return defaultOptions()
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "both architectures and archMap")
+			},
+		},
+		{
+			name: "no default action",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte(`{"syscalls": []}`), nil)
+				return defaultOptions()
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "no default action")
+			},
+		},
+		{
+			name: "apparmor profile",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte(`
+#include <tunables/global>
+/usr/sbin/nginx flags=(complain) {
+  /etc/nginx/** r,
+  network inet tcp,
+  ptrace,
+}
+`), nil)
+				options := defaultOptions()
+				options.inputFile = "usr.sbin.nginx"
+				options.from = FromAppArmor
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, out, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(out), "kind: AppArmorProfile")
+				require.Contains(t, string(out), "name: nginx\n")
+				require.Contains(t, string(out), "complainMode: true")
+				require.Contains(t, string(out), "- /etc/nginx/**")
+				require.Contains(t, string(out), "allowTcp: true")
+			},
+		},
+		{
+			name: "apparmor directory",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturnsOnCall(0, fakeFileInfo{dir: true}, nil)
+				mock.StatReturnsOnCall(1, fakeFileInfo{}, nil)
+				mock.ReadDirReturns([]os.DirEntry{
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "abstractions", dir: true}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: ".hidden"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "usr.bin.first"}),
+					fs.FileInfoToDirEntry(fakeFileInfo{name: "usr.bin.second"}),
+				}, nil)
+				mock.ReadFileReturnsOnCall(0, []byte("profile first {\n  capability chown,\n}\n"), nil)
+				mock.ReadFileReturnsOnCall(1, []byte("profile second {\n  capability setuid,\n}\n"), nil)
+				options := defaultOptions()
+				options.inputFile = "/etc/apparmor.d"
+				options.from = FromAppArmor
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.ReadFileCallCount())
+				_, out, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(out), "name: first\n")
+				require.Contains(t, string(out), "name: second\n")
+			},
+		},
+		{
+			name: "apparmor name for multiple profiles",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(fakeFileInfo{}, nil)
+				mock.ReadFileReturns([]byte("profile a {\n}\nprofile b {\n}\n"), nil)
+				options := defaultOptions()
+				options.from = FromAppArmor
+				options.name = "custom"
+				return options
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "contains 2 profiles")
+			},
+		},
+		{
+			name: "input file not found",
+			prepare: func(mock *converterfakes.FakeImpl) *Options {
+				mock.StatReturns(nil, errors.New("file not found"))
+				return defaultOptions()
+			},
+			assert: func(mock *converterfakes.FakeImpl, err error) {
+				require.ErrorContains(t, err, "file not found")
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &converterfakes.FakeImpl{}
+			options := prepare(mock)
+
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -27,6 +27,8 @@ type defaultImpl struct{}
 type impl interface {
 	ReadFile(string) ([]byte, error)
 	WriteFile(name string, data []byte, perm os.FileMode) error
+	Stat(string) (os.FileInfo, error)
+	ReadDir(string) ([]os.DirEntry, error)
 }
 
 func (*defaultImpl) ReadFile(name string) ([]byte, error) {
@@ -36,3 +38,11 @@ func (*defaultImpl) ReadFile(name string) ([]byte, error) {
 func (*defaultImpl) WriteFile(name string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(name, data, perm)
 }
+
+func (*defaultImpl) Stat(name string) (os.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (*defaultImpl) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
@@ -18,21 +18,32 @@ package converter
 
 import (
 	"errors"
+	"fmt"
+	"runtime"
+	"strings"
 
 	ucli "github.com/urfave/cli/v2"
 )
 
 // Options define all possible options for the puller.
 type Options struct {
-	inputFile   string
-	outputFile  string
-	programName string
+	inputFile     string
+	outputFile    string
+	programName   string
+	from          string
+	namespace     string
+	name          string
+	arch          string
+	capabilities  []string
+	kernelVersion string
 }
 
 // Default returns a default options instance.
 func Default() *Options {
 	return &Options{
 		outputFile: DefaultOutputFile,
+		from:       FromCRD,
+		arch:       runtime.GOARCH,
 	}
 }
 
@@ -57,5 +68,37 @@ func FromContext(ctx *ucli.Context) (*Options, error) {
 		options.programName = ctx.String(FlagProgramName)
 	}
 
+	if ctx.IsSet(FlagFrom) {
+		options.from = ctx.String(FlagFrom)
+	}
+	if options.from != FromCRD && options.from != FromSeccomp && options.from != FromAppArmor {
+		return nil, fmt.Errorf("unsupported input format: %s", options.from)
+	}
+
+	if ctx.IsSet(FlagNamespace) {
+		options.namespace = ctx.String(FlagNamespace)
+	}
+	if ctx.IsSet(FlagName) {
+		options.name = ctx.String(FlagName)
+	}
+	if ctx.IsSet(FlagArch) {
+		options.arch = ctx.String(FlagArch)
+	}
+	if ctx.IsSet(FlagCapabilities) {
+		for _, capability := range ctx.StringSlice(FlagCapabilities) {
+			capability = strings.ToUpper(capability)
+			if !strings.HasPrefix(capability, "CAP_") {
+				capability = "CAP_" + capability
+			}
+			options.capabilities = append(options.capabilities, capability)
+		}
+	}
+	if ctx.IsSet(FlagKernelVersion) {
+		options.kernelVersion = ctx.String(FlagKernelVersion)
+		if _, err := parseKernelVersion(options.kernelVersion); err != nil {
+			return nil, fmt.Errorf("invalid kernel version: %w", err)
+		}
+	}
+
 	return options, nil
 }
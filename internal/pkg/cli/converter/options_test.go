@@ -54,6 +54,38 @@ func TestFromContext(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{ // failure: unsupported input format
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagFrom, "", "")
+				require.NoError(t, set.Set(FlagFrom, "docker"))
+				require.NoError(t, set.Parse([]string{"foo.json"}))
+			},
+			assert: func(err error) {
+				require.ErrorContains(t, err, "unsupported input format")
+			},
+		},
+		{ // Success: seccomp input
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagFrom, "", "")
+				require.NoError(t, set.Set(FlagFrom, FromSeccomp))
+				set.String(FlagKernelVersion, "", "")
+				require.NoError(t, set.Set(FlagKernelVersion, "6.8.0-45-generic"))
+				require.NoError(t, set.Parse([]string{"foo.json"}))
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{ // failure: invalid kernel version
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagKernelVersion, "", "")
+				require.NoError(t, set.Set(FlagKernelVersion, "latest"))
+				require.NoError(t, set.Parse([]string{"foo.json"}))
+			},
+			assert: func(err error) {
+				require.ErrorContains(t, err, "invalid kernel version")
+			},
+		},
 	} {
 		set := flag.NewFlagSet("", flag.ExitOnError)
 		tc.prepare(set)
@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+// rawSeccompProfile is the OCI runtime seccomp profile, extended by the
+// conditional fields used by Docker and containerd.
+type rawSeccompProfile struct {
+	DefaultAction    seccomp.Action           `json:"defaultAction"`
+	DefaultErrnoRet  *uint                    `json:"defaultErrnoRet,omitempty"`
+	Architectures    []seccompprofileapi.Arch `json:"architectures,omitempty"`
+	ArchMap          []rawArchitecture        `json:"archMap,omitempty"`
+	ListenerPath     string                   `json:"listenerPath,omitempty"`
+	ListenerMetadata string                   `json:"listenerMetadata,omitempty"`
+	Flags            []seccompprofileapi.Flag `json:"flags,omitempty"`
+	Syscalls         []*rawSyscall            `json:"syscalls,omitempty"`
+}
+
+type rawArchitecture struct {
+	Arch      seccompprofileapi.Arch   `json:"architecture"`
+	SubArches []seccompprofileapi.Arch `json:"subArchitectures"`
+}
+
+type rawSyscall struct {
+	Name     string                   `json:"name,omitempty"`
+	Names    []string                 `json:"names,omitempty"`
+	Action   seccomp.Action           `json:"action"`
+	ErrnoRet *uint                    `json:"errnoRet,omitempty"`
+	Args     []*seccompprofileapi.Arg `json:"args,omitempty"`
+	Includes rawFilter                `json:"includes"`
+	Excludes rawFilter                `json:"excludes"`
+}
+
+type rawFilter struct {
+	Arches    []string `json:"arches,omitempty"`
+	Caps      []string `json:"caps,omitempty"`
+	MinKernel string   `json:"minKernel,omitempty"`
+}
+
+// seccompSpecFromRaw converts a raw seccomp profile into a SeccompProfile
+// spec. Conditional rules are resolved the same way Docker does it for a
+// container, by using the configured architecture, capabilities and kernel
+// version.
+func seccompSpecFromRaw(content []byte, options *Options) (*seccompprofileapi.SeccompProfileSpec, error) {
+	raw := &rawSeccompProfile{}
+	if err := json.Unmarshal(content, raw); err != nil {
+		return nil, fmt.Errorf("unmarshal JSON profile: %w", err)
+	}
+	if raw.DefaultAction == "" {
+		return nil, errors.New("profile has no default action")
+	}
+	if len(raw.Architectures) > 0 && len(raw.ArchMap) > 0 {
+		return nil, errors.New("profile specifies both architectures and archMap")
+	}
+
+	var kernel []int
+	if options.kernelVersion != "" {
+		var err error
+		if kernel, err = parseKernelVersion(options.kernelVersion); err != nil {
+			return nil, fmt.Errorf("parse kernel version: %w", err)
+		}
+	}
+
+	spec := &seccompprofileapi.SeccompProfileSpec{
+		DefaultAction:    raw.DefaultAction,
+		DefaultErrnoRet:  raw.DefaultErrnoRet,
+		Architectures:    raw.Architectures,
+		ListenerPath:     raw.ListenerPath,
+		ListenerMetadata: raw.ListenerMetadata,
+	}
+	for i := range raw.Flags {
+		spec.Flags = append(spec.Flags, &raw.Flags[i])
+	}
+
+	if len(raw.ArchMap) > 0 {
+		nativeArch, err := seccomp.GoArchToSeccompArch(options.arch)
+		if err != nil {
+			return nil, fmt.Errorf("resolve architecture %s: %w", options.arch, err)
+		}
+		for _, arch := range raw.ArchMap {
+			if string(arch.Arch) == string(nativeArch) {
+				spec.Architectures = append(spec.Architectures, arch.Arch)
+				spec.Architectures = append(spec.Architectures, arch.SubArches...)
+			}
+		}
+	}
+
+	for _, call := range raw.Syscalls {
+		matches, err := call.matches(options, kernel)
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		names := call.Names
+		if call.Name != "" {
+			names = append([]string{call.Name}, names...)
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		syscall := &seccompprofileapi.Syscall{
+			Names:  names,
+			Action: call.Action,
+			Args:   call.Args,
+		}
+		if call.ErrnoRet != nil {
+			syscall.ErrnoRet = *call.ErrnoRet
+		}
+		spec.Syscalls = append(spec.Syscalls, syscall)
+	}
+
+	return spec, nil
+}
+
+// matches returns true if the syscall rule applies to the configured
+// architecture, capabilities and kernel version.
+func (s *rawSyscall) matches(options *Options, kernel []int) (bool, error) {
+	if slices.Contains(s.Excludes.Arches, options.arch) {
+		return false, nil
+	}
+	for _, capability := range s.Excludes.Caps {
+		if slices.Contains(options.capabilities, capability) {
+			return false, nil
+		}
+	}
+	if s.Excludes.MinKernel != "" && kernel != nil {
+		atLeast, err := kernelAtLeast(kernel, s.Excludes.MinKernel)
+		if err != nil {
+			return false, err
+		}
+		if atLeast {
+			return false, nil
+		}
+	}
+
+	if len(s.Includes.Arches) > 0 && !slices.Contains(s.Includes.Arches, options.arch) {
+		return false, nil
+	}
+	for _, capability := range s.Includes.Caps {
+		if !slices.Contains(options.capabilities, capability) {
+			return false, nil
+		}
+	}
+	if s.Includes.MinKernel != "" && kernel != nil {
+		atLeast, err := kernelAtLeast(kernel, s.Includes.MinKernel)
+		if err != nil {
+			return false, err
+		}
+		if !atLeast {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func kernelAtLeast(kernel []int, minKernel string) (bool, error) {
+	required, err := parseKernelVersion(minKernel)
+	if err != nil {
+		return false, fmt.Errorf("parse minimum kernel version: %w", err)
+	}
+	return slices.Compare(kernel, required) >= 0, nil
+}
+
+// parseKernelVersion parses the major and minor version of a kernel release
+// like 5.15 or 6.8.0-45-generic.
+func parseKernelVersion(version string) ([]int, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("%q is not in the format <major>.<minor>", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("parse major version of %q: %w", version, err)
+	}
+	minor := parts[1]
+	if i := strings.IndexFunc(minor, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		minor = minor[:i]
+	}
+	minorVersion, err := strconv.Atoi(minor)
+	if err != nil {
+		return nil, fmt.Errorf("parse minor version of %q: %w", version, err)
+	}
+
+	return []int{major, minorVersion}, nil
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
+)
+
+// Diff is the semantic difference between two profiles of the same kind.
+type Diff struct {
+	// Kind is the kind of the compared profiles.
+	Kind string `json:"kind"`
+	// Changes are the changed sections of the profile.
+	Changes []Change `json:"changes"`
+}
+
+// Change contains the added and removed entries of a profile section.
+type Change struct {
+	// Section identifies the changed part of the profile, for example
+	// `syscalls/SCMP_ACT_ALLOW`.
+	Section string `json:"section"`
+	// Added are the entries only present in the new profile.
+	Added []string `json:"added,omitempty"`
+	// Removed are the entries only present in the old profile.
+	Removed []string `json:"removed,omitempty"`
+}
+
+// Empty returns true if the profiles do not differ.
+func (d *Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// String returns the diff in a human readable format.
+func (d *Diff) String() string {
+	var sb strings.Builder
+	for _, change := range d.Changes {
+		fmt.Fprintf(&sb, "%s:\n", change.Section)
+		for _, entry := range change.Removed {
+			fmt.Fprintf(&sb, "- %s\n", entry)
+		}
+		for _, entry := range change.Added {
+			fmt.Fprintf(&sb, "+ %s\n", entry)
+		}
+	}
+	return sb.String()
+}
+
+// add records the difference of the old and new entries of a section.
+func (d *Diff) add(section string, oldEntries, newEntries []string) {
+	change := Change{
+		Section: section,
+		Added:   missing(newEntries, oldEntries),
+		Removed: missing(oldEntries, newEntries),
+	}
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		return
+	}
+	d.Changes = append(d.Changes, change)
+}
+
+// addValue records a changed single value of a section.
+func (d *Diff) addValue(section, oldValue, newValue string) {
+	if oldValue == newValue {
+		return
+	}
+	change := Change{Section: section}
+	if newValue != "" {
+		change.Added = []string{newValue}
+	}
+	if oldValue != "" {
+		change.Removed = []string{oldValue}
+	}
+	d.Changes = append(d.Changes, change)
+}
+
+// missing returns the sorted unique entries of a which are not in b.
+func missing(a, b []string) []string {
+	var res []string
+	for _, entry := range a {
+		if !slices.Contains(b, entry) && !slices.Contains(res, entry) {
+			res = append(res, entry)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+func diffSeccomp(oldProf, newProf *seccompprofileapi.SeccompProfile) *Diff {
+	d := &Diff{Kind: "SeccompProfile", Changes: []Change{}}
+	oldSpec, newSpec := &oldProf.Spec, &newProf.Spec
+
+	d.addValue("defaultAction", string(oldSpec.DefaultAction), string(newSpec.DefaultAction))
+	d.addValue("defaultErrnoRet", uintString(oldSpec.DefaultErrnoRet), uintString(newSpec.DefaultErrnoRet))
+	d.addValue("baseProfileName", oldSpec.BaseProfileName, newSpec.BaseProfileName)
+	d.addValue("complainMode", boolString(oldSpec.ComplainMode), boolString(newSpec.ComplainMode))
+	d.add("architectures", stringsOf(oldSpec.Architectures), stringsOf(newSpec.Architectures))
+	d.add("flags", flagsOf(oldSpec.Flags), flagsOf(newSpec.Flags))
+
+	oldSyscalls, newSyscalls := syscallsByAction(oldSpec.Syscalls), syscallsByAction(newSpec.Syscalls)
+	for _, action := range sortedKeys(oldSyscalls, newSyscalls) {
+		d.add("syscalls/"+action, oldSyscalls[action], newSyscalls[action])
+	}
+	return d
+}
+
+// syscallsByAction returns the syscall names per action. Syscalls with
+// argument filters are identified by their name and arguments.
+func syscallsByAction(syscalls []*seccompprofileapi.Syscall) map[string][]string {
+	res := map[string][]string{}
+	for _, syscall := range syscalls {
+		if syscall == nil {
+			continue
+		}
+		for _, name := range syscall.Names {
+			res[string(syscall.Action)] = append(res[string(syscall.Action)], name+argsString(syscall.Args))
+		}
+	}
+	return res
+}
+
+func argsString(args []*seccompprofileapi.Arg) string {
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		part := fmt.Sprintf("%d %s %d", arg.Index, arg.Op, arg.Value)
+		if arg.ValueTwo != 0 {
+			part += fmt.Sprintf(" %d", arg.ValueTwo)
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func diffSelinux(oldProf, newProf *selinuxprofileapi.SelinuxProfile) *Diff {
+	d := &Diff{Kind: "SelinuxProfile", Changes: []Change{}}
+	oldSpec, newSpec := &oldProf.Spec, &newProf.Spec
+
+	d.addValue("permissive", boolString(oldSpec.Permissive), boolString(newSpec.Permissive))
+	d.add("inherit", policyRefs(oldSpec.Inherit), policyRefs(newSpec.Inherit))
+
+	oldAllow, newAllow := allowEntries(oldSpec.Allow), allowEntries(newSpec.Allow)
+	for _, section := range sortedKeys(oldAllow, newAllow) {
+		d.add("allow/"+section, oldAllow[section], newAllow[section])
+	}
+	return d
+}
+
+// allowEntries returns the permissions per label and object class.
+func allowEntries(allow selinuxprofileapi.Allow) map[string][]string {
+	res := map[string][]string{}
+	for label, classes := range allow {
+		for class, perms := range classes {
+			res[string(label)+"/"+string(class)] = perms
+		}
+	}
+	return res
+}
+
+func policyRefs(refs []selinuxprofileapi.PolicyRef) []string {
+	res := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		kind := ref.Kind
+		if kind == "" {
+			kind = "System"
+		}
+		res = append(res, kind+"/"+ref.Name)
+	}
+	return res
+}
+
+func diffAppArmor(oldProf, newProf *apparmorprofileapi.AppArmorProfile) *Diff {
+	d := &Diff{Kind: "AppArmorProfile", Changes: []Change{}}
+	oldAbstract, newAbstract := &oldProf.Spec.Abstract, &newProf.Spec.Abstract
+
+	d.addValue("complainMode", boolString(oldProf.Spec.ComplainMode), boolString(newProf.Spec.ComplainMode))
+
+	oldExec, newExec := executableRules(oldAbstract), executableRules(newAbstract)
+	d.add("executable/allowedExecutables", deref(oldExec.AllowedExecutables), deref(newExec.AllowedExecutables))
+	d.add("executable/allowedLibraries", deref(oldExec.AllowedLibraries), deref(newExec.AllowedLibraries))
+
+	oldFs, newFs := filesystemRules(oldAbstract), filesystemRules(newAbstract)
+	d.add("filesystem/readOnlyPaths", deref(oldFs.ReadOnlyPaths), deref(newFs.ReadOnlyPaths))
+	d.add("filesystem/writeOnlyPaths", deref(oldFs.WriteOnlyPaths), deref(newFs.WriteOnlyPaths))
+	d.add("filesystem/readWritePaths", deref(oldFs.ReadWritePaths), deref(newFs.ReadWritePaths))
+
+	d.add("capability/allowedCapabilities", capabilities(oldAbstract), capabilities(newAbstract))
+	d.add("network", networkRules(oldAbstract), networkRules(newAbstract))
+
+	oldRules, newRules := crd2armor.Rules(oldAbstract), crd2armor.Rules(newAbstract)
+	for _, section := range sortedKeys(oldRules, newRules) {
+		d.add(section, oldRules[section], newRules[section])
+	}
+	return d
+}
+
+func executableRules(abstract *apparmorprofileapi.AppArmorAbstract) *apparmorprofileapi.AppArmorExecutablesRules {
+	if abstract.Executable == nil {
+		return &apparmorprofileapi.AppArmorExecutablesRules{}
+	}
+	return abstract.Executable
+}
+
+func filesystemRules(abstract *apparmorprofileapi.AppArmorAbstract) *apparmorprofileapi.AppArmorFsRules {
+	if abstract.Filesystem == nil {
+		return &apparmorprofileapi.AppArmorFsRules{}
+	}
+	return abstract.Filesystem
+}
+
+func capabilities(abstract *apparmorprofileapi.AppArmorAbstract) []string {
+	if abstract.Capability == nil {
+		return nil
+	}
+	return abstract.Capability.AllowedCapabilities
+}
+
+// networkRules returns the allowed network access as list.
+func networkRules(abstract *apparmorprofileapi.AppArmorAbstract) []string {
+	network := abstract.Network
+	if network == nil {
+		return nil
+	}
+
+	var res []string
+	if network.AllowRaw != nil && *network.AllowRaw {
+		res = append(res, "raw")
+	}
+	if network.Protocols != nil {
+		if network.Protocols.AllowTCP != nil && *network.Protocols.AllowTCP {
+			res = append(res, "tcp")
+		}
+		if network.Protocols.AllowUDP != nil && *network.Protocols.AllowUDP {
+			res = append(res, "udp")
+		}
+	}
+	return res
+}
+
+func deref(list *[]string) []string {
+	if list == nil {
+		return nil
+	}
+	return *list
+}
+
+func boolString(b bool) string {
+	if !b {
+		return ""
+	}
+	return strconv.FormatBool(b)
+}
+
+func uintString(u *uint) string {
+	if u == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*u), 10)
+}
+
+func stringsOf[T ~string](list []T) []string {
+	res := make([]string, 0, len(list))
+	for _, entry := range list {
+		res = append(res, string(entry))
+	}
+	return res
+}
+
+func flagsOf(flags []*seccompprofileapi.Flag) []string {
+	res := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag != nil {
+			res = append(res, string(*flag))
+		}
+	}
+	return res
+}
+
+func sortedKeys(a, b map[string][]string) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+)
+
+// Differ is the main structure of this package.
+type Differ struct {
+	impl
+	options *Options
+}
+
+// New returns a new Differ instance.
+func New(options *Options) *Differ {
+	return &Differ{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Differ.
+func (d *Differ) Run() error {
+	log.Printf("Comparing %s with %s", d.options.oldFile, d.options.newFile)
+
+	oldProf, err := d.readProfile(d.options.oldFile)
+	if err != nil {
+		return err
+	}
+	newProf, err := d.readProfile(d.options.newFile)
+	if err != nil {
+		return err
+	}
+
+	diff, err := Profiles(oldProf, newProf)
+	if err != nil {
+		return err
+	}
+
+	if d.options.format == FormatJSON {
+		out, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON diff: %w", err)
+		}
+		d.Printf("%s\n", out)
+		return nil
+	}
+
+	if diff.Empty() {
+		log.Printf("Profiles do not differ.")
+		return nil
+	}
+	d.Printf("%s", diff.String())
+	return nil
+}
+
+func (d *Differ) readProfile(filepath string) (client.Object, error) {
+	content, err := d.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("open profile: %w", err)
+	}
+	profile, err := artifact.ReadProfile(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath, err)
+	}
+	return profile, nil
+}
+
+// Profiles returns the semantic difference between two profiles of the same
+// kind.
+func Profiles(oldProf, newProf client.Object) (*Diff, error) {
+	switch oldObj := oldProf.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		if newObj, ok := newProf.(*seccompprofileapi.SeccompProfile); ok {
+			return diffSeccomp(oldObj, newObj), nil
+		}
+	case *selinuxprofileapi.SelinuxProfile:
+		if newObj, ok := newProf.(*selinuxprofileapi.SelinuxProfile); ok {
+			return diffSelinux(oldObj, newObj), nil
+		}
+	case *apparmorprofileapi.AppArmorProfile:
+		if newObj, ok := newProf.(*apparmorprofileapi.AppArmorProfile); ok {
+			return diffAppArmor(oldObj, newObj), nil
+		}
+	default:
+		return nil, fmt.Errorf("cannot compare %T profiles", oldProf)
+	}
+	return nil, fmt.Errorf("cannot compare %T with %T", oldProf, newProf)
+}
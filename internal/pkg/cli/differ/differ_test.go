@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/differ/differfakes"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name            string
+		oldProfile      string
+		newProfile      string
+		expectedErr     bool
+		expectedChanges []Change
+	}{
+		{
+			name: "seccomp syscalls per action",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  defaultErrnoRet: 1
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names: [read, write, mkdir]
+  - action: SCMP_ACT_LOG
+    names: [ptrace]
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_LOG
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names: [write, read, rmdir]
+  - action: SCMP_ACT_ERRNO
+    names: [ptrace]
+    args:
+    - index: 0
+      value: 2
+      op: SCMP_CMP_EQ
+`,
+			expectedChanges: []Change{
+				{Section: "defaultAction", Added: []string{"SCMP_ACT_LOG"}, Removed: []string{"SCMP_ACT_ERRNO"}},
+				{Section: "defaultErrnoRet", Removed: []string{"1"}},
+				{Section: "syscalls/SCMP_ACT_ALLOW", Added: []string{"rmdir"}, Removed: []string{"mkdir"}},
+				{Section: "syscalls/SCMP_ACT_ERRNO", Added: []string{"ptrace(0 SCMP_CMP_EQ 2)"}},
+				{Section: "syscalls/SCMP_ACT_LOG", Removed: []string{"ptrace"}},
+			},
+		},
+		{
+			name: "selinux allow entries",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha2
+kind: SelinuxProfile
+spec:
+  inherit:
+  - name: container
+  allow:
+    var_log_t:
+      file: [open, read]
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha2
+kind: SelinuxProfile
+spec:
+  permissive: true
+  inherit:
+  - name: container
+  allow:
+    var_log_t:
+      file: [open, read, getattr]
+      dir: [search]
+`,
+			expectedChanges: []Change{
+				{Section: "permissive", Added: []string{"true"}},
+				{Section: "allow/var_log_t/dir", Added: []string{"search"}},
+				{Section: "allow/var_log_t/file", Added: []string{"getattr"}},
+			},
+		},
+		{
+			name: "apparmor paths, capabilities and network",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      readOnlyPaths: [/etc/passwd]
+    capability:
+      allowedCapabilities: [net_bind_service]
+    network:
+      allowedProtocols:
+        allowTcp: true
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      readOnlyPaths: [/etc/passwd, /etc/group]
+      readWritePaths: [/tmp/**]
+    network:
+      allowedProtocols:
+        allowTcp: true
+        allowUdp: true
+`,
+			expectedChanges: []Change{
+				{Section: "filesystem/readOnlyPaths", Added: []string{"/etc/group"}},
+				{Section: "filesystem/readWritePaths", Added: []string{"/tmp/**"}},
+				{Section: "capability/allowedCapabilities", Removed: []string{"net_bind_service"}},
+				{Section: "network", Added: []string{"udp"}},
+			},
+		},
+		{
+			name: "apparmor fine-grained rules",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      fileRules:
+      - path: /tmp/app.log
+        permissions: [append]
+    signal:
+    - access: [send]
+      signals: [term]
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      fileRules:
+      - path: /tmp/app.log
+        permissions: [append]
+        owner: true
+    signal:
+    - access: [send]
+      signals: [term]
+    mount:
+      umounts: [/mnt/]
+`,
+			expectedChanges: []Change{
+				{
+					Section: "filesystem/fileRules",
+					Added:   []string{"owner /tmp/app.log a"},
+					Removed: []string{"/tmp/app.log a"},
+				},
+				{Section: "mount/umounts", Added: []string{"/mnt/"}},
+			},
+		},
+		{
+			name: "equal profiles",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+`,
+		},
+		{
+			name: "different kinds",
+			oldProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+`,
+			newProfile: `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+`,
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &differfakes.FakeImpl{}
+			mock.ReadFileReturnsOnCall(0, []byte(tc.oldProfile), nil)
+			mock.ReadFileReturnsOnCall(1, []byte(tc.newProfile), nil)
+
+			options := Default()
+			options.oldFile = "old.yaml"
+			options.newFile = "new.yaml"
+			options.format = FormatJSON
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, 1, mock.PrintfCallCount())
+			_, args := mock.PrintfArgsForCall(0)
+			require.Len(t, args, 1)
+			out, ok := args[0].([]byte)
+			require.True(t, ok)
+
+			diff := &Diff{}
+			require.NoError(t, json.Unmarshal(out, diff))
+			if tc.expectedChanges == nil {
+				require.NotNil(t, diff.Changes)
+				require.Empty(t, diff.Changes)
+				return
+			}
+			require.Equal(t, tc.expectedChanges, diff.Changes)
+		})
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	t.Parallel()
+
+	diff := &Diff{Changes: []Change{
+		{Section: "syscalls/SCMP_ACT_ALLOW", Added: []string{"rmdir"}, Removed: []string{"mkdir"}},
+	}}
+	require.Equal(t, "syscalls/SCMP_ACT_ALLOW:\n- mkdir\n+ rmdir\n", diff.String())
+	require.False(t, diff.Empty())
+	require.True(t, (&Diff{}).Empty())
+}
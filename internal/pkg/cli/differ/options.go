@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package differ
+
+import (
+	"errors"
+	"fmt"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+// Options define all possible options for the differ.
+type Options struct {
+	oldFile string
+	newFile string
+	format  string
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		format: FormatText,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	const expectedArgs = 2
+	args := ctx.Args().Slice()
+	if len(args) != expectedArgs {
+		return nil, errors.New("expected two profiles to compare")
+	}
+	options.oldFile = args[0]
+	options.newFile = args[1]
+
+	if ctx.IsSet(FlagFormat) {
+		options.format = ctx.String(FlagFormat)
+	}
+	if options.format != FormatText && options.format != FormatJSON {
+		return nil, fmt.Errorf("unsupported output format: %s", options.format)
+	}
+
+	return options, nil
+}
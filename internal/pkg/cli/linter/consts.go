@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+const (
+	// FlagConfig is the flag for defining the rule configuration file.
+	FlagConfig string = "config"
+
+	// FlagFailOn is the flag for defining the severity which fails the lint.
+	FlagFailOn string = "fail-on"
+
+	// FlagFormat is the flag for defining the output format.
+	FlagFormat string = "format"
+
+	// FormatText prints the findings in a human readable format.
+	FormatText string = "text"
+
+	// FormatJSON prints the findings as JSON.
+	FormatJSON string = "json"
+)
@@ -0,0 +1,325 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"strings"
+)
+
+type globTokenKind int
+
+const (
+	// globLiteral matches a single character.
+	globLiteral globTokenKind = iota
+	// globAnyChar matches a single character except "/", like "?" or a
+	// character class.
+	globAnyChar
+	// globStar matches any number of characters except "/".
+	globStar
+	// globDoubleStar matches any number of characters including "/".
+	globDoubleStar
+)
+
+type globToken struct {
+	kind globTokenKind
+	char byte
+}
+
+// glob is an AppArmor path glob with its alternations expanded.
+type glob [][]globToken
+
+// globVariables are the values of the commonly used variables of the global
+// tunables. Other variables are assumed to match any path.
+var globVariables = map[string]string{
+	"HOME": "{/home/*/,/root/}",
+	"PROC": "/proc/",
+	"sys":  "/sys/",
+	"run":  "{/run/,/var/run/}",
+}
+
+// compileGlob parses the AppArmor path glob.
+func compileGlob(pattern string) glob {
+	var g glob
+	for _, expanded := range expandAlternations(expandGlobVariables(pattern)) {
+		g = append(g, tokenizeGlob(expanded))
+	}
+	return g
+}
+
+// expandGlobVariables replaces the variable references of the pattern.
+func expandGlobVariables(pattern string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(pattern, "@{")
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(pattern[start:], '}')
+		if end < 0 {
+			break
+		}
+		b.WriteString(pattern[:start])
+		if value, ok := globVariables[pattern[start+2:start+end]]; ok {
+			b.WriteString(value)
+		} else {
+			b.WriteString("**")
+		}
+		pattern = pattern[start+end+1:]
+	}
+	b.WriteString(pattern)
+	return b.String()
+}
+
+// expandAlternations expands all alternations like "{a,b}" of the pattern,
+// including nested ones.
+func expandAlternations(pattern string) []string {
+	start, end, depth := -1, -1, 0
+	for i := 0; i < len(pattern) && end < 0; i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '{':
+			if depth == 0 {
+				start = i
+			}
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+				if depth == 0 {
+					end = i
+				}
+			}
+		}
+	}
+	if end < 0 {
+		return []string{pattern}
+	}
+
+	var alternatives []string
+	last, depth := start+1, 0
+	for i := start + 1; i < end; i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				alternatives = append(alternatives, pattern[last:i])
+				last = i + 1
+			}
+		}
+	}
+	alternatives = append(alternatives, pattern[last:end])
+
+	var res []string
+	for _, alternative := range alternatives {
+		res = append(res, expandAlternations(pattern[:start]+alternative+pattern[end+1:])...)
+	}
+	return res
+}
+
+// tokenizeGlob splits the pattern without alternations into tokens.
+// Character classes are treated like "?" and repeated slashes are merged like
+// AppArmor does.
+func tokenizeGlob(pattern string) []globToken {
+	var tokens []globToken
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				for i+1 < len(pattern) && pattern[i+1] == '*' {
+					i++
+				}
+				tokens = append(tokens, globToken{kind: globDoubleStar})
+			} else {
+				tokens = append(tokens, globToken{kind: globStar})
+			}
+		case '?':
+			tokens = append(tokens, globToken{kind: globAnyChar})
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				tokens = append(tokens, globToken{kind: globLiteral, char: c})
+				continue
+			}
+			i += end + 1
+			tokens = append(tokens, globToken{kind: globAnyChar})
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			tokens = append(tokens, globToken{kind: globLiteral, char: pattern[i]})
+		case '/':
+			if len(tokens) > 0 && tokens[len(tokens)-1] == (globToken{kind: globLiteral, char: c}) {
+				continue
+			}
+			tokens = append(tokens, globToken{kind: globLiteral, char: c})
+		default:
+			tokens = append(tokens, globToken{kind: globLiteral, char: c})
+		}
+	}
+	return tokens
+}
+
+// globState is a set of positions in the alternatives of a glob.
+type globState []bool
+
+func (g glob) start() globState {
+	state := g.newState()
+	for i := range g {
+		state.add(g, i, 0)
+	}
+	return state
+}
+
+func (g glob) newState() globState {
+	size := 0
+	for _, tokens := range g {
+		size += len(tokens) + 1
+	}
+	return make(globState, size)
+}
+
+// add adds the position and all positions reachable by matching stars with
+// no characters.
+func (s globState) add(g glob, alternative, pos int) {
+	offset := 0
+	for _, tokens := range g[:alternative] {
+		offset += len(tokens) + 1
+	}
+	tokens := g[alternative]
+	for ; pos <= len(tokens); pos++ {
+		s[offset+pos] = true
+		if pos == len(tokens) ||
+			(tokens[pos].kind != globStar && tokens[pos].kind != globDoubleStar) {
+			return
+		}
+	}
+}
+
+// next returns the state after matching the character.
+func (s globState) next(g glob, c byte) globState {
+	next := g.newState()
+	offset := 0
+	for alternative, tokens := range g {
+		for pos, token := range tokens {
+			if !s[offset+pos] {
+				continue
+			}
+			switch token.kind {
+			case globLiteral:
+				if token.char == c {
+					next.add(g, alternative, pos+1)
+				}
+			case globAnyChar:
+				if c != '/' {
+					next.add(g, alternative, pos+1)
+				}
+			case globStar:
+				if c != '/' {
+					next.add(g, alternative, pos)
+				}
+			case globDoubleStar:
+				next.add(g, alternative, pos)
+			}
+		}
+		offset += len(tokens) + 1
+	}
+	return next
+}
+
+// accepts returns true if the state matches the whole path.
+func (s globState) accepts(g glob) bool {
+	offset := 0
+	for _, tokens := range g {
+		offset += len(tokens) + 1
+		if s[offset-1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s globState) key() string {
+	var b strings.Builder
+	for _, set := range s {
+		if set {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+// walkGlobs walks all paths matched by any of both globs and calls visit with
+// whether the paths are matched by a and b. The walk stops if visit returns
+// true, which is returned as result.
+func walkGlobs(a, b glob, visit func(matchesA, matchesB bool) bool) bool {
+	// All characters which do not appear in the globs behave the same, so
+	// the literals, "/" and one other character cover all paths.
+	alphabet := map[byte]bool{'/': true, 0: true}
+	for _, g := range []glob{a, b} {
+		for _, tokens := range g {
+			for _, token := range tokens {
+				if token.kind == globLiteral {
+					alphabet[token.char] = true
+				}
+			}
+		}
+	}
+
+	type pair struct{ a, b globState }
+	queue := []pair{{a.start(), b.start()}}
+	seen := map[string]bool{}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		key := p.a.key() + "/" + p.b.key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if visit(p.a.accepts(a), p.b.accepts(b)) {
+			return true
+		}
+		for c := range alphabet {
+			queue = append(queue, pair{p.a.next(a, c), p.b.next(b, c)})
+		}
+	}
+	return false
+}
+
+// globsOverlap returns true if any path is matched by both globs.
+func globsOverlap(a, b glob) bool {
+	return walkGlobs(a, b, func(matchesA, matchesB bool) bool {
+		return matchesA && matchesB
+	})
+}
+
+// globCovers returns true if all paths matched by b are matched by a.
+func globCovers(a, b glob) bool {
+	return !walkGlobs(a, b, func(matchesA, matchesB bool) bool {
+		return matchesB && !matchesA
+	})
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		a, b     string
+		overlaps bool
+		covers   bool
+	}{
+		{a: "/etc/shadow", b: "/etc/**", overlaps: true},
+		{a: "/etc/*/**", b: "/etc/**", overlaps: true},
+		{a: "/etc/**", b: "/etc/*/**", overlaps: true, covers: true},
+		{a: "/{,**}", b: "/", overlaps: true, covers: true},
+		{a: "/{,**}", b: "/**", overlaps: true, covers: true},
+		{a: "/**", b: "/*", overlaps: true, covers: true},
+		{a: "/*", b: "/**", overlaps: true},
+		{a: "/tmp/**", b: "/*"},
+		{a: "/tmp/**", b: "/**", overlaps: true},
+		{a: "/tmp/**", b: "/etc/**"},
+		{a: "/e?c/[a-z]*", b: "/etc/**", overlaps: true},
+		{a: "/etc/\\*", b: "/etc/*", overlaps: true},
+		{a: "/{etc,usr}/{,lib/}**", b: "/usr/**", overlaps: true, covers: true},
+		{a: "@{PROC}/**", b: "/proc/**", overlaps: true, covers: true},
+		{a: "@{HOME}/**", b: "/etc/**"},
+		{a: "@{unknown}/x", b: "/etc/**", overlaps: true},
+	} {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := compileGlob(tc.a), compileGlob(tc.b)
+			require.Equal(t, tc.overlaps, globsOverlap(a, b))
+			require.Equal(t, tc.covers, globCovers(a, b))
+		})
+	}
+}
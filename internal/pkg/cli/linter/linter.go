@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+)
+
+// Linter is the main structure of this package.
+type Linter struct {
+	impl
+	options *Options
+}
+
+// New returns a new Linter instance.
+func New(options *Options) *Linter {
+	return &Linter{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Linter.
+func (l *Linter) Run() error {
+	config := &Config{}
+	if l.options.configFile != "" {
+		content, err := l.ReadFile(l.options.configFile)
+		if err != nil {
+			return fmt.Errorf("open config: %w", err)
+		}
+		if err := yaml.UnmarshalStrict(content, config); err != nil {
+			return fmt.Errorf("unmarshal config %s: %w", l.options.configFile, err)
+		}
+		if err := config.validate(); err != nil {
+			return fmt.Errorf("invalid config %s: %w", l.options.configFile, err)
+		}
+	}
+
+	findings := []Finding{}
+	for _, filepath := range l.options.inputFiles {
+		content, err := l.ReadFile(filepath)
+		if err != nil {
+			return fmt.Errorf("open profile: %w", err)
+		}
+		profile, err := artifact.ReadProfile(content)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filepath, err)
+		}
+
+		fileFindings, err := Lint(filepath, profile, config)
+		if err != nil {
+			return fmt.Errorf("lint %s: %w", filepath, err)
+		}
+		findings = append(findings, fileFindings...)
+	}
+
+	if l.options.format == FormatJSON {
+		out, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON findings: %w", err)
+		}
+		l.Printf("%s\n", out)
+	} else {
+		for _, finding := range findings {
+			l.Printf("%s: %s: %s (%s)\n", finding.File, finding.Severity, finding.Message, finding.Rule)
+		}
+	}
+
+	failed := 0
+	for _, finding := range findings {
+		if finding.Severity.atLeast(l.options.failOn) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("found %d problems with severity %s or higher", failed, l.options.failOn)
+	}
+
+	log.Printf("Linted %d profiles with %d findings", len(l.options.inputFiles), len(findings))
+	return nil
+}
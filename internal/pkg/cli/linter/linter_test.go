@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/linter/linterfakes"
+)
+
+const (
+	seccompProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ALLOW
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names: [read, ptrace, mount]
+  - action: SCMP_ACT_ERRNO
+    names: [bpf]
+`
+	appArmorProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      readOnlyPaths: [/**]
+      readWritePaths: [/tmp/**, /etc/**]
+    capability:
+      allowedCapabilities: [net_bind_service, CAP_SYS_ADMIN]
+    network:
+      allowRaw: true
+`
+	appArmorGlobProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha1
+kind: AppArmorProfile
+spec:
+  abstract:
+    filesystem:
+      writeOnlyPaths: [/etc/shadow, /var/log/**]
+      readWritePaths: [/etc/*/**, "/{,**}", "@{PROC}/sys/**", /tmp/**]
+`
+	selinuxProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1alpha2
+kind: SelinuxProfile
+spec:
+  permissive: true
+  allow:
+    var_log_t:
+      file: [read]
+    shadow_t:
+      file: [read]
+`
+	cleanProfile = `
+apiVersion: security-profiles-operator.x-k8s.io/v1beta1
+kind: SeccompProfile
+spec:
+  defaultAction: SCMP_ACT_ERRNO
+  syscalls:
+  - action: SCMP_ACT_ALLOW
+    names: [read, write]
+`
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		profile  string
+		config   *Config
+		expected []Finding
+	}{
+		{
+			name:    "seccomp",
+			profile: seccompProfile,
+			config:  &Config{},
+			expected: []Finding{
+				{
+					Rule: "seccomp-allow-default-action", Severity: SeverityError,
+					Message: "default action SCMP_ACT_ALLOW permits all syscalls which are not listed",
+				},
+				{
+					Rule: "seccomp-dangerous-syscalls", Severity: SeverityError,
+					Message: "dangerous syscall ptrace is permitted by SCMP_ACT_ALLOW",
+				},
+				{
+					Rule: "seccomp-dangerous-syscalls", Severity: SeverityError,
+					Message: "dangerous syscall mount is permitted by SCMP_ACT_ALLOW",
+				},
+			},
+		},
+		{
+			name:    "seccomp with config",
+			profile: seccompProfile,
+			config: &Config{Rules: map[string]RuleConfig{
+				"seccomp-allow-default-action": {Severity: SeverityOff},
+				"seccomp-dangerous-syscalls":   {Severity: SeverityWarning, Values: []string{"read"}},
+			}},
+			expected: []Finding{
+				{
+					Rule: "seccomp-dangerous-syscalls", Severity: SeverityWarning,
+					Message: "dangerous syscall read is permitted by SCMP_ACT_ALLOW",
+				},
+			},
+		},
+		{
+			name:    "apparmor",
+			profile: appArmorProfile,
+			config:  &Config{},
+			expected: []Finding{
+				{
+					Rule: "apparmor-broad-write-paths", Severity: SeverityError,
+					Message: "write access to /etc/** is allowed",
+				},
+				{
+					Rule: "apparmor-dangerous-capabilities", Severity: SeverityError,
+					Message: "dangerous capability CAP_SYS_ADMIN is allowed",
+				},
+				{
+					Rule: "apparmor-raw-network", Severity: SeverityWarning,
+					Message: "raw sockets are allowed",
+				},
+			},
+		},
+		{
+			name:    "apparmor globs",
+			profile: appArmorGlobProfile,
+			config:  &Config{},
+			expected: []Finding{
+				{
+					Rule: "apparmor-broad-write-paths", Severity: SeverityError,
+					Message: "write access to /etc/shadow is allowed",
+				},
+				{
+					Rule: "apparmor-broad-write-paths", Severity: SeverityError,
+					Message: "write access to /etc/*/** is allowed",
+				},
+				{
+					Rule: "apparmor-broad-write-paths", Severity: SeverityError,
+					Message: "write access to /{,**} is allowed",
+				},
+				{
+					Rule: "apparmor-broad-write-paths", Severity: SeverityError,
+					Message: "write access to @{PROC}/sys/** is allowed",
+				},
+			},
+		},
+		{
+			name:    "selinux",
+			profile: selinuxProfile,
+			config:  &Config{},
+			expected: []Finding{
+				{
+					Rule: "selinux-sensitive-types", Severity: SeverityError,
+					Message: "access to sensitive type shadow_t is allowed",
+				},
+				{
+					Rule: "selinux-permissive", Severity: SeverityWarning,
+					Message: "the profile is permissive and does not deny any access",
+				},
+			},
+		},
+		{
+			name:     "clean profile",
+			profile:  cleanProfile,
+			config:   &Config{},
+			expected: []Finding{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile, err := artifact.ReadProfile([]byte(tc.profile))
+			require.NoError(t, err)
+
+			findings, err := Lint("", profile, tc.config)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, findings)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		profile     string
+		config      string
+		failOn      Severity
+		expectedErr bool
+	}{
+		{
+			name:        "errors fail",
+			profile:     seccompProfile,
+			failOn:      SeverityError,
+			expectedErr: true,
+		},
+		{
+			name:    "warnings pass",
+			profile: appArmorProfile,
+			config: `
+rules:
+  apparmor-broad-write-paths:
+    severity: warning
+  apparmor-dangerous-capabilities:
+    severity: "off"
+`,
+			failOn: SeverityError,
+		},
+		{
+			name:    "warnings fail",
+			profile: appArmorProfile,
+			config: `
+rules:
+  apparmor-broad-write-paths:
+    severity: warning
+`,
+			failOn:      SeverityWarning,
+			expectedErr: true,
+		},
+		{
+			name:    "unknown rule",
+			profile: cleanProfile,
+			config: `
+rules:
+  unknown: {}
+`,
+			failOn:      SeverityError,
+			expectedErr: true,
+		},
+		{
+			name:    "clean profile",
+			profile: cleanProfile,
+			failOn:  SeverityInfo,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &linterfakes.FakeImpl{}
+			options := Default()
+			options.inputFiles = []string{"profile.yaml"}
+			options.failOn = tc.failOn
+			if tc.config != "" {
+				options.configFile = "config.yaml"
+				mock.ReadFileReturnsOnCall(0, []byte(tc.config), nil)
+				mock.ReadFileReturnsOnCall(1, []byte(tc.profile), nil)
+			} else {
+				mock.ReadFileReturns([]byte(tc.profile), nil)
+			}
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
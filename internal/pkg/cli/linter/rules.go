@@ -0,0 +1,344 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityOff disables a rule.
+	SeverityOff Severity = "off"
+	// SeverityInfo marks findings worth knowing.
+	SeverityInfo Severity = "info"
+	// SeverityWarning marks findings which weaken the profile.
+	SeverityWarning Severity = "warning"
+	// SeverityError marks findings which defeat the purpose of the profile.
+	SeverityError Severity = "error"
+)
+
+var severityLevels = map[Severity]int{
+	SeverityOff:     0,
+	SeverityInfo:    1,
+	SeverityWarning: 2,
+	SeverityError:   3,
+}
+
+func (s Severity) valid() bool {
+	_, ok := severityLevels[s]
+	return ok
+}
+
+// atLeast returns true if the severity is at least as high as the given one.
+func (s Severity) atLeast(other Severity) bool {
+	return severityLevels[s] >= severityLevels[other]
+}
+
+// Config allows to change the severity and values of the rules.
+type Config struct {
+	// Rules maps the rule IDs to their configuration.
+	Rules map[string]RuleConfig `json:"rules,omitempty"`
+}
+
+// RuleConfig is the configuration of a single rule.
+type RuleConfig struct {
+	// Severity overrides the default severity of the rule. Use "off" to
+	// disable the rule.
+	Severity Severity `json:"severity,omitempty"`
+	// Values replaces the default values checked by the rule, for example
+	// the list of dangerous syscalls.
+	Values []string `json:"values,omitempty"`
+}
+
+// Finding is a problem found in a profile.
+type Finding struct {
+	// File is the profile file containing the finding.
+	File string `json:"file"`
+	// Rule is the ID of the rule which reported the finding.
+	Rule string `json:"rule"`
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+type rule struct {
+	id       string
+	severity Severity
+	values   []string
+	check    func(profile client.Object, values []string) []string
+}
+
+var rules = []rule{
+	{
+		id:       "seccomp-allow-default-action",
+		severity: SeverityError,
+		values:   []string{string(seccomp.ActAllow), string(seccomp.ActLog)},
+		check:    checkSeccompDefaultAction,
+	},
+	{
+		id:       "seccomp-dangerous-syscalls",
+		severity: SeverityError,
+		values: []string{
+			"acct", "add_key", "bpf", "clock_settime", "delete_module", "finit_module",
+			"init_module", "iopl", "ioperm", "kexec_file_load", "kexec_load", "keyctl",
+			"mount", "move_mount", "open_by_handle_at", "perf_event_open", "pivot_root",
+			"process_vm_writev", "ptrace", "reboot", "request_key", "setns", "settimeofday",
+			"swapoff", "swapon", "umount2", "unshare", "userfaultfd",
+		},
+		check: checkSeccompSyscalls,
+	},
+	{
+		id:       "apparmor-broad-write-paths",
+		severity: SeverityError,
+		values: []string{
+			"/", "/*", "/**", "/boot/**", "/dev/**", "/etc/**", "/proc/**",
+			"/root/**", "/sys/**", "/usr/**",
+		},
+		check: checkAppArmorWritePaths,
+	},
+	{
+		id:       "apparmor-dangerous-capabilities",
+		severity: SeverityError,
+		values: []string{
+			"bpf", "dac_read_search", "mac_admin", "mac_override", "sys_admin",
+			"sys_boot", "sys_module", "sys_ptrace", "sys_rawio",
+		},
+		check: checkAppArmorCapabilities,
+	},
+	{
+		id:       "apparmor-raw-network",
+		severity: SeverityWarning,
+		check:    checkAppArmorRawNetwork,
+	},
+	{
+		id:       "selinux-sensitive-types",
+		severity: SeverityError,
+		values: []string{
+			"container_runtime_exec_t", "container_runtime_t", "container_var_run_t",
+			"init_t", "kernel_t", "memory_device_t", "proc_kmsg_t", "security_t",
+			"shadow_t", "unconfined_t",
+		},
+		check: checkSelinuxTypes,
+	},
+	{
+		id:       "selinux-permissive",
+		severity: SeverityWarning,
+		check:    checkSelinuxPermissive,
+	},
+}
+
+// Lint checks a profile against all enabled rules.
+func Lint(file string, profile client.Object, config *Config) ([]Finding, error) {
+	switch profile.(type) {
+	case *seccompprofileapi.SeccompProfile,
+		*apparmorprofileapi.AppArmorProfile,
+		*selinuxprofileapi.SelinuxProfile:
+	default:
+		return nil, fmt.Errorf("cannot lint %T profiles", profile)
+	}
+
+	findings := []Finding{}
+	for _, r := range rules {
+		severity, values := r.severity, r.values
+		if ruleConfig, ok := config.Rules[r.id]; ok {
+			if ruleConfig.Severity != "" {
+				severity = ruleConfig.Severity
+			}
+			if ruleConfig.Values != nil {
+				values = ruleConfig.Values
+			}
+		}
+		if severity == SeverityOff {
+			continue
+		}
+
+		for _, message := range r.check(profile, values) {
+			findings = append(findings, Finding{
+				File:     file,
+				Rule:     r.id,
+				Severity: severity,
+				Message:  message,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// validate returns an error if the configuration references unknown rules
+// or severities.
+func (c *Config) validate() error {
+	for id, ruleConfig := range c.Rules {
+		if !slices.ContainsFunc(rules, func(r rule) bool { return r.id == id }) {
+			return fmt.Errorf("unknown rule: %s", id)
+		}
+		if ruleConfig.Severity != "" && !ruleConfig.Severity.valid() {
+			return fmt.Errorf("unsupported severity of rule %s: %s", id, ruleConfig.Severity)
+		}
+	}
+	return nil
+}
+
+func checkSeccompDefaultAction(profile client.Object, values []string) []string {
+	sp, ok := profile.(*seccompprofileapi.SeccompProfile)
+	if !ok || !slices.Contains(values, string(sp.Spec.DefaultAction)) {
+		return nil
+	}
+	return []string{fmt.Sprintf("default action %s permits all syscalls which are not listed", sp.Spec.DefaultAction)}
+}
+
+func checkSeccompSyscalls(profile client.Object, values []string) []string {
+	sp, ok := profile.(*seccompprofileapi.SeccompProfile)
+	if !ok {
+		return nil
+	}
+
+	var messages []string
+	for _, syscall := range sp.Spec.Syscalls {
+		if syscall == nil || !permitsSyscall(syscall.Action) {
+			continue
+		}
+		for _, name := range syscall.Names {
+			if slices.Contains(values, name) {
+				messages = append(messages, fmt.Sprintf("dangerous syscall %s is permitted by %s", name, syscall.Action))
+			}
+		}
+	}
+	return messages
+}
+
+// permitsSyscall returns true if the action lets the syscall execute.
+func permitsSyscall(action seccomp.Action) bool {
+	switch action {
+	case seccomp.ActAllow, seccomp.ActLog, seccomp.ActTrace, seccomp.ActNotify:
+		return true
+	default:
+		return false
+	}
+}
+
+func checkAppArmorWritePaths(profile client.Object, values []string) []string {
+	ap, ok := profile.(*apparmorprofileapi.AppArmorProfile)
+	if !ok || ap.Spec.Abstract.Filesystem == nil {
+		return nil
+	}
+
+	var messages []string
+	fs := ap.Spec.Abstract.Filesystem
+	for _, paths := range []*[]string{fs.WriteOnlyPaths, fs.ReadWritePaths} {
+		if paths == nil {
+			continue
+		}
+		for _, path := range *paths {
+			if matchesWritePath(path, values) {
+				messages = append(messages, fmt.Sprintf("write access to %s is allowed", path))
+			}
+		}
+	}
+	for _, rule := range fs.FileRules {
+		if matchesWritePath(rule.Path, values) &&
+			(slices.Contains(rule.Permissions, apparmorprofileapi.AppArmorFilePermissionWrite) ||
+				slices.Contains(rule.Permissions, apparmorprofileapi.AppArmorFilePermissionAppend)) {
+			messages = append(messages, fmt.Sprintf("write access to %s is allowed", rule.Path))
+		}
+	}
+	return messages
+}
+
+// matchesWritePath returns true if the path glob allows writing to any of the
+// values. Values below the root directory like /etc/** match every path which
+// allows writing to one of their files, while values like / or /** only match
+// paths which allow writing to all of their files.
+func matchesWritePath(path string, values []string) bool {
+	pathGlob := compileGlob(path)
+	for _, value := range values {
+		valueGlob := compileGlob(value)
+		prefix := value
+		if i := strings.IndexAny(value, "*?[{@\\"); i >= 0 {
+			prefix = value[:i]
+		}
+		if strings.Count(prefix, "/") > 1 {
+			if globsOverlap(pathGlob, valueGlob) {
+				return true
+			}
+		} else if globCovers(pathGlob, valueGlob) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkAppArmorCapabilities(profile client.Object, values []string) []string {
+	ap, ok := profile.(*apparmorprofileapi.AppArmorProfile)
+	if !ok || ap.Spec.Abstract.Capability == nil {
+		return nil
+	}
+
+	var messages []string
+	for _, capability := range ap.Spec.Abstract.Capability.AllowedCapabilities {
+		normalized := strings.TrimPrefix(strings.ToLower(capability), "cap_")
+		if slices.Contains(values, normalized) {
+			messages = append(messages, fmt.Sprintf("dangerous capability %s is allowed", capability))
+		}
+	}
+	return messages
+}
+
+func checkAppArmorRawNetwork(profile client.Object, _ []string) []string {
+	ap, ok := profile.(*apparmorprofileapi.AppArmorProfile)
+	if !ok || ap.Spec.Abstract.Network == nil || ap.Spec.Abstract.Network.AllowRaw == nil ||
+		!*ap.Spec.Abstract.Network.AllowRaw {
+		return nil
+	}
+	return []string{"raw sockets are allowed"}
+}
+
+func checkSelinuxTypes(profile client.Object, values []string) []string {
+	sp, ok := profile.(*selinuxprofileapi.SelinuxProfile)
+	if !ok {
+		return nil
+	}
+
+	var messages []string
+	for label := range sp.Spec.Allow {
+		if slices.Contains(values, string(label)) {
+			messages = append(messages, fmt.Sprintf("access to sensitive type %s is allowed", label))
+		}
+	}
+	slices.Sort(messages)
+	return messages
+}
+
+func checkSelinuxPermissive(profile client.Object, _ []string) []string {
+	sp, ok := profile.(*selinuxprofileapi.SelinuxProfile)
+	if !ok || !sp.Spec.Permissive {
+		return nil
+	}
+	return []string{"the profile is permissive and does not deny any access"}
+}
@@ -40,6 +40,10 @@ const (
 	// externally and should not be started.
 	FlagNoProcStart string = "no-proc-start"
 
+	// FlagRecordSyscallArgs is the flag for defining the syscalls for which
+	// the argument values should be recorded.
+	FlagRecordSyscallArgs string = "record-syscall-args"
+
 	// FlagPrivileged is the flag for running commands without dropping sudo privileges.
 	FlagPrivileged string = command.FlagPrivileged
 )
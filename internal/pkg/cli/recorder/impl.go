@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
 
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 )
@@ -54,6 +55,8 @@ type impl interface {
 	IteratorNext(*libbpfgo.BPFMapIterator) bool
 	IteratorKey(*libbpfgo.BPFMapIterator) []byte
 	SyscallsGetValue(*bpfrecorder.BpfRecorder, uint32) ([]byte, error)
+	SetSyscallArgs(*bpfrecorder.BpfRecorder, []string) error
+	SyscallArgs(*bpfrecorder.BpfRecorder, uint32) []*api.SyscallArgs
 	GetName(libseccomp.ScmpSyscall) (string, error)
 	MarshalIndent(any, string, string) ([]byte, error)
 	Create(string) (io.WriteCloser, error)
@@ -110,6 +113,14 @@ func (*defaultImpl) SyscallsGetValue(b *bpfrecorder.BpfRecorder, mntns uint32) (
 	return b.Syscalls().GetValue(unsafe.Pointer(&mntns))
 }
 
+func (*defaultImpl) SetSyscallArgs(b *bpfrecorder.BpfRecorder, syscalls []string) error {
+	return b.Seccomp.SetSyscallArgs(syscalls)
+}
+
+func (*defaultImpl) SyscallArgs(b *bpfrecorder.BpfRecorder, mntns uint32) []*api.SyscallArgs {
+	return b.Seccomp.SyscallArgs(b, mntns)
+}
+
 func (*defaultImpl) GetName(s libseccomp.ScmpSyscall) (string, error) {
 	return s.GetName()
 }
@@ -23,15 +23,17 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 )
 
 // Options define all possible options for the recorder.
 type Options struct {
-	commandOptions *command.Options
-	typ            Type
-	outputFile     string
-	baseSyscalls   []string
-	noProcStart    bool
+	commandOptions    *command.Options
+	typ               Type
+	outputFile        string
+	baseSyscalls      []string
+	noProcStart       bool
+	recordSyscallArgs []string
 }
 
 // Default returns a default options instance.
@@ -74,6 +76,13 @@ func FromContext(ctx *cli.Context) (*Options, error) {
 		options.noProcStart = true
 	}
 
+	if ctx.IsSet(FlagRecordSyscallArgs) {
+		options.recordSyscallArgs = ctx.StringSlice(FlagRecordSyscallArgs)
+		if err := bpfrecorder.ValidateSyscallArgs(options.recordSyscallArgs); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagRecordSyscallArgs, err)
+		}
+	}
+
 	commandOptions, err := command.FromContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get command options: %w", err)
@@ -53,6 +53,26 @@ func TestFromContext(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{ // Success with syscall args
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRecordSyscallArgs, "")
+				require.NoError(t, set.Set(FlagRecordSyscallArgs, "prctl"))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{ // failure: unsupported syscall args
+			prepare: func(set *flag.FlagSet) {
+				set.Var(cli.NewStringSlice(), FlagRecordSyscallArgs, "")
+				require.NoError(t, set.Set(FlagRecordSyscallArgs, "read"))
+				require.NoError(t, set.Parse([]string{"echo"}))
+			},
+			assert: func(err error) {
+				require.Error(t, err)
+			},
+		},
 		{ // failure: no filename provided
 			prepare: func(set *flag.FlagSet) {
 				set.String(FlagOutputFile, "", "")
@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
@@ -93,6 +94,12 @@ func (r *Recorder) Run() error {
 		recordAppArmor,
 	)
 
+	if recordSeccomp {
+		if err := r.SetSyscallArgs(r.bpfRecorder, r.options.recordSyscallArgs); err != nil {
+			return fmt.Errorf("set syscall args: %w", err)
+		}
+	}
+
 	if err := r.LoadBpfRecorder(r.bpfRecorder); err != nil {
 		return fmt.Errorf("load: %w", err)
 	}
@@ -217,7 +224,8 @@ func (r *Recorder) processSeccomp(writer io.Writer, mntns uint32) error {
 	}
 
 	log.Printf("Got syscalls: %s", strings.Join(syscalls, ", "))
-	if err := r.buildProfile(writer, syscalls); err != nil {
+	args := r.SyscallArgs(r.bpfRecorder, mntns)
+	if err := r.buildProfile(writer, syscalls, args); err != nil {
 		return fmt.Errorf("build profile: %w", err)
 	}
 
@@ -248,7 +256,8 @@ func (r *Recorder) generateAppArmorProfile(mntns uint32) apparmorprofileapi.AppA
 
 	if (len(processed.FileProcessed.ReadOnlyPaths) != 0) ||
 		(len(processed.FileProcessed.WriteOnlyPaths) != 0) ||
-		(len(processed.FileProcessed.ReadWritePaths) != 0) {
+		(len(processed.FileProcessed.ReadWritePaths) != 0) ||
+		(len(processed.FileProcessed.WritableLibraries) != 0) {
 		files := apparmorprofileapi.AppArmorFsRules{}
 		if len(processed.FileProcessed.ReadOnlyPaths) != 0 {
 			sort.Strings(processed.FileProcessed.ReadOnlyPaths)
@@ -268,6 +277,16 @@ func (r *Recorder) generateAppArmorProfile(mntns uint32) apparmorprofileapi.AppA
 			copy(FileReadWriteCopy, processed.FileProcessed.ReadWritePaths)
 			files.ReadWritePaths = &FileReadWriteCopy
 		}
+		for _, library := range processed.FileProcessed.WritableLibraries {
+			files.FileRules = append(files.FileRules, apparmorprofileapi.AppArmorFileRule{
+				Path: library,
+				Permissions: []apparmorprofileapi.AppArmorFilePermission{
+					apparmorprofileapi.AppArmorFilePermissionRead,
+					apparmorprofileapi.AppArmorFilePermissionWrite,
+					apparmorprofileapi.AppArmorFilePermissionMmap,
+				},
+			})
+		}
 		abstract.Filesystem = &files
 	}
 
@@ -288,6 +307,10 @@ func (r *Recorder) generateAppArmorProfile(mntns uint32) apparmorprofileapi.AppA
 		abstract.Network = &net
 	}
 
+	for _, unixType := range processed.Socket.UnixTypes {
+		abstract.Unix = append(abstract.Unix, apparmorprofileapi.AppArmorUnixRule{Type: unixType})
+	}
+
 	if len(processed.Capabilities) != 0 {
 		capabilities := apparmorprofileapi.AppArmorCapabilityRules{}
 		capabilities.AllowedCapabilities = processed.Capabilities
@@ -337,7 +360,7 @@ func (r *Recorder) processAppArmor(writer io.Writer, mntns uint32) error {
 	return r.buildAppArmorProfileCRD(writer, &spec)
 }
 
-func (r *Recorder) buildProfile(writer io.Writer, names []string) error {
+func (r *Recorder) buildProfile(writer io.Writer, names []string, args []*api.SyscallArgs) error {
 	arch, err := r.goArchToSeccompArch(runtime.GOARCH)
 	if err != nil {
 		return fmt.Errorf("get seccomp arch: %w", err)
@@ -358,10 +381,7 @@ func (r *Recorder) buildProfile(writer io.Writer, names []string) error {
 	spec := seccompprofileapi.SeccompProfileSpec{
 		DefaultAction: seccomp.ActErrno,
 		Architectures: []seccompprofileapi.Arch{arch},
-		Syscalls: []*seccompprofileapi.Syscall{{
-			Action: seccomp.ActAllow,
-			Names:  names,
-		}},
+		Syscalls:      bpfrecorder.SyscallRules(names, args, r.options.recordSyscallArgs),
 	}
 
 	defer func() {
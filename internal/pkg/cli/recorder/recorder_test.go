@@ -27,6 +27,8 @@ import (
 	"github.com/containers/common/pkg/seccomp"
 	"github.com/stretchr/testify/require"
 
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/recorder/recorderfakes"
 )
 
@@ -113,6 +115,47 @@ func TestRun(t *testing.T) {
 				require.Equal(t, 1, mock.CreateCallCount())
 			},
 		},
+		{
+			name: "success raw seccomp profile with syscall args",
+			prepare: func(mock *recorderfakes.FakeImpl) *Options {
+				defaultMock(mock)
+				mock.GetNameReturns("prctl", nil)
+				mock.SyscallArgsReturns([]*api.SyscallArgs{{
+					Name: "prctl",
+					Observed: []*api.SyscallArgs_Values{
+						{Values: []uint64{38, 0, 0, 0, 0, 0}},
+					},
+				}})
+				options := Default()
+				options.typ = TypeRawSeccomp
+				options.recordSyscallArgs = []string{"prctl"}
+				return options
+			},
+			assert: func(mock *recorderfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.SetSyscallArgsCallCount())
+				_, syscalls := mock.SetSyscallArgsArgsForCall(0)
+				require.Equal(t, []string{"prctl"}, syscalls)
+				spec, _, _ := mock.MarshalIndentArgsForCall(0)
+				profile, isSpec := spec.(*seccompprofileapi.SeccompProfileSpec)
+				require.True(t, isSpec)
+				require.Len(t, profile.Syscalls, 2)
+				require.NotContains(t, profile.Syscalls[0].Names, "prctl")
+				require.Equal(t, []string{"prctl"}, profile.Syscalls[1].Names)
+				require.Equal(t, uint64(38), profile.Syscalls[1].Args[0].Value)
+			},
+		},
+		{
+			name: "failure seccomp CRD on SetSyscallArgs",
+			prepare: func(mock *recorderfakes.FakeImpl) *Options {
+				defaultMock(mock)
+				mock.SetSyscallArgsReturns(errTest)
+				return Default()
+			},
+			assert: func(mock *recorderfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
 		{
 			name: "failure seccomp CRD on Create",
 			prepare: func(mock *recorderfakes.FakeImpl) *Options {
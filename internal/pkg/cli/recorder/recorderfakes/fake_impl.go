@@ -31,6 +31,7 @@ import (
 	seccomp "github.com/seccomp/libseccomp-golang"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+	api_bpfrecorder "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/command"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 )
@@ -190,6 +191,18 @@ type FakeImpl struct {
 	printObjReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetSyscallArgsStub        func(*bpfrecorder.BpfRecorder, []string) error
+	setSyscallArgsMutex       sync.RWMutex
+	setSyscallArgsArgsForCall []struct {
+		arg1 *bpfrecorder.BpfRecorder
+		arg2 []string
+	}
+	setSyscallArgsReturns struct {
+		result1 error
+	}
+	setSyscallArgsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartBpfRecordingStub        func(*bpfrecorder.BpfRecorder) error
 	startBpfRecordingMutex       sync.RWMutex
 	startBpfRecordingArgsForCall []struct {
@@ -212,6 +225,18 @@ type FakeImpl struct {
 	stopBpfRecordingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SyscallArgsStub        func(*bpfrecorder.BpfRecorder, uint32) []*api_bpfrecorder.SyscallArgs
+	syscallArgsMutex       sync.RWMutex
+	syscallArgsArgsForCall []struct {
+		arg1 *bpfrecorder.BpfRecorder
+		arg2 uint32
+	}
+	syscallArgsReturns struct {
+		result1 []*api_bpfrecorder.SyscallArgs
+	}
+	syscallArgsReturnsOnCall map[int]struct {
+		result1 []*api_bpfrecorder.SyscallArgs
+	}
 	SyscallsGetValueStub        func(*bpfrecorder.BpfRecorder, uint32) ([]byte, error)
 	syscallsGetValueMutex       sync.RWMutex
 	syscallsGetValueArgsForCall []struct {
@@ -1034,6 +1059,73 @@ func (fake *FakeImpl) PrintObjReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) SetSyscallArgs(arg1 *bpfrecorder.BpfRecorder, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.setSyscallArgsMutex.Lock()
+	ret, specificReturn := fake.setSyscallArgsReturnsOnCall[len(fake.setSyscallArgsArgsForCall)]
+	fake.setSyscallArgsArgsForCall = append(fake.setSyscallArgsArgsForCall, struct {
+		arg1 *bpfrecorder.BpfRecorder
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.SetSyscallArgsStub
+	fakeReturns := fake.setSyscallArgsReturns
+	fake.recordInvocation("SetSyscallArgs", []interface{}{arg1, arg2Copy})
+	fake.setSyscallArgsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SetSyscallArgsCallCount() int {
+	fake.setSyscallArgsMutex.RLock()
+	defer fake.setSyscallArgsMutex.RUnlock()
+	return len(fake.setSyscallArgsArgsForCall)
+}
+
+func (fake *FakeImpl) SetSyscallArgsCalls(stub func(*bpfrecorder.BpfRecorder, []string) error) {
+	fake.setSyscallArgsMutex.Lock()
+	defer fake.setSyscallArgsMutex.Unlock()
+	fake.SetSyscallArgsStub = stub
+}
+
+func (fake *FakeImpl) SetSyscallArgsArgsForCall(i int) (*bpfrecorder.BpfRecorder, []string) {
+	fake.setSyscallArgsMutex.RLock()
+	defer fake.setSyscallArgsMutex.RUnlock()
+	argsForCall := fake.setSyscallArgsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SetSyscallArgsReturns(result1 error) {
+	fake.setSyscallArgsMutex.Lock()
+	defer fake.setSyscallArgsMutex.Unlock()
+	fake.SetSyscallArgsStub = nil
+	fake.setSyscallArgsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) SetSyscallArgsReturnsOnCall(i int, result1 error) {
+	fake.setSyscallArgsMutex.Lock()
+	defer fake.setSyscallArgsMutex.Unlock()
+	fake.SetSyscallArgsStub = nil
+	if fake.setSyscallArgsReturnsOnCall == nil {
+		fake.setSyscallArgsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSyscallArgsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) StartBpfRecording(arg1 *bpfrecorder.BpfRecorder) error {
 	fake.startBpfRecordingMutex.Lock()
 	ret, specificReturn := fake.startBpfRecordingReturnsOnCall[len(fake.startBpfRecordingArgsForCall)]
@@ -1156,6 +1248,68 @@ func (fake *FakeImpl) StopBpfRecordingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) SyscallArgs(arg1 *bpfrecorder.BpfRecorder, arg2 uint32) []*api_bpfrecorder.SyscallArgs {
+	fake.syscallArgsMutex.Lock()
+	ret, specificReturn := fake.syscallArgsReturnsOnCall[len(fake.syscallArgsArgsForCall)]
+	fake.syscallArgsArgsForCall = append(fake.syscallArgsArgsForCall, struct {
+		arg1 *bpfrecorder.BpfRecorder
+		arg2 uint32
+	}{arg1, arg2})
+	stub := fake.SyscallArgsStub
+	fakeReturns := fake.syscallArgsReturns
+	fake.recordInvocation("SyscallArgs", []interface{}{arg1, arg2})
+	fake.syscallArgsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) SyscallArgsCallCount() int {
+	fake.syscallArgsMutex.RLock()
+	defer fake.syscallArgsMutex.RUnlock()
+	return len(fake.syscallArgsArgsForCall)
+}
+
+func (fake *FakeImpl) SyscallArgsCalls(stub func(*bpfrecorder.BpfRecorder, uint32) []*api_bpfrecorder.SyscallArgs) {
+	fake.syscallArgsMutex.Lock()
+	defer fake.syscallArgsMutex.Unlock()
+	fake.SyscallArgsStub = stub
+}
+
+func (fake *FakeImpl) SyscallArgsArgsForCall(i int) (*bpfrecorder.BpfRecorder, uint32) {
+	fake.syscallArgsMutex.RLock()
+	defer fake.syscallArgsMutex.RUnlock()
+	argsForCall := fake.syscallArgsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) SyscallArgsReturns(result1 []*api_bpfrecorder.SyscallArgs) {
+	fake.syscallArgsMutex.Lock()
+	defer fake.syscallArgsMutex.Unlock()
+	fake.SyscallArgsStub = nil
+	fake.syscallArgsReturns = struct {
+		result1 []*api_bpfrecorder.SyscallArgs
+	}{result1}
+}
+
+func (fake *FakeImpl) SyscallArgsReturnsOnCall(i int, result1 []*api_bpfrecorder.SyscallArgs) {
+	fake.syscallArgsMutex.Lock()
+	defer fake.syscallArgsMutex.Unlock()
+	fake.SyscallArgsStub = nil
+	if fake.syscallArgsReturnsOnCall == nil {
+		fake.syscallArgsReturnsOnCall = make(map[int]struct {
+			result1 []*api_bpfrecorder.SyscallArgs
+		})
+	}
+	fake.syscallArgsReturnsOnCall[i] = struct {
+		result1 []*api_bpfrecorder.SyscallArgs
+	}{result1}
+}
+
 func (fake *FakeImpl) SyscallsGetValue(arg1 *bpfrecorder.BpfRecorder, arg2 uint32) ([]byte, error) {
 	fake.syscallsGetValueMutex.Lock()
 	ret, specificReturn := fake.syscallsGetValueReturnsOnCall[len(fake.syscallsGetValueArgsForCall)]
@@ -1374,10 +1528,14 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.notifyMutex.RUnlock()
 	fake.printObjMutex.RLock()
 	defer fake.printObjMutex.RUnlock()
+	fake.setSyscallArgsMutex.RLock()
+	defer fake.setSyscallArgsMutex.RUnlock()
 	fake.startBpfRecordingMutex.RLock()
 	defer fake.startBpfRecordingMutex.RUnlock()
 	fake.stopBpfRecordingMutex.RLock()
 	defer fake.stopBpfRecordingMutex.RUnlock()
+	fake.syscallArgsMutex.RLock()
+	defer fake.syscallArgsMutex.RUnlock()
 	fake.syscallsGetValueMutex.RLock()
 	defer fake.syscallsGetValueMutex.RUnlock()
 	fake.syscallsIteratorMutex.RLock()
@@ -0,0 +1,31 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+const (
+	// FlagNamespace is the flag for defining the namespace of the profile.
+	FlagNamespace string = "namespace"
+
+	// FlagRevision is the flag for defining the revision to roll back to.
+	FlagRevision string = "revision"
+
+	// FlagList is the flag for listing the revisions instead of rolling back.
+	FlagList string = "list"
+
+	// DefaultNamespace is the default namespace of the profile.
+	DefaultNamespace string = "default"
+)
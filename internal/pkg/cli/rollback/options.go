@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+const (
+	kindSeccompProfile  = "SeccompProfile"
+	kindAppArmorProfile = "AppArmorProfile"
+)
+
+// Options define all possible options for the rollback.
+type Options struct {
+	kind      string
+	name      string
+	namespace string
+	revision  int64
+	list      bool
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		namespace: DefaultNamespace,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	const expectedArgs = 2
+	args := ctx.Args().Slice()
+	if len(args) != expectedArgs {
+		return nil, errors.New("expected the profile kind and name")
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "seccompprofile", "seccompprofiles", "sp":
+		options.kind = kindSeccompProfile
+	case "apparmorprofile", "apparmorprofiles", "aa":
+		options.kind = kindAppArmorProfile
+	default:
+		return nil, fmt.Errorf("unsupported profile kind: %s", args[0])
+	}
+	options.name = args[1]
+
+	if ctx.IsSet(FlagNamespace) {
+		options.namespace = ctx.String(FlagNamespace)
+	}
+	if options.namespace == "" {
+		return nil, errors.New("no namespace provided")
+	}
+
+	if ctx.IsSet(FlagRevision) {
+		options.revision = ctx.Int64(FlagRevision)
+		if options.revision < 1 {
+			return nil, fmt.Errorf("invalid revision: %d", options.revision)
+		}
+	}
+
+	options.list = ctx.Bool(FlagList)
+
+	return options, nil
+}
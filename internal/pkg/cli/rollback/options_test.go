@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		prepare func(*flag.FlagSet)
+		assert  func(*Options, error)
+	}{
+		{ // Success
+			prepare: func(set *flag.FlagSet) {
+				require.NoError(t, set.Parse([]string{"sp", "nginx"}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kindSeccompProfile, options.kind)
+				require.Equal(t, DefaultNamespace, options.namespace)
+			},
+		},
+		{ // Success with revision and namespace
+			prepare: func(set *flag.FlagSet) {
+				set.String(FlagNamespace, "", "")
+				set.Int64(FlagRevision, 0, "")
+				require.NoError(t, set.Parse([]string{
+					"--" + FlagNamespace, "team-a", "--" + FlagRevision, "2", "AppArmorProfile", "nginx",
+				}))
+			},
+			assert: func(options *Options, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kindAppArmorProfile, options.kind)
+				require.Equal(t, "team-a", options.namespace)
+				require.EqualValues(t, 2, options.revision)
+			},
+		},
+		{ // failure: no profile provided
+			prepare: func(set *flag.FlagSet) {},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // failure: unsupported kind
+			prepare: func(set *flag.FlagSet) {
+				require.NoError(t, set.Parse([]string{"selinuxprofile", "nginx"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+		{ // failure: invalid revision
+			prepare: func(set *flag.FlagSet) {
+				set.Int64(FlagRevision, 0, "")
+				require.NoError(t, set.Parse([]string{"--" + FlagRevision, "0", "sp", "nginx"}))
+			},
+			assert: func(_ *Options, err error) {
+				require.Error(t, err)
+			},
+		},
+	} {
+		set := flag.NewFlagSet("", flag.ExitOnError)
+		tc.prepare(set)
+
+		app := cli.NewApp()
+		ctx := cli.NewContext(app, set, nil)
+
+		options, err := FromContext(ctx)
+		tc.assert(options, err)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/revision"
+)
+
+// Rollback is the main structure of this package.
+type Rollback struct {
+	impl
+	options *Options
+}
+
+// New returns a new Rollback instance.
+func New(options *Options) *Rollback {
+	return &Rollback{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Rollback.
+func (r *Rollback) Run() error {
+	ctx := context.Background()
+
+	cli, err := r.NewClient()
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	var prof client.Object = &seccompprofileapi.SeccompProfile{}
+	if r.options.kind == kindAppArmorProfile {
+		prof = &apparmorprofileapi.AppArmorProfile{}
+	}
+	key := types.NamespacedName{Namespace: r.options.namespace, Name: r.options.name}
+	if err := cli.Get(ctx, key, prof); err != nil {
+		return fmt.Errorf("get %s %s: %w", r.options.kind, key, err)
+	}
+
+	revisions, err := revision.ListRevisions(ctx, cli, r.options.kind, r.options.namespace, r.options.name)
+	if err != nil {
+		return fmt.Errorf("get revisions of %s %s: %w", r.options.kind, key, err)
+	}
+
+	if r.options.list {
+		r.Printf("REVISION\tCREATED\n")
+		for i := range revisions {
+			current := ""
+			if revision.Revision(&revisions[i]) == prof.GetGeneration() {
+				current = "\t(current)"
+			}
+			r.Printf("%d\t%s%s\n",
+				revision.Revision(&revisions[i]), revisions[i].CreationTimestamp.UTC().Format(time.RFC3339), current)
+		}
+		return nil
+	}
+
+	target := r.options.revision
+	if target == 0 {
+		// Default to the latest revision before the current one
+		for i := range revisions {
+			if rev := revision.Revision(&revisions[i]); rev < prof.GetGeneration() {
+				target = rev
+			}
+		}
+		if target == 0 {
+			return errors.New("no previous revision found")
+		}
+	}
+
+	found := false
+	for i := range revisions {
+		if revision.Revision(&revisions[i]) == target {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("revision %d of %s %s not found", target, r.options.kind, key)
+	}
+
+	patched, ok := prof.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("copy %s %s", r.options.kind, key)
+	}
+	annotations := patched.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[profilebasev1alpha1.RollbackToRevisionAnnotation] = strconv.FormatInt(target, 10)
+	patched.SetAnnotations(annotations)
+
+	if err := cli.Patch(ctx, patched, client.MergeFrom(prof)); err != nil {
+		return fmt.Errorf("request rollback of %s %s: %w", r.options.kind, key, err)
+	}
+
+	log.Printf("Requested rollback of %s %s to revision %d", r.options.kind, key, target)
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/rollback/rollbackfakes"
+)
+
+func revisionConfigMap(revision int64) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "seccompprofile-nginx-rev-" + strconv.FormatInt(revision, 10),
+		Namespace: DefaultNamespace,
+		Labels: map[string]string{
+			profilebasev1alpha1.ProfileRevisionOfLabel: "seccompprofile-nginx",
+			profilebasev1alpha1.ProfileRevisionLabel:   strconv.FormatInt(revision, 10),
+		},
+	}}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+
+	for _, tc := range []struct {
+		name             string
+		revision         int64
+		list             bool
+		clientErr        error
+		expectedErr      bool
+		expectedRollback string
+	}{
+		{
+			name:             "previous revision",
+			expectedRollback: "2",
+		},
+		{
+			name:             "given revision",
+			revision:         1,
+			expectedRollback: "1",
+		},
+		{
+			name:        "missing revision",
+			revision:    4,
+			expectedErr: true,
+		},
+		{
+			name: "list revisions",
+			list: true,
+		},
+		{
+			name:        "client failure",
+			clientErr:   errors.New("test"),
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := &seccompprofileapi.SeccompProfile{ObjectMeta: metav1.ObjectMeta{
+				Name:       "nginx",
+				Namespace:  DefaultNamespace,
+				Generation: 3,
+			}}
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(
+				profile, revisionConfigMap(1), revisionConfigMap(2), revisionConfigMap(3),
+			).Build()
+
+			mock := &rollbackfakes.FakeImpl{}
+			mock.NewClientReturns(cli, tc.clientErr)
+
+			options := Default()
+			options.kind = kindSeccompProfile
+			options.name = "nginx"
+			options.revision = tc.revision
+			options.list = tc.list
+			sut := New(options)
+			sut.impl = mock
+
+			err := sut.Run()
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			res := &seccompprofileapi.SeccompProfile{}
+			require.NoError(t, cli.Get(context.Background(),
+				types.NamespacedName{Name: "nginx", Namespace: DefaultNamespace}, res))
+			if tc.expectedRollback == "" {
+				require.NotContains(t, res.Annotations, profilebasev1alpha1.RollbackToRevisionAnnotation)
+			} else {
+				require.Equal(t, tc.expectedRollback, res.Annotations[profilebasev1alpha1.RollbackToRevisionAnnotation])
+			}
+			if tc.list {
+				require.Equal(t, 4, mock.PrintfCallCount())
+			}
+		})
+	}
+}
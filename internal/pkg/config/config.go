@@ -88,6 +88,10 @@ const (
 	// EnableBpfRecorderEnvKey is the environment variable key for enabling the BPF recorder.
 	EnableBpfRecorderEnvKey = "ENABLE_BPF_RECORDER"
 
+	// BpfRecorderSyscallArgsEnvKey is the environment variable key for the
+	// syscalls whose argument values get recorded by the BPF recorder.
+	BpfRecorderSyscallArgsEnvKey = "BPF_RECORDER_SYSCALL_ARGS"
+
 	// EnableRecordingEnvKey is the environment variable key to enabling profile recording.
 	EnableRecordingEnvKey = "ENABLE_RECORDING"
 
@@ -105,6 +109,42 @@ const (
 	// KubeletDirEnvKey is the environment variable key for custom kubelet directory.
 	KubeletDirEnvKey = "KUBELET_DIR"
 
+	// LogEnricherSourceEnvKey is the environment variable key for selecting
+	// the audit source of the log enricher.
+	LogEnricherSourceEnvKey = "LOG_ENRICHER_SOURCE"
+
+	// LogEnricherExportFileEnvKey is the environment variable key for the
+	// JSON lines file the log enricher exports the events to.
+	LogEnricherExportFileEnvKey = "LOG_ENRICHER_EXPORT_FILE"
+
+	// LogEnricherExportFileMaxSizeEnvKey is the environment variable key for
+	// the size in megabytes after which the export file gets rotated.
+	LogEnricherExportFileMaxSizeEnvKey = "LOG_ENRICHER_EXPORT_FILE_MAX_SIZE"
+
+	// LogEnricherExportFileMaxBackupsEnvKey is the environment variable key
+	// for the number of rotated export files to keep.
+	LogEnricherExportFileMaxBackupsEnvKey = "LOG_ENRICHER_EXPORT_FILE_MAX_BACKUPS"
+
+	// LogEnricherExportWebhookURLEnvKey is the environment variable key for
+	// the webhook URL the log enricher exports the events to.
+	LogEnricherExportWebhookURLEnvKey = "LOG_ENRICHER_EXPORT_WEBHOOK_URL"
+
+	// LogEnricherExportWebhookAuthorizationEnvKey is the environment variable
+	// key for the Authorization header of the export webhook requests.
+	LogEnricherExportWebhookAuthorizationEnvKey = "LOG_ENRICHER_EXPORT_WEBHOOK_AUTHORIZATION"
+
+	// LogEnricherExportWebhookBatchSizeEnvKey is the environment variable key
+	// for the maximum number of events per export webhook request.
+	LogEnricherExportWebhookBatchSizeEnvKey = "LOG_ENRICHER_EXPORT_WEBHOOK_BATCH_SIZE"
+
+	// LogEnricherExportWebhookFlushIntervalEnvKey is the environment variable
+	// key for the interval after which pending events get sent.
+	LogEnricherExportWebhookFlushIntervalEnvKey = "LOG_ENRICHER_EXPORT_WEBHOOK_FLUSH_INTERVAL"
+
+	// LogEnricherExportWebhookMaxRetriesEnvKey is the environment variable key
+	// for the number of retries of failed export webhook requests.
+	LogEnricherExportWebhookMaxRetriesEnvKey = "LOG_ENRICHER_EXPORT_WEBHOOK_MAX_RETRIES"
+
 	// DefaultProfilingPort is the start port where the profiling endpoint runs.
 	DefaultProfilingPort = 6060
 
@@ -143,6 +183,21 @@ const (
 	// SyslogLogPath is the path to the syslog log file.
 	SyslogLogPath = "/var/log/syslog"
 
+	// LogEnricherExportDir is the path where the directory of the log
+	// enricher export file gets mounted into the container.
+	LogEnricherExportDir = "/var/run/spo-export"
+
+	// HostRootPath is the path to the root file system of the host, which
+	// requires the host PID namespace.
+	HostRootPath = "/proc/1/root"
+
+	// HostNetNsPath is the path to the network namespace of the host, which
+	// requires the host PID namespace.
+	HostNetNsPath = "/proc/1/ns/net"
+
+	// JournalctlPath is the path to the journalctl binary on the host.
+	JournalctlPath = "/usr/bin/journalctl"
+
 	// LogEnricherProfile is the seccomp profile name for tracing syscalls from
 	// the log enricher.
 	LogEnricherProfile = "log-enricher-trace"
@@ -33,6 +33,7 @@ import (
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/armor2crd"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
 )
 
@@ -66,24 +67,37 @@ func (a *aaProfileManager) Enabled() bool {
 }
 
 func (a *aaProfileManager) RemoveProfile(bp profilebasev1alpha1.StatusBaseUser) error {
-	profile, ok := bp.(*v1alpha1.AppArmorProfile)
-	if !ok {
+	switch profile := bp.(type) {
+	case *v1alpha1.AppArmorProfile:
+		return a.removeProfile(a.logger, profile.GetProfileName())
+	case *v1alpha1.RawAppArmorProfile:
+		return a.removeProfile(a.logger, profile.GetProfileName())
+	default:
 		return errors.New(errInvalidCustomResourceType)
 	}
-	return a.removeProfile(a.logger, profile.GetProfileName())
 }
 
 func (a *aaProfileManager) InstallProfile(bp profilebasev1alpha1.StatusBaseUser) (bool, error) {
-	profile, ok := bp.(*v1alpha1.AppArmorProfile)
-	if !ok {
+	switch profile := bp.(type) {
+	case *v1alpha1.AppArmorProfile:
+		policy, err := crd2armor.GenerateProfile(
+			profile.GetProfileName(), profile.Spec.ComplainMode, &profile.Spec.Abstract,
+		)
+		if err != nil {
+			return false, fmt.Errorf("generating raw apparmor profile: %w", err)
+		}
+		if err := armor2crd.Validate(policy); err != nil {
+			return false, fmt.Errorf("validating generated apparmor profile: %w", err)
+		}
+		return a.loadProfile(a.logger, profile.GetProfileName(), policy)
+	case *v1alpha1.RawAppArmorProfile:
+		if err := validateRawPolicy(profile); err != nil {
+			return false, fmt.Errorf("validating raw apparmor profile: %w", err)
+		}
+		return a.loadProfile(a.logger, profile.GetProfileName(), profile.Spec.Policy)
+	default:
 		return false, errors.New(errInvalidCustomResourceType)
 	}
-
-	policy, err := crd2armor.GenerateProfile(profile.GetProfileName(), profile.Spec.ComplainMode, &profile.Spec.Abstract)
-	if err != nil {
-		return false, fmt.Errorf("generating raw apparmor profile: %w", err)
-	}
-	return a.loadProfile(a.logger, profile.GetProfileName(), policy)
 }
 
 func (a *aaProfileManager) CustomResourceTypeName() string {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
@@ -55,6 +56,36 @@ func TestInstallProfile(t *testing.T) {
 			sut:     aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return false, nil }},
 			profile: &v1alpha1.AppArmorProfile{},
 		},
+		{
+			name: "invalid capability in profile CRD",
+			sut:  aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return true, nil }},
+			profile: &v1alpha1.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1alpha1.AppArmorProfileSpec{Abstract: v1alpha1.AppArmorAbstract{
+					Capability: &v1alpha1.AppArmorCapabilityRules{AllowedCapabilities: []string{"CAP_SYS_ADMIN"}},
+				}},
+			},
+			wantErr: errors.New(`validating generated apparmor profile: ` +
+				`line 17: capability CAP_SYS_ADMIN: unknown capability "CAP_SYS_ADMIN"`),
+		},
+		{
+			name: "valid raw profile CRD",
+			sut:  aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return true, nil }},
+			profile: &v1alpha1.RawAppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "raw"},
+				Spec:       v1alpha1.RawAppArmorProfileSpec{Policy: "profile raw {\n  file,\n}\n"},
+			},
+			wantResult: true,
+		},
+		{
+			name: "invalid raw profile CRD",
+			sut:  aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return true, nil }},
+			profile: &v1alpha1.RawAppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "raw"},
+				Spec:       v1alpha1.RawAppArmorProfileSpec{Policy: "profile other {\n  file,\n}\n"},
+			},
+			wantErr: errors.New(`validating raw apparmor profile: policy defines profile "other" instead of "raw"`),
+		},
 	}
 
 	for _, tc := range cases {
@@ -92,6 +123,13 @@ func TestRemoveProfile(t *testing.T) {
 			},
 			profile: &v1alpha1.AppArmorProfile{},
 		},
+		{
+			name: "valid raw profile CRD",
+			sut: aaProfileManager{
+				removeProfile: func(_ logr.Logger, _ string) error { return nil },
+			},
+			profile: &v1alpha1.RawAppArmorProfile{},
+		},
 	}
 
 	for _, tc := range cases {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -35,9 +36,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -72,13 +75,40 @@ type Reconciler struct {
 	record  record.EventRecorder
 	metrics *metrics.Metrics
 	manager ProfileManager
+	// raw is true if the reconciler manages RawAppArmorProfiles instead of
+	// AppArmorProfiles.
+	raw bool
+}
+
+// profileObject is an AppArmor profile which can be reconciled.
+type profileObject interface {
+	profilebasev1alpha1.StatusBaseUser
+	profilebasev1alpha1.SecurityProfileBase
+	GetProfileName() string
 }
 
 // Name returns the name of the controller.
 func (r *Reconciler) Name() string {
+	if r.raw {
+		return "rawapparmor-spod"
+	}
 	return "apparmor-spod"
 }
 
+func (r *Reconciler) newProfile() profileObject {
+	if r.raw {
+		return &v1alpha1.RawAppArmorProfile{}
+	}
+	return &v1alpha1.AppArmorProfile{}
+}
+
+func (r *Reconciler) kind() string {
+	if r.raw {
+		return "RawAppArmorProfile"
+	}
+	return "AppArmorProfile"
+}
+
 // SchemeBuilder returns the API scheme of the controller.
 func (r *Reconciler) SchemeBuilder() *scheme.Builder {
 	return v1alpha1.SchemeBuilder
@@ -101,11 +131,14 @@ func (r *Reconciler) checkAppArmor() error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawapparmorprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawapparmorprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawapparmorprofiles/finalizers,verbs=delete;get;update;patch
 
-// Reconcile reconciles a AppArmorProfile.
+// Reconcile reconciles a AppArmorProfile or RawAppArmorProfile.
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	logger := r.log.WithValues("apparmorprofile", req.Name, "namespace", req.Namespace)
-	logger.Info("Reconciling AppArmorProfile")
+	logger := r.log.WithValues(strings.ToLower(r.kind()), req.Name, "namespace", req.Namespace)
+	logger.Info("Reconciling " + r.kind())
 
 	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
@@ -117,7 +150,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		if r.record != nil {
 			r.metrics.IncAppArmorProfileError(reasonAppArmorNotSupported)
 			r.record.AnnotatedEventf(
-				&v1alpha1.AppArmorProfile{},
+				r.newProfile(),
 				map[string]string{os.Getenv(config.NodeNameEnvKey): "node does not support apparmor"},
 				util.EventTypeWarning,
 				reasonAppArmorNotSupported,
@@ -131,9 +164,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	appArmorProfile := &v1alpha1.AppArmorProfile{}
+	appArmorProfile := r.newProfile()
 	if err := r.client.Get(ctx, req.NamespacedName, appArmorProfile); err != nil {
-		// Expected to find an AppArmorProfile, return an error and requeue
+		// Expected to find a profile, return an error and requeue
 		if util.IgnoreNotFound(err) == nil {
 			return reconcile.Result{}, nil
 		}
@@ -144,7 +177,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 }
 
 func (r *Reconciler) reconcileAppArmorProfile(
-	ctx context.Context, sp *v1alpha1.AppArmorProfile, l logr.Logger,
+	ctx context.Context, sp profileObject, l logr.Logger,
 ) (reconcile.Result, error) {
 	if sp == nil {
 		return reconcile.Result{}, errors.New(errAppArmorProfileNil)
@@ -173,6 +206,20 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
+	if !sp.IsReconcilable() {
+		l.Info("Profile is partial or disabled, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	allowed, err := r.rolloutAllowed(ctx, nodeStatus)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking profile rollout: %w", err)
+	}
+	if !allowed {
+		l.Info("Waiting for the staged rollout of the changed profile")
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
 	// TODO: backoff policy
 	updated, err := r.manager.InstallProfile(sp)
 	if err != nil {
@@ -201,7 +248,7 @@ func (r *Reconciler) reconcileAppArmorProfile(
 	}
 
 	l.Info(
-		"Reconciled profile from AppArmorProfile",
+		"Reconciled profile from "+r.kind(),
 		"resource version", sp.GetResourceVersion(),
 		"name", sp.GetName(),
 	)
@@ -213,9 +260,22 @@ func (r *Reconciler) reconcileAppArmorProfile(
 	return reconcile.Result{}, nil
 }
 
+// rolloutAllowed returns true if the node is allowed to install the profile
+// if a staged rollout is configured.
+func (r *Reconciler) rolloutAllowed(ctx context.Context, nodeStatus *nodestatus.StatusClient) (bool, error) {
+	spod, err := common.GetSPOD(ctx, r.client)
+	if err != nil {
+		return false, fmt.Errorf("retrieving the SPOD configuration: %w", err)
+	}
+	if spod.Spec.ProfileRollout == nil {
+		return true, nil
+	}
+	return nodeStatus.InstallAllowed(ctx)
+}
+
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
-	sp *v1alpha1.AppArmorProfile,
+	sp profileObject,
 	nsc *nodestatus.StatusClient,
 ) (reconcile.Result, error) {
 	hasStatus, err := nsc.Exists(ctx)
@@ -264,7 +324,7 @@ func (r *Reconciler) reconcileDeletion(
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) handleDeletion(sp *v1alpha1.AppArmorProfile) error {
+func (r *Reconciler) handleDeletion(sp profileObject) error {
 	if err := r.manager.RemoveProfile(sp); err != nil {
 		return fmt.Errorf("unloading profile from host: %w", err)
 	}
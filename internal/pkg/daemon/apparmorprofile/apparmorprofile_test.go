@@ -78,6 +78,21 @@ func TestReconcile(t *testing.T) {
 			wantResult: reconcile.Result{},
 			wantErr:    nil,
 		},
+		{
+			name: "RawProfileNotFound",
+			rec: &Reconciler{
+				client: &util.MockClient{
+					MockGet: util.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, name)),
+				},
+				log:     log.Log,
+				metrics: metrics.New(),
+				manager: NewAppArmorProfileManager(log.Log),
+				raw:     true,
+			},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}},
+			wantResult: reconcile.Result{},
+			wantErr:    nil,
+		},
 		{
 			name: "NotEnabled",
 			rec: &Reconciler{
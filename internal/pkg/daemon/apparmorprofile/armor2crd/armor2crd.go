@@ -0,0 +1,719 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armor2crd
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
+)
+
+// Profile is an AppArmor profile parsed from its raw syntax.
+type Profile struct {
+	// Name is the name of the profile, which can be the path of the confined
+	// program as well.
+	Name string
+	// ComplainMode is true if the profile has the complain flag set.
+	ComplainMode bool
+	// Abstract contains all rules which can be represented by the CRD.
+	Abstract apparmorprofileapi.AppArmorAbstract
+	// Unsupported contains all rules which cannot be represented by the CRD
+	// or were only represented partially.
+	Unsupported []UnsupportedRule
+}
+
+// UnsupportedRule is a rule of a raw profile which cannot be represented by
+// the CRD.
+type UnsupportedRule struct {
+	// Line is the line of the rule within the profile.
+	Line int
+	// Rule is the rule itself.
+	Rule string
+	// Reason describes why the rule is unsupported.
+	Reason string
+}
+
+func (u UnsupportedRule) String() string {
+	return fmt.Sprintf("line %d: %s: %s", u.Line, u.Rule, u.Reason)
+}
+
+type statementKind int
+
+const (
+	kindRule statementKind = iota
+	kindOpen
+	kindClose
+	kindInclude
+	kindVariable
+)
+
+type statement struct {
+	kind statementKind
+	line int
+	text string
+	// unterminated is true for rules which are closed by the end of their
+	// block instead of a comma.
+	unterminated bool
+}
+
+const (
+	baseAbstraction = "abstractions/base"
+	globalTunables  = "tunables/global"
+)
+
+var (
+	flagsRegex      = regexp.MustCompile(`flags\s*=\s*\(?([^)]*)\)?`)
+	includeRegex    = regexp.MustCompile(`^#?include\s+(if\s+exists\s+)?[<"]?([^>"]*)[>"]?$`)
+	variableRegex   = regexp.MustCompile(`^@\{[^}]+\}\s*\+?=`)
+	definitionRegex = regexp.MustCompile(`^@\{([^}]+)\}\s*(\+?=)\s*(.*)$`)
+	referenceRegex  = regexp.MustCompile(`@\{([^}]+)\}`)
+
+	// defaultRules returns the rules generated for every CRD, which are
+	// therefore implied when converting raw profiles back.
+	defaultRules = sync.OnceValues(func() (map[string]bool, error) {
+		generated, err := crd2armor.GenerateProfile("default", false, &apparmorprofileapi.AppArmorAbstract{})
+		if err != nil {
+			return nil, fmt.Errorf("generate default profile: %w", err)
+		}
+		statements, err := scan(generated)
+		if err != nil {
+			return nil, fmt.Errorf("scan default profile: %w", err)
+		}
+		rules := map[string]bool{}
+		for _, s := range statements {
+			if s.kind == kindRule {
+				rules[s.text] = true
+			}
+		}
+		return rules, nil
+	})
+)
+
+// ParseProfiles parses all profiles of the raw AppArmor profile content.
+// Rules which cannot be represented by the CRD are added to the unsupported
+// rules of the profile instead of being dropped silently.
+func ParseProfiles(content string) ([]*Profile, error) {
+	implied, err := defaultRules()
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := scan(content)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := []*Profile{}
+	fileUnsupported := []UnsupportedRule{}
+	variables := map[string][]string{}
+	var current *parser
+	depth := 0
+
+	for _, s := range statements {
+		switch s.kind {
+		case kindInclude:
+			match := includeRegex.FindStringSubmatch(s.text)
+			switch {
+			case current == nil:
+				if match == nil || match[2] != globalTunables {
+					fileUnsupported = append(fileUnsupported, UnsupportedRule{
+						Line: s.line, Rule: s.text, Reason: "includes cannot be resolved",
+					})
+				}
+			case depth == 1 && (match == nil || match[2] != baseAbstraction):
+				current.unsupported(s, "includes cannot be resolved")
+			}
+
+		case kindVariable:
+			switch {
+			case current == nil:
+				if err := defineVariable(variables, s.text); err != nil {
+					fileUnsupported = append(fileUnsupported, UnsupportedRule{
+						Line: s.line, Rule: s.text, Reason: err.Error(),
+					})
+				}
+			case depth == 1:
+				current.unsupported(s, "variables can only be defined outside of profiles")
+			}
+
+		case kindOpen:
+			depth++
+			if current == nil {
+				current, err = newParser(s, implied, variables)
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if depth == 2 {
+				current.unsupported(s, "nested blocks like hats, child profiles and conditionals are not supported")
+			}
+
+		case kindClose:
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unbalanced closing brace", s.line)
+			}
+			if depth == 0 {
+				profiles = append(profiles, current.finalize())
+				current = nil
+			}
+
+		case kindRule:
+			if current == nil {
+				switch {
+				case strings.HasPrefix(s.text, "abi "):
+				case strings.HasPrefix(s.text, "alias "):
+					fileUnsupported = append(fileUnsupported, UnsupportedRule{
+						Line: s.line, Rule: s.text, Reason: "aliases are not supported",
+					})
+				default:
+					return nil, fmt.Errorf("line %d: rule outside of a profile: %s", s.line, s.text)
+				}
+				continue
+			}
+			if depth == 1 {
+				current.parseRule(s)
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, errors.New("profile is not closed")
+	}
+	if len(profiles) == 0 {
+		return nil, errors.New("no profile found")
+	}
+
+	for _, profile := range profiles {
+		profile.Unsupported = append(fileUnsupported, profile.Unsupported...)
+	}
+
+	return profiles, nil
+}
+
+// scan splits the content into statements, which are either rules
+// terminated by a comma, the opening or closing of a block, include
+// directives or variable definitions.
+func scan(content string) ([]statement, error) {
+	statements := []statement{}
+	current := &strings.Builder{}
+	startLine := 0
+
+	emit := func(kind statementKind) {
+		statements = append(statements, statement{
+			kind: kind,
+			line: startLine,
+			text: strings.Join(strings.Fields(current.String()), " "),
+		})
+		current.Reset()
+	}
+
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+
+		if current.Len() == 0 {
+			startLine = lineNumber
+			trimmed := strings.TrimSpace(line)
+			if includeRegex.MatchString(trimmed) {
+				current.WriteString(trimmed)
+				emit(kindInclude)
+				continue
+			}
+			if variableRegex.MatchString(trimmed) {
+				current.WriteString(trimmed)
+				emit(kindVariable)
+				continue
+			}
+		}
+
+		quoted := false
+		globDepth := 0
+		parenDepth := 0
+	chars:
+		for j, r := range line {
+			switch {
+			case current.Len() == 0 && unicode.IsSpace(r):
+				continue
+
+			case current.Len() == 0:
+				startLine = lineNumber
+			}
+
+			switch {
+			case r == '"':
+				quoted = !quoted
+				current.WriteRune(r)
+
+			case quoted:
+				current.WriteRune(r)
+
+			case r == '#':
+				// The rest of the line is a comment.
+				break chars
+
+			case r == '{':
+				if rest := strings.TrimSpace(line[j+1:]); rest == "" || strings.HasPrefix(rest, "#") {
+					emit(kindOpen)
+					break chars
+				}
+				globDepth++
+				current.WriteRune(r)
+
+			case r == '}' && globDepth > 0:
+				globDepth--
+				current.WriteRune(r)
+
+			case r == '}':
+				if current.Len() > 0 {
+					emit(kindRule)
+					statements[len(statements)-1].unterminated = true
+				}
+				emit(kindClose)
+
+			case r == '(':
+				parenDepth++
+				current.WriteRune(r)
+
+			case r == ')' && parenDepth > 0:
+				parenDepth--
+				current.WriteRune(r)
+
+			case r == ',' && globDepth == 0 && parenDepth == 0:
+				emit(kindRule)
+
+			default:
+				current.WriteRune(r)
+			}
+		}
+
+		if current.Len() > 0 {
+			current.WriteRune(' ')
+		}
+	}
+
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		return nil, fmt.Errorf("line %d: rule is not terminated by a comma: %s", startLine, rest)
+	}
+
+	return statements, nil
+}
+
+type parser struct {
+	profile   *Profile
+	denies    []fileRule
+	implied   map[string]bool
+	variables map[string][]string
+}
+
+type fileRule struct {
+	statement
+	path  string
+	perms string
+}
+
+func newParser(s statement, implied map[string]bool, variables map[string][]string) (*parser, error) {
+	header := flagsRegex.ReplaceAllString(s.text, "")
+	fields := strings.Fields(header)
+	if len(fields) > 0 && fields[0] == "profile" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("line %d: profile has no name", s.line)
+	}
+
+	p := &parser{
+		profile:   &Profile{Name: strings.Trim(fields[0], `"`)},
+		implied:   implied,
+		variables: variables,
+	}
+	if len(fields) > 1 {
+		p.unsupported(s, fmt.Sprintf(
+			"profile attachment %q is not supported, imported as unattached profile",
+			strings.Join(fields[1:], " "),
+		))
+	}
+
+	if match := flagsRegex.FindStringSubmatch(s.text); match != nil {
+		for _, flag := range strings.FieldsFunc(match[1], func(r rune) bool {
+			return r == ',' || r == ' '
+		}) {
+			switch flag {
+			case "complain":
+				p.profile.ComplainMode = true
+			case "enforce", "attach_disconnected", "mediate_deleted":
+			default:
+				p.unsupported(s, fmt.Sprintf("profile flag %q is not supported", flag))
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func (p *parser) unsupported(s statement, reason string) {
+	p.profile.Unsupported = append(p.profile.Unsupported, UnsupportedRule{
+		Line: s.line, Rule: s.text, Reason: reason,
+	})
+}
+
+func (p *parser) parseRule(s statement) {
+	text := expandVariables(s.text, p.variables)
+	if p.implied[text] {
+		return
+	}
+
+	fields := ruleFields(text)
+	deny, owner := false, false
+	qualifiers := 0
+	for _, qualifier := range fields {
+		if !isQualifier(qualifier) {
+			break
+		}
+		qualifiers++
+
+		switch qualifier {
+		case "deny":
+			deny = true
+		case "audit":
+			p.unsupported(s, "audit qualifier is not supported, imported without auditing")
+		case "owner":
+			owner = true
+		case "other":
+			p.unsupported(s, "other qualifier is not supported, imported for all owners")
+		case "allow":
+		default:
+			p.unsupported(s, "rule priorities are not supported")
+		}
+	}
+	fields = fields[qualifiers:]
+
+	if len(fields) == 0 {
+		p.unsupported(s, "rule has no content")
+		return
+	}
+
+	kind := fields[0]
+	isFile := kind == "file" || isPath(kind) || (len(fields) > 1 && isPath(fields[1]) && !isRuleKind(kind))
+	if owner && !isFile {
+		p.unsupported(s, "owner qualifier is only supported for file rules, imported for all owners")
+	}
+	if deny && slices.Contains([]string{
+		"signal", "ptrace", "mount", "umount", "unmount", "pivot_root", "unix", "dbus", "change_profile",
+	}, kind) {
+		p.unsupported(s, fmt.Sprintf("denying %s rules is not supported", kind))
+		return
+	}
+
+	switch {
+	case kind == "capability":
+		p.parseCapability(s, fields[1:], deny)
+	case kind == "network":
+		p.parseNetwork(s, fields[1:], deny)
+	case kind == "signal":
+		p.parseSignal(s, fields[1:])
+	case kind == "ptrace":
+		p.parsePtrace(s, fields[1:])
+	case kind == "mount":
+		p.parseMount(s, fields[1:])
+	case kind == "umount", kind == "unmount":
+		p.parseUmount(s, fields[1:])
+	case kind == "pivot_root":
+		p.parsePivotRoot(s, fields[1:])
+	case kind == "unix":
+		p.parseUnix(s, fields[1:])
+	case kind == "dbus":
+		p.parseDBus(s, fields[1:])
+	case kind == "change_profile":
+		p.parseChangeProfile(s, fields[1:])
+	case kind == "file":
+		p.parseFile(s, fields[1:], deny, owner)
+	case isFile:
+		p.parseFile(s, fields, deny, owner)
+	default:
+		if kind == "set" && len(fields) > 1 {
+			kind = fields[1]
+		}
+		p.unsupported(s, kind+" rules are not supported")
+	}
+}
+
+func (p *parser) parseCapability(s statement, capabilities []string, deny bool) {
+	if deny {
+		p.unsupported(s, "denying capabilities is not supported")
+		return
+	}
+	if len(capabilities) == 0 {
+		p.unsupported(s, "allowing all capabilities is not supported")
+		return
+	}
+
+	abstract := &p.profile.Abstract
+	if abstract.Capability == nil {
+		abstract.Capability = &apparmorprofileapi.AppArmorCapabilityRules{}
+	}
+	for _, capability := range capabilities {
+		capability = strings.ToLower(capability)
+		if !slices.Contains(abstract.Capability.AllowedCapabilities, capability) {
+			abstract.Capability.AllowedCapabilities = append(abstract.Capability.AllowedCapabilities, capability)
+		}
+	}
+}
+
+func (p *parser) parseNetwork(s statement, fields []string, deny bool) {
+	if len(fields) == 0 {
+		p.unsupported(s, "network access for all families is not supported")
+		return
+	}
+
+	var tcp, udp, raw, domain bool
+	for _, field := range fields {
+		switch field {
+		case "inet", "inet6":
+			domain = true
+		case "tcp", "stream":
+			tcp = true
+		case "udp", "dgram":
+			udp = true
+		case "raw":
+			raw = true
+		default:
+			p.unsupported(s, fmt.Sprintf("network %q is not supported", field))
+			return
+		}
+	}
+	if domain && !tcp && !udp && !raw {
+		tcp, udp, raw = true, true, true
+	}
+
+	abstract := &p.profile.Abstract
+	if abstract.Network == nil {
+		abstract.Network = &apparmorprofileapi.AppArmorNetworkRules{}
+	}
+	allow := !deny
+	if raw {
+		abstract.Network.AllowRaw = &allow
+	}
+	if tcp || udp {
+		if abstract.Network.Protocols == nil {
+			abstract.Network.Protocols = &apparmorprofileapi.AppArmorAllowedProtocols{}
+		}
+		if tcp {
+			abstract.Network.Protocols.AllowTCP = &allow
+		}
+		if udp {
+			abstract.Network.Protocols.AllowUDP = &allow
+		}
+	}
+}
+
+func (p *parser) parseFile(s statement, fields []string, deny, owner bool) {
+	if len(fields) == 0 {
+		p.unsupported(s, "allowing access to all files is not supported")
+		return
+	}
+
+	// The permissions can be either after or before the path.
+	var path, perms, rest string
+	if isPath(fields[0]) {
+		path, rest = splitPath(strings.Join(fields, " "))
+		perms, rest, _ = strings.Cut(strings.TrimSpace(rest), " ")
+	} else {
+		perms = fields[0]
+		path, rest = splitPath(strings.Join(fields[1:], " "))
+	}
+	if strings.TrimSpace(rest) != "" {
+		p.unsupported(s, "file rules with link targets are not supported")
+		return
+	}
+
+	if strings.Trim(perms, "rwalkmixpuPUCc") != "" {
+		p.unsupported(s, fmt.Sprintf("file permissions %q are invalid", perms))
+		return
+	}
+
+	if deny {
+		if owner {
+			p.unsupported(s, "denying file access is only supported for read only and write only paths")
+			return
+		}
+		p.denies = append(p.denies, fileRule{statement: s, path: path, perms: perms})
+		return
+	}
+
+	if execMode := strings.Trim(perms, "rwalkm"); execMode != "" && execMode != "ix" {
+		p.unsupported(s, fmt.Sprintf("exec mode %q is not supported", execMode))
+		return
+	}
+
+	// The rules generated for the paths of the CRD are mapped back to them,
+	// all others are kept as fine-grained file rules.
+	rule := apparmorprofileapi.AppArmorFileRule{Path: path, Owner: owner}
+	mode := ""
+	for _, permission := range crd2armor.FilePermissions {
+		if strings.Contains(perms, permission.Mode) {
+			rule.Permissions = append(rule.Permissions, permission.Permission)
+			mode += permission.Mode
+		}
+	}
+
+	switch {
+	case owner:
+		p.filesystem().FileRules = append(p.filesystem().FileRules, rule)
+	case mode == "rix":
+		addPath(&p.executable().AllowedExecutables, path)
+	case mode == "rm":
+		addPath(&p.executable().AllowedLibraries, path)
+	case mode == "rwlk":
+		addPath(&p.filesystem().ReadWritePaths, path)
+	case mode == "r":
+		addPath(&p.filesystem().ReadOnlyPaths, path)
+	case mode == "wlk":
+		addPath(&p.filesystem().WriteOnlyPaths, path)
+	default:
+		p.filesystem().FileRules = append(p.filesystem().FileRules, rule)
+	}
+}
+
+// finalize checks all deny file rules, which are only supported when they
+// are implied by the read only and write only paths.
+func (p *parser) finalize() *Profile {
+	fs := p.profile.Abstract.Filesystem
+	for _, rule := range p.denies {
+		if fs != nil {
+			if fs.ReadOnlyPaths != nil && slices.Contains(*fs.ReadOnlyPaths, rule.path) &&
+				strings.Trim(rule.perms, "walk") == "" {
+				continue
+			}
+			if fs.WriteOnlyPaths != nil && slices.Contains(*fs.WriteOnlyPaths, rule.path) &&
+				rule.perms == "r" {
+				continue
+			}
+		}
+		p.unsupported(rule.statement, "denying file access is only supported for read only and write only paths")
+	}
+
+	return p.profile
+}
+
+func (p *parser) executable() *apparmorprofileapi.AppArmorExecutablesRules {
+	if p.profile.Abstract.Executable == nil {
+		p.profile.Abstract.Executable = &apparmorprofileapi.AppArmorExecutablesRules{}
+	}
+	return p.profile.Abstract.Executable
+}
+
+func (p *parser) filesystem() *apparmorprofileapi.AppArmorFsRules {
+	if p.profile.Abstract.Filesystem == nil {
+		p.profile.Abstract.Filesystem = &apparmorprofileapi.AppArmorFsRules{}
+	}
+	return p.profile.Abstract.Filesystem
+}
+
+func addPath(paths **[]string, path string) {
+	if *paths == nil {
+		*paths = &[]string{}
+	}
+	if !slices.Contains(**paths, path) {
+		**paths = append(**paths, path)
+	}
+}
+
+// defineVariable adds the values of a variable definition like
+// "@{APP_DIRS}=/opt/app /srv/app" to the variables. Variables referenced by
+// the values get expanded.
+func defineVariable(variables map[string][]string, definition string) error {
+	match := definitionRegex.FindStringSubmatch(definition)
+	if match == nil {
+		return errors.New("variable definition is invalid")
+	}
+
+	values := []string{}
+	for rest := strings.TrimSpace(match[3]); rest != ""; {
+		var value string
+		value, rest = splitPath(rest)
+		rest = strings.TrimSpace(rest)
+		values = append(values, expandVariables(strings.Trim(value, `"`), variables))
+	}
+	if len(values) == 0 {
+		return errors.New("variable has no value")
+	}
+
+	if match[2] == "+=" {
+		values = append(variables[match[1]], values...)
+	}
+	variables[match[1]] = values
+	return nil
+}
+
+// expandVariables replaces the references to defined variables, which
+// become alternations if the variable has multiple values. References to
+// variables of the global tunables are kept.
+func expandVariables(text string, variables map[string][]string) string {
+	return referenceRegex.ReplaceAllStringFunc(text, func(reference string) string {
+		values, ok := variables[referenceRegex.FindStringSubmatch(reference)[1]]
+		switch {
+		case !ok:
+			return reference
+		case len(values) == 1:
+			return values[0]
+		default:
+			return "{" + strings.Join(values, ",") + "}"
+		}
+	})
+}
+
+func isQualifier(field string) bool {
+	switch field {
+	case "allow", "deny", "audit", "owner", "other":
+		return true
+	}
+	return strings.HasPrefix(field, "priority=")
+}
+
+func isRuleKind(field string) bool {
+	switch field {
+	case "capability", "network", "signal", "ptrace", "mount", "remount", "umount", "unmount",
+		"pivot_root", "unix", "dbus", "change_profile", "rlimit", "set", "link":
+		return true
+	}
+	return false
+}
+
+func isPath(field string) bool {
+	field = strings.TrimPrefix(field, `"`)
+	return strings.HasPrefix(field, "/") || strings.HasPrefix(field, "@{") || strings.HasPrefix(field, "{")
+}
+
+// splitPath splits a file rule into its path, which may be quoted, and the
+// rest of the rule.
+func splitPath(rule string) (path, rest string) {
+	if strings.HasPrefix(rule, `"`) {
+		if end := strings.Index(rule[1:], `"`); end >= 0 {
+			return rule[:end+2], rule[end+2:]
+		}
+	}
+	path, rest, _ = strings.Cut(rule, " ")
+	return path, rest
+}
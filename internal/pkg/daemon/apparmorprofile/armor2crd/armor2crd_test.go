@@ -0,0 +1,342 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armor2crd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
+)
+
+func TestParseProfilesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	abstract := apparmorprofileapi.AppArmorAbstract{
+		Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+			AllowedExecutables: &[]string{"/usr/bin/nginx"},
+			AllowedLibraries:   &[]string{"/usr/lib/**.so*"},
+		},
+		Filesystem: &apparmorprofileapi.AppArmorFsRules{
+			ReadOnlyPaths:  &[]string{"/etc/nginx/**"},
+			WriteOnlyPaths: &[]string{"/var/log/nginx/*"},
+			ReadWritePaths: &[]string{"/var/cache/nginx/{client,proxy}_temp/**"},
+			FileRules: []apparmorprofileapi.AppArmorFileRule{
+				{
+					Path: "/run/nginx.pid",
+					Permissions: []apparmorprofileapi.AppArmorFilePermission{
+						apparmorprofileapi.AppArmorFilePermissionRead,
+						apparmorprofileapi.AppArmorFilePermissionWrite,
+					},
+				},
+				{
+					Path: "/tmp/nginx-*.log",
+					Permissions: []apparmorprofileapi.AppArmorFilePermission{
+						apparmorprofileapi.AppArmorFilePermissionAppend,
+					},
+					Owner: true,
+				},
+			},
+		},
+		Network: &apparmorprofileapi.AppArmorNetworkRules{
+			AllowRaw: ptr.To(false),
+			Protocols: &apparmorprofileapi.AppArmorAllowedProtocols{
+				AllowTCP: ptr.To(true),
+				AllowUDP: ptr.To(true),
+			},
+		},
+		Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+			AllowedCapabilities: []string{"net_bind_service", "setuid"},
+		},
+		Signal: []apparmorprofileapi.AppArmorSignalRule{{
+			Access:  []apparmorprofileapi.AppArmorSignalAccess{"receive"},
+			Signals: []string{"hup", "term"},
+			Peer:    "unconfined",
+		}},
+		Ptrace: []apparmorprofileapi.AppArmorPtraceRule{{
+			Access: []apparmorprofileapi.AppArmorPtraceAccess{"readby"},
+		}},
+		Mount: &apparmorprofileapi.AppArmorMountRules{
+			Mounts: []apparmorprofileapi.AppArmorMountRule{{
+				FsTypes:    []string{"tmpfs"},
+				Options:    []string{"rw", "nosuid"},
+				Source:     "none",
+				MountPoint: "/var/cache/nginx/",
+			}},
+			Umounts:    []string{"/var/cache/nginx/"},
+			PivotRoots: []string{"/new/root/"},
+		},
+		Unix: []apparmorprofileapi.AppArmorUnixRule{{
+			Access:      []apparmorprofileapi.AppArmorUnixAccess{"connect", "send", "receive"},
+			Type:        "stream",
+			PeerAddress: "@nginx",
+		}},
+		DBus: []apparmorprofileapi.AppArmorDBusRule{{
+			Access:    []apparmorprofileapi.AppArmorDBusAccess{"send"},
+			Bus:       "system",
+			Path:      "/org/freedesktop/DBus",
+			Interface: "org.freedesktop.DBus",
+			Member:    "Hello",
+			PeerName:  "org.freedesktop.DBus",
+		}},
+		ChangeProfile: []apparmorprofileapi.AppArmorChangeProfileRule{
+			{Target: "nginx-worker"},
+			{Executable: "/usr/bin/helper", Target: "helper"},
+		},
+	}
+
+	generated, err := crd2armor.GenerateProfile("nginx", true, &abstract)
+	require.NoError(t, err)
+	require.NoError(t, Validate(generated))
+
+	profiles, err := ParseProfiles(generated)
+	require.NoError(t, err)
+	require.Len(t, profiles, 1)
+	require.Equal(t, "nginx", profiles[0].Name)
+	require.True(t, profiles[0].ComplainMode)
+	require.Empty(t, profiles[0].Unsupported)
+	require.Equal(t, abstract, profiles[0].Abstract)
+}
+
+func TestParseProfiles(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                string
+		content             string
+		expectedNames       []string
+		expectedAbstract    apparmorprofileapi.AppArmorAbstract
+		expectedUnsupported []string
+		expectedErr         string
+	}{
+		{
+			name: "hand written profile",
+			content: `
+abi <abi/3.0>,
+include <tunables/global>
+@{APP_DIRS}=/opt/app /srv/app
+
+/usr/bin/app flags=(attach_disconnected) {
+  include <abstractions/base>
+  include <abstractions/nameservice>
+
+  # Binaries and files
+  /usr/bin/app mrix,
+  r "/srv/app data/**",
+  owner /tmp/app-*.log w,
+  deny /etc/shadow r,
+  /usr/bin/helper Px,
+
+  network inet stream,
+  network unix,
+  capability chown dac_override,
+  deny capability sys_admin,
+
+  signal (send) peer=unconfined,
+  ptrace,
+  mount fstype=tmpfs -> /run/app/,
+  unix (connect, send) type=stream peer=(addr=@app),
+  dbus send bus=system path=/org/app peer=(name=org.app),
+  change_profile -> helper,
+  deny ptrace read,
+  owner signal,
+  set rlimit nofile <= 1024,
+
+  ^hat {
+    /etc/hat r,
+  }
+}
+`,
+			expectedNames: []string{"/usr/bin/app"},
+			expectedAbstract: apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					ReadOnlyPaths: &[]string{`"/srv/app data/**"`},
+					FileRules: []apparmorprofileapi.AppArmorFileRule{
+						{
+							Path: "/usr/bin/app",
+							Permissions: []apparmorprofileapi.AppArmorFilePermission{
+								apparmorprofileapi.AppArmorFilePermissionRead,
+								apparmorprofileapi.AppArmorFilePermissionMmap,
+								apparmorprofileapi.AppArmorFilePermissionExec,
+							},
+						},
+						{
+							Path: "/tmp/app-*.log",
+							Permissions: []apparmorprofileapi.AppArmorFilePermission{
+								apparmorprofileapi.AppArmorFilePermissionWrite,
+							},
+							Owner: true,
+						},
+					},
+				},
+				Network: &apparmorprofileapi.AppArmorNetworkRules{
+					Protocols: &apparmorprofileapi.AppArmorAllowedProtocols{
+						AllowTCP: ptr.To(true),
+					},
+				},
+				Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+					AllowedCapabilities: []string{"chown", "dac_override"},
+				},
+				Signal: []apparmorprofileapi.AppArmorSignalRule{
+					{Access: []apparmorprofileapi.AppArmorSignalAccess{"send"}, Peer: "unconfined"},
+					{},
+				},
+				Ptrace: []apparmorprofileapi.AppArmorPtraceRule{{}},
+				Mount: &apparmorprofileapi.AppArmorMountRules{
+					Mounts: []apparmorprofileapi.AppArmorMountRule{
+						{FsTypes: []string{"tmpfs"}, MountPoint: "/run/app/"},
+					},
+				},
+				Unix: []apparmorprofileapi.AppArmorUnixRule{{
+					Access:      []apparmorprofileapi.AppArmorUnixAccess{"connect", "send"},
+					Type:        "stream",
+					PeerAddress: "@app",
+				}},
+				DBus: []apparmorprofileapi.AppArmorDBusRule{{
+					Access:   []apparmorprofileapi.AppArmorDBusAccess{"send"},
+					Bus:      "system",
+					Path:     "/org/app",
+					PeerName: "org.app",
+				}},
+				ChangeProfile: []apparmorprofileapi.AppArmorChangeProfileRule{{Target: "helper"}},
+			},
+			expectedUnsupported: []string{
+				"line 8: include <abstractions/nameservice>: includes cannot be resolved",
+				`line 15: /usr/bin/helper Px: exec mode "Px" is not supported`,
+				`line 18: network unix: network "unix" is not supported`,
+				"line 20: deny capability sys_admin: denying capabilities is not supported",
+				"line 28: deny ptrace read: denying ptrace rules is not supported",
+				"line 29: owner signal: owner qualifier is only supported for file rules, imported for all owners",
+				"line 30: set rlimit nofile <= 1024: rlimit rules are not supported",
+				"line 32: ^hat: nested blocks like hats, child profiles and conditionals are not supported",
+				"line 14: deny /etc/shadow r: denying file access is only supported for read only and write only paths",
+			},
+		},
+		{
+			name: "variables and includes outside of profiles",
+			content: `
+include <tunables/global>
+include <local/app>
+@{APP}=/opt/app
+@{APP_DIRS}=@{APP} /srv/app
+@{APP_DIRS}+=/var/lib/app
+@{EMPTY}=
+
+profile app {
+  @{APP}/bin/app rix,
+  @{APP_DIRS}/data/** r,
+  @{HOME}/.app/ rwlk,
+}
+`,
+			expectedNames: []string{"app"},
+			expectedAbstract: apparmorprofileapi.AppArmorAbstract{
+				Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+					AllowedExecutables: &[]string{"/opt/app/bin/app"},
+				},
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					ReadOnlyPaths:  &[]string{"{/opt/app,/srv/app,/var/lib/app}/data/**"},
+					ReadWritePaths: &[]string{"@{HOME}/.app/"},
+				},
+			},
+			expectedUnsupported: []string{
+				"line 3: include <local/app>: includes cannot be resolved",
+				"line 7: @{EMPTY}=: variable has no value",
+			},
+		},
+		{
+			name: "multiple profiles",
+			content: `
+profile first flags=(complain) {
+  network raw,
+}
+profile second /usr/bin/second {
+  /etc/second r,
+}
+`,
+			expectedNames: []string{"first", "second"},
+		},
+		{
+			name:          "profile with attachment",
+			content:       "profile app /usr/bin/app {\n  capability chown,\n}\n",
+			expectedNames: []string{"app"},
+			expectedAbstract: apparmorprofileapi.AppArmorAbstract{
+				Capability: &apparmorprofileapi.AppArmorCapabilityRules{
+					AllowedCapabilities: []string{"chown"},
+				},
+			},
+			expectedUnsupported: []string{
+				`line 1: profile app /usr/bin/app: profile attachment "/usr/bin/app" is not supported, ` +
+					"imported as unattached profile",
+			},
+		},
+		{
+			name:        "no profile",
+			content:     "#include <tunables/global>\n",
+			expectedErr: "no profile found",
+		},
+		{
+			name:        "profile not closed",
+			content:     "profile foo {\n  /etc/foo r,\n",
+			expectedErr: "profile is not closed",
+		},
+		{
+			name:        "unbalanced closing brace",
+			content:     "profile foo {\n}\n}\n",
+			expectedErr: "line 3: unbalanced closing brace",
+		},
+		{
+			name:        "rule outside of profile",
+			content:     "/etc/foo r,\n",
+			expectedErr: "line 1: rule outside of a profile",
+		},
+		{
+			name:        "rule without comma",
+			content:     "profile foo {\n}\n/etc/foo r\n",
+			expectedErr: "line 3: rule is not terminated by a comma",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profiles, err := ParseProfiles(tc.content)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, profile := range profiles {
+				names = append(names, profile.Name)
+			}
+			require.Equal(t, tc.expectedNames, names)
+
+			if len(profiles) != 1 {
+				return
+			}
+			require.Equal(t, tc.expectedAbstract, profiles[0].Abstract)
+			unsupported := []string{}
+			for _, rule := range profiles[0].Unsupported {
+				unsupported = append(unsupported, rule.String())
+			}
+			require.Equal(t, tc.expectedUnsupported, unsupported)
+		})
+	}
+}
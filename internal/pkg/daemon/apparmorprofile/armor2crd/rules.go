@@ -0,0 +1,307 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armor2crd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+// The access keywords of the rules, including the short forms of the
+// AppArmor syntax.
+var (
+	signalAccess = map[string][]string{
+		"send": {"send"}, "write": {"send"}, "w": {"send"},
+		"receive": {"receive"}, "read": {"receive"}, "r": {"receive"},
+		"rw": {"send", "receive"},
+	}
+	ptraceAccess = map[string][]string{
+		"read": {"read"}, "r": {"read"},
+		"trace": {"trace"}, "w": {"trace"},
+		"readby": {"readby"}, "tracedby": {"tracedby"},
+		"rw": {"read", "trace"},
+	}
+	unixAccess = map[string][]string{
+		"create": {"create"}, "bind": {"bind"}, "listen": {"listen"},
+		"accept": {"accept"}, "connect": {"connect"}, "shutdown": {"shutdown"},
+		"getattr": {"getattr"}, "setattr": {"setattr"},
+		"getopt": {"getopt"}, "setopt": {"setopt"},
+		"send": {"send"}, "write": {"send"}, "w": {"send"},
+		"receive": {"receive"}, "read": {"receive"}, "r": {"receive"},
+		"rw": {"send", "receive"},
+	}
+	dbusAccess = map[string][]string{
+		"send": {"send"}, "write": {"send"}, "w": {"send"},
+		"receive": {"receive"}, "read": {"receive"}, "r": {"receive"},
+		"bind": {"bind"}, "eavesdrop": {"eavesdrop"},
+		"rw": {"send", "receive"},
+	}
+)
+
+func (p *parser) parseSignal(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorSignalRule{}
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, "=")
+		switch {
+		case !found:
+			access, ok := accessList[apparmorprofileapi.AppArmorSignalAccess](field, signalAccess)
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("signal access %q is not supported", field))
+				return
+			}
+			rule.Access = append(rule.Access, access...)
+		case key == "set":
+			rule.Signals = append(rule.Signals, listValues(value)...)
+		case key == "peer":
+			rule.Peer = value
+		default:
+			p.unsupported(s, fmt.Sprintf("signal condition %q is not supported", key))
+			return
+		}
+	}
+	p.profile.Abstract.Signal = append(p.profile.Abstract.Signal, rule)
+}
+
+func (p *parser) parsePtrace(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorPtraceRule{}
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, "=")
+		switch {
+		case !found:
+			access, ok := accessList[apparmorprofileapi.AppArmorPtraceAccess](field, ptraceAccess)
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("ptrace access %q is not supported", field))
+				return
+			}
+			rule.Access = append(rule.Access, access...)
+		case key == "peer":
+			rule.Peer = value
+		default:
+			p.unsupported(s, fmt.Sprintf("ptrace condition %q is not supported", key))
+			return
+		}
+	}
+	p.profile.Abstract.Ptrace = append(p.profile.Abstract.Ptrace, rule)
+}
+
+func (p *parser) parseMount(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorMountRule{}
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		key, value, found := strings.Cut(field, "=")
+		switch {
+		case field == "->":
+			if i != len(fields)-2 {
+				p.unsupported(s, "mount rule has no single mount point")
+				return
+			}
+			rule.MountPoint = fields[i+1]
+			i++
+		case found && (key == "fstype" || key == "vfstype"):
+			rule.FsTypes = append(rule.FsTypes, listValues(value)...)
+		case found && (key == "options" || key == "option"):
+			rule.Options = append(rule.Options, listValues(value)...)
+		case found:
+			p.unsupported(s, fmt.Sprintf("mount condition %q is not supported", key))
+			return
+		case rule.Source == "" && !strings.HasPrefix(field, "("):
+			rule.Source = field
+		default:
+			p.unsupported(s, fmt.Sprintf("mount condition %q is not supported", field))
+			return
+		}
+	}
+	p.mount().Mounts = append(p.mount().Mounts, rule)
+}
+
+func (p *parser) parseUmount(s statement, fields []string) {
+	if len(fields) != 1 || strings.Contains(fields[0], "=") {
+		p.unsupported(s, "umount rules are only supported for a single mount point")
+		return
+	}
+	p.mount().Umounts = append(p.mount().Umounts, fields[0])
+}
+
+func (p *parser) parsePivotRoot(s statement, fields []string) {
+	if len(fields) != 1 || strings.Contains(fields[0], "=") {
+		p.unsupported(s, "pivot_root rules are only supported for a single new root")
+		return
+	}
+	p.mount().PivotRoots = append(p.mount().PivotRoots, fields[0])
+}
+
+func (p *parser) parseUnix(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorUnixRule{}
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, "=")
+		switch {
+		case !found:
+			access, ok := accessList[apparmorprofileapi.AppArmorUnixAccess](field, unixAccess)
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("unix access %q is not supported", field))
+				return
+			}
+			rule.Access = append(rule.Access, access...)
+		case key == "type" && slices.Contains([]string{"stream", "dgram", "seqpacket"}, value):
+			rule.Type = value
+		case key == "addr":
+			rule.Address = value
+		case key == "peer":
+			peer, ok := peerConditions(value, "label", "addr")
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("unix peer %q is not supported", value))
+				return
+			}
+			rule.Peer, rule.PeerAddress = peer["label"], peer["addr"]
+		default:
+			p.unsupported(s, fmt.Sprintf("unix condition %q is not supported", field))
+			return
+		}
+	}
+	p.profile.Abstract.Unix = append(p.profile.Abstract.Unix, rule)
+}
+
+func (p *parser) parseDBus(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorDBusRule{}
+	for _, field := range fields {
+		key, value, found := strings.Cut(field, "=")
+		switch {
+		case !found:
+			access, ok := accessList[apparmorprofileapi.AppArmorDBusAccess](field, dbusAccess)
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("dbus access %q is not supported", field))
+				return
+			}
+			rule.Access = append(rule.Access, access...)
+		case key == "bus":
+			rule.Bus = value
+		case key == "name":
+			rule.Name = value
+		case key == "path":
+			rule.Path = value
+		case key == "interface":
+			rule.Interface = value
+		case key == "member":
+			rule.Member = value
+		case key == "peer":
+			peer, ok := peerConditions(value, "name", "label")
+			if !ok {
+				p.unsupported(s, fmt.Sprintf("dbus peer %q is not supported", value))
+				return
+			}
+			rule.PeerName, rule.Peer = peer["name"], peer["label"]
+		default:
+			p.unsupported(s, fmt.Sprintf("dbus condition %q is not supported", key))
+			return
+		}
+	}
+	p.profile.Abstract.DBus = append(p.profile.Abstract.DBus, rule)
+}
+
+func (p *parser) parseChangeProfile(s statement, fields []string) {
+	rule := apparmorprofileapi.AppArmorChangeProfileRule{}
+	switch {
+	case len(fields) == 2 && fields[0] == "->":
+		rule.Target = fields[1]
+	case len(fields) == 3 && fields[1] == "->" && isPath(fields[0]):
+		rule.Executable, rule.Target = fields[0], fields[2]
+	default:
+		p.unsupported(s, "change_profile rules are only supported with a single target profile")
+		return
+	}
+	p.profile.Abstract.ChangeProfile = append(p.profile.Abstract.ChangeProfile, rule)
+}
+
+func (p *parser) mount() *apparmorprofileapi.AppArmorMountRules {
+	if p.profile.Abstract.Mount == nil {
+		p.profile.Abstract.Mount = &apparmorprofileapi.AppArmorMountRules{}
+	}
+	return p.profile.Abstract.Mount
+}
+
+// ruleFields splits a rule into its fields like strings.Fields, but keeps
+// parenthesized lists like set=(hup, term) together.
+func ruleFields(rule string) []string {
+	fields := []string{}
+	field := strings.Builder{}
+	depth := 0
+	for _, r := range rule {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case (r == ' ' || r == '\t') && depth == 0:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		}
+		field.WriteRune(r)
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// listValues returns the values of a single value or a parenthesized list,
+// which can be separated by commas or spaces.
+func listValues(value string) []string {
+	return strings.FieldsFunc(strings.TrimSuffix(strings.TrimPrefix(value, "("), ")"), func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+// accessList resolves the access keywords of a single access or a
+// parenthesized list of them.
+func accessList[T ~string](field string, keywords map[string][]string) ([]T, bool) {
+	res := []T{}
+	for _, value := range listValues(field) {
+		access, ok := keywords[value]
+		if !ok {
+			return nil, false
+		}
+		for _, a := range access {
+			if !slices.Contains(res, T(a)) {
+				res = append(res, T(a))
+			}
+		}
+	}
+	return res, true
+}
+
+// peerConditions parses the conditions of a peer like
+// peer=(label=foo addr=@bar), which are restricted to the allowed keys.
+func peerConditions(value string, keys ...string) (map[string]string, bool) {
+	if !strings.HasPrefix(value, "(") {
+		value = "(label=" + value + ")"
+	}
+	res := map[string]string{}
+	for _, condition := range listValues(value) {
+		key, val, found := strings.Cut(condition, "=")
+		if !found || !slices.Contains(keys, key) {
+			return nil, false
+		}
+		res[key] = val
+	}
+	return res, true
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armor2crd
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// capabilities are the Linux capabilities known by AppArmor.
+var capabilities = []string{
+	"chown", "dac_override", "dac_read_search", "fowner", "fsetid", "kill",
+	"setgid", "setuid", "setpcap", "linux_immutable", "net_bind_service",
+	"net_broadcast", "net_admin", "net_raw", "ipc_lock", "ipc_owner",
+	"sys_module", "sys_rawio", "sys_chroot", "sys_ptrace", "sys_pacct",
+	"sys_admin", "sys_boot", "sys_nice", "sys_resource", "sys_time",
+	"sys_tty_config", "mknod", "lease", "audit_write", "audit_control",
+	"setfcap", "mac_override", "mac_admin", "syslog", "wake_alarm",
+	"block_suspend", "audit_read", "perfmon", "bpf", "checkpoint_restore",
+}
+
+// execModes are the valid qualifiers of the exec permission, with their
+// letters sorted by code point.
+var execModes = []string{"i", "p", "P", "c", "C", "u", "U", "ip", "Pi", "ci", "Ci", "pu", "PU", "cu", "CU"}
+
+var variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Validate checks the syntax of the raw AppArmor profile content without
+// loading it into the kernel. It verifies that blocks are balanced, rules
+// are terminated and that file permissions, path globs and capability names
+// are valid. Rules which are not understood are left to the kernel loader.
+func Validate(content string) error {
+	statements, err := scan(content)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	depth, profiles := 0, 0
+	for _, s := range statements {
+		switch s.kind {
+		case kindOpen:
+			if depth == 0 {
+				profiles++
+			}
+			depth++
+
+		case kindClose:
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("line %d: unbalanced closing brace", s.line)
+			}
+
+		case kindRule:
+			if depth == 0 {
+				if !strings.HasPrefix(s.text, "abi ") && !strings.HasPrefix(s.text, "alias ") {
+					errs = append(errs, fmt.Errorf("line %d: rule outside of a profile: %s", s.line, s.text))
+				}
+				continue
+			}
+			if s.unterminated {
+				errs = append(errs, fmt.Errorf("line %d: rule is not terminated by a comma: %s", s.line, s.text))
+				continue
+			}
+			if err := validateRule(s.text); err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %s: %w", s.line, s.text, err))
+			}
+
+		case kindInclude, kindVariable:
+		}
+	}
+
+	if depth != 0 {
+		errs = append(errs, errors.New("profile is not closed"))
+	}
+	if profiles == 0 {
+		errs = append(errs, errors.New("no profile found"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateProfile validates the raw AppArmor profile content like Validate
+// and additionally ensures that it defines exactly one profile with the
+// provided name.
+func ValidateProfile(content, name string) error {
+	if err := Validate(content); err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+	profiles, err := ParseProfiles(content)
+	if err != nil {
+		return fmt.Errorf("parsing policy: %w", err)
+	}
+	if len(profiles) != 1 {
+		return fmt.Errorf("policy defines %d profiles instead of one", len(profiles))
+	}
+	if profiles[0].Name != name {
+		return fmt.Errorf("policy defines profile %q instead of %q", profiles[0].Name, name)
+	}
+	return nil
+}
+
+func validateRule(rule string) error {
+	fields := ruleFields(rule)
+	deny := false
+	for len(fields) > 0 && isQualifier(fields[0]) {
+		deny = deny || fields[0] == "deny"
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return errors.New("rule has no content")
+	}
+
+	switch kind := fields[0]; {
+	case kind == "capability":
+		return validateCapabilities(fields[1:])
+	case kind == "file":
+		fields = fields[1:]
+	case isRuleKind(kind):
+		return nil
+	}
+
+	if len(fields) > 1 && !isPath(fields[0]) && isPath(fields[1]) {
+		// Permissions can precede the path as well.
+		fields[0], fields[1] = fields[1], fields[0]
+	}
+	if len(fields) == 0 || !isPath(fields[0]) {
+		return nil
+	}
+	return validateFile(fields, deny)
+}
+
+func validateCapabilities(names []string) error {
+	for _, name := range names {
+		if !slices.Contains(capabilities, name) {
+			return fmt.Errorf("unknown capability %q", name)
+		}
+	}
+	return nil
+}
+
+// validateFile validates a file rule consisting of the path, the
+// permissions and an optional exec transition target.
+func validateFile(fields []string, deny bool) error {
+	if strings.HasPrefix(fields[0], `"`) {
+		// Quoted paths may contain spaces.
+		path, rest := splitPath(strings.Join(fields, " "))
+		fields = append([]string{path}, strings.Fields(rest)...)
+	}
+	if err := validatePath(strings.Trim(fields[0], `"`)); err != nil {
+		return err
+	}
+	if len(fields) == 1 {
+		return errors.New("file rule has no permissions")
+	}
+	if len(fields) > 2 && (fields[2] != "->" || len(fields) != 4) {
+		return fmt.Errorf("unexpected %q after permissions", strings.Join(fields[2:], " "))
+	}
+	return validatePermissions(fields[1], deny)
+}
+
+// validatePath validates the globbing of a path, which supports variables,
+// alternations and character classes.
+func validatePath(path string) error {
+	braces, inClass := 0, false
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == '\\':
+			i++
+
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+
+		case c == '[':
+			if strings.HasPrefix(path[i+1:], "]") || strings.HasPrefix(path[i+1:], "^]") {
+				return fmt.Errorf("path %q contains an empty character class", path)
+			}
+			inClass = true
+
+		case c == ']':
+			return fmt.Errorf("path %q contains an unbalanced ']'", path)
+
+		case c == '@' && strings.HasPrefix(path[i+1:], "{"):
+			end := strings.IndexByte(path[i+2:], '}')
+			if end < 0 {
+				return fmt.Errorf("path %q contains an unterminated variable", path)
+			}
+			if name := path[i+2 : i+2+end]; !variableNameRegex.MatchString(name) {
+				return fmt.Errorf("path %q contains the invalid variable name %q", path, name)
+			}
+			i += end + 2
+
+		case c == '{':
+			braces++
+
+		case c == '}':
+			braces--
+			if braces < 0 {
+				return fmt.Errorf("path %q contains an unbalanced '}'", path)
+			}
+		}
+	}
+
+	if inClass {
+		return fmt.Errorf("path %q contains an unterminated '['", path)
+	}
+	if braces > 0 {
+		return fmt.Errorf("path %q contains an unterminated '{'", path)
+	}
+	return nil
+}
+
+// validatePermissions validates the permission letters of a file rule.
+func validatePermissions(perms string, deny bool) error {
+	exec, qualifiers := false, []rune{}
+	for _, p := range perms {
+		switch p {
+		case 'r', 'w', 'a', 'l', 'k', 'm':
+		case 'x':
+			exec = true
+		case 'i', 'p', 'P', 'c', 'C', 'u', 'U':
+			if !slices.Contains(qualifiers, p) {
+				qualifiers = append(qualifiers, p)
+			}
+		default:
+			return fmt.Errorf("invalid permission %q in %q", p, perms)
+		}
+	}
+
+	if strings.Contains(perms, "w") && strings.Contains(perms, "a") {
+		return fmt.Errorf("permissions %q cannot combine write and append", perms)
+	}
+	if len(qualifiers) > 0 && !exec {
+		return fmt.Errorf("permissions %q contain an exec qualifier without 'x'", perms)
+	}
+	if !exec {
+		return nil
+	}
+	if deny {
+		if len(qualifiers) > 0 {
+			return fmt.Errorf("permissions %q of a deny rule cannot contain exec qualifiers", perms)
+		}
+		return nil
+	}
+	slices.Sort(qualifiers)
+	if !slices.Contains(execModes, string(qualifiers)) {
+		return fmt.Errorf("permissions %q contain an invalid exec mode", perms)
+	}
+	return nil
+}
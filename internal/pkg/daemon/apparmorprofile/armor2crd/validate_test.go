@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package armor2crd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	emptyProfile, err := crd2armor.GenerateProfile("empty", false, &apparmorprofileapi.AppArmorAbstract{})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "generated",
+			content: emptyProfile,
+		},
+		{
+			name: "valid",
+			content: `
+abi <abi/3.0>,
+@{HOME}=/home/*/
+profile test /usr/bin/test flags=(complain) {
+  #include <abstractions/base>
+  capability net_bind_service setuid,
+  owner @{HOME}/** rw,
+  "/opt/my app/{bin,lib}/*" mr,
+  /usr/bin/helper Px -> helper,
+  /usr/bin/other pix,
+  deny /etc/shadow rwx,
+  file r /srv/[a-z]*.conf,
+  network inet tcp,
+  userns,
+
+  ^hat {
+    /tmp/** rw,
+  }
+}
+`,
+		},
+		{
+			name:    "not closed",
+			content: "profile test {\n  /tmp/** rw,\n",
+			wantErr: "profile is not closed",
+		},
+		{
+			name:    "unbalanced closing brace",
+			content: "profile test {\n}\n}\n",
+			wantErr: "line 3: unbalanced closing brace",
+		},
+		{
+			name:    "not terminated",
+			content: "profile test {\n  /tmp/** rw\n}\n",
+			wantErr: "rule is not terminated by a comma",
+		},
+		{
+			name:    "no profile",
+			content: "#include <tunables/global>\n",
+			wantErr: "no profile found",
+		},
+		{
+			name:    "rule outside of a profile",
+			content: "/tmp/** rw,\nprofile test {\n}\n",
+			wantErr: "line 1: rule outside of a profile",
+		},
+		{
+			name:    "unknown capability",
+			content: "profile test {\n  capability CAP_SYS_ADMIN,\n}\n",
+			wantErr: `line 2: capability CAP_SYS_ADMIN: unknown capability "CAP_SYS_ADMIN"`,
+		},
+		{
+			name:    "invalid permission",
+			content: "profile test {\n  /tmp/** rwz,\n}\n",
+			wantErr: `invalid permission 'z' in "rwz"`,
+		},
+		{
+			name:    "no permissions",
+			content: "profile test {\n  /tmp/**,\n}\n",
+			wantErr: "file rule has no permissions",
+		},
+		{
+			name:    "write and append",
+			content: "profile test {\n  /tmp/** wa,\n}\n",
+			wantErr: "cannot combine write and append",
+		},
+		{
+			name:    "exec without qualifier",
+			content: "profile test {\n  /usr/bin/test x,\n}\n",
+			wantErr: "invalid exec mode",
+		},
+		{
+			name:    "qualifier without exec",
+			content: "profile test {\n  /usr/bin/test ir,\n}\n",
+			wantErr: "exec qualifier without 'x'",
+		},
+		{
+			name:    "conflicting exec qualifiers",
+			content: "profile test {\n  /usr/bin/test pcx,\n}\n",
+			wantErr: "invalid exec mode",
+		},
+		{
+			name:    "deny with exec qualifier",
+			content: "profile test {\n  deny /usr/bin/test ix,\n}\n",
+			wantErr: "deny rule cannot contain exec qualifiers",
+		},
+		{
+			name:    "unterminated alternation",
+			content: "profile test {\n  \"/tmp/{a,b/**\" rw,\n}\n",
+			wantErr: `contains an unterminated '{'`,
+		},
+		{
+			name:    "unterminated character class",
+			content: "profile test {\n  /tmp/[a-z/** rw,\n}\n",
+			wantErr: `contains an unterminated '['`,
+		},
+		{
+			name:    "empty character class",
+			content: "profile test {\n  /tmp/[]/** rw,\n}\n",
+			wantErr: "contains an empty character class",
+		},
+		{
+			name:    "invalid variable",
+			content: "profile test {\n  @{MY-VAR}/** rw,\n}\n",
+			wantErr: `invalid variable name "MY-VAR"`,
+		},
+		{
+			name:    "unexpected field",
+			content: "profile test {\n  /tmp/** rw foo,\n}\n",
+			wantErr: `unexpected "foo" after permissions`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(tc.content)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -51,22 +51,40 @@ profile {{.Name}} flags=({{.ProfileMode}},attach_disconnected,mediate_deleted) {
 {{end}}{{end}}
 {{ if ne .Abstract.Filesystem.ReadWritePaths nil }}
 {{range $readwrite := .Abstract.Filesystem.ReadWritePaths}}  {{$readwrite}} rwlk,
-{{end}}{{end}}{{end}}
+{{end}}{{end}}
+{{range $rule := .Abstract.Filesystem.FileRules}}  {{fileRule $rule}},
+{{end}}{{end}}
 
   # Network rules
 {{ if ne .Abstract.Network nil }}{{ if ne .Abstract.Network.AllowRaw nil }}
-{{ if .Abstract.Network.AllowRaw}}  network raw,{{else}}  deny network raw,
+{{ if isTrue .Abstract.Network.AllowRaw}}  network raw,{{else}}  deny network raw,
 {{end}}{{end}}
 {{ if ne .Abstract.Network.Protocols nil }}
 {{if ne .Abstract.Network.Protocols.AllowTCP nil }}
-{{if .Abstract.Network.Protocols.AllowTCP}}  network tcp,
+{{if isTrue .Abstract.Network.Protocols.AllowTCP}}  network tcp,
 {{end}}{{end}}{{if ne .Abstract.Network.Protocols.AllowUDP nil }}
-{{if .Abstract.Network.Protocols.AllowUDP}}  network udp,
+{{if isTrue .Abstract.Network.Protocols.AllowUDP}}  network udp,
 {{end}}{{end}}{{end}}{{end}}
-
+{{range $rule := .Abstract.Unix}}  {{unixRule $rule}},
+{{end}}
   # Capabilities rules
 {{ if ne .Abstract.Capability nil}}{{range $cap := .Abstract.Capability.AllowedCapabilities}}  capability {{$cap}},
 {{end}}{{end}}
+  # Signal and ptrace rules
+{{range $rule := .Abstract.Signal}}  {{signalRule $rule}},
+{{end}}{{range $rule := .Abstract.Ptrace}}  {{ptraceRule $rule}},
+{{end}}
+  # Mount rules
+{{ if ne .Abstract.Mount nil }}{{range $rule := .Abstract.Mount.Mounts}}  {{mountRule $rule}},
+{{end}}{{range $mountPoint := .Abstract.Mount.Umounts}}  umount {{$mountPoint}},
+{{end}}{{range $root := .Abstract.Mount.PivotRoots}}  pivot_root {{$root}},
+{{end}}{{end}}
+  # D-Bus rules
+{{range $rule := .Abstract.DBus}}  {{dbusRule $rule}},
+{{end}}
+  # Change profile rules
+{{range $rule := .Abstract.ChangeProfile}}  {{changeProfileRule $rule}},
+{{end}}
 
   # Raw rules placeholder
 
@@ -79,8 +97,8 @@ profile {{.Name}} flags=({{.ProfileMode}},attach_disconnected,mediate_deleted) {
   deny @{PROC}/mem rwklx,
   deny @{PROC}/kmem rwklx,
   deny @{PROC}/kcore rwklx,
-  deny mount,
-  deny /sys/[^f]*/** wklx,
+{{ if eq .Abstract.Mount nil }}  deny mount,
+{{end}}  deny /sys/[^f]*/** wklx,
   deny /sys/f[^s]*/** wklx,
   deny /sys/fs/[^c]*/** wklx,
   deny /sys/fs/c[^g]*/** wklx,
@@ -110,8 +128,11 @@ func GenerateProfile(name string, complainMode bool, abstract *apparmorprofileap
 	if abstract == nil {
 		return "", errors.New("abstract cannot be nil")
 	}
+	if err := ValidateRules(abstract); err != nil {
+		return "", err
+	}
 
-	tpl, err := template.New("apparmor").Parse(appArmorTemplate)
+	tpl, err := template.New("apparmor").Funcs(ruleFuncs).Parse(appArmorTemplate)
 	if err != nil {
 		return "", err
 	}
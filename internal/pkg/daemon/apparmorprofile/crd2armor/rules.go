@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+// ruleValuePattern matches the free-form values of the rules, which must not
+// contain characters that end the rule or start a new one. Commas are only
+// allowed within a single level of braces for alternations like /{a,b}. It
+// has to be kept in sync with the validation pattern of the CRD.
+var ruleValuePattern = regexp.MustCompile(`^(?:[^\s,(){}"#]|\{[^\s(){}"#]*\})*$`)
+
+var ruleFuncs = template.FuncMap{
+	"isTrue":            isTrue,
+	"fileRule":          fileRule,
+	"signalRule":        signalRule,
+	"ptraceRule":        ptraceRule,
+	"mountRule":         mountRule,
+	"unixRule":          unixRule,
+	"dbusRule":          dbusRule,
+	"changeProfileRule": changeProfileRule,
+}
+
+// ErrInvalidRuleValue is returned if a value of a rule contains characters
+// which are not allowed in the AppArmor profile syntax.
+var ErrInvalidRuleValue = errors.New(
+	"value must not contain whitespace, quotes, parentheses, # or commas outside of braces",
+)
+
+// FilePermissions maps the file permissions of the CRD to the ones of the
+// AppArmor profile syntax, in the order of their appearance within a rule.
+var FilePermissions = []struct {
+	Permission apparmorprofileapi.AppArmorFilePermission
+	Mode       string
+}{
+	{apparmorprofileapi.AppArmorFilePermissionRead, "r"},
+	{apparmorprofileapi.AppArmorFilePermissionWrite, "w"},
+	{apparmorprofileapi.AppArmorFilePermissionAppend, "a"},
+	{apparmorprofileapi.AppArmorFilePermissionLink, "l"},
+	{apparmorprofileapi.AppArmorFilePermissionLock, "k"},
+	{apparmorprofileapi.AppArmorFilePermissionMmap, "m"},
+	{apparmorprofileapi.AppArmorFilePermissionExec, "ix"},
+}
+
+// Rules returns the fine-grained file rules and the signal, ptrace, mount,
+// unix, D-Bus and change profile rules of the abstract in the AppArmor
+// profile syntax, keyed by the field of the abstract.
+func Rules(abstract *apparmorprofileapi.AppArmorAbstract) map[string][]string {
+	res := map[string][]string{}
+	if abstract.Filesystem != nil {
+		res["filesystem/fileRules"] = rules(abstract.Filesystem.FileRules, fileRule)
+	}
+	res["signal"] = rules(abstract.Signal, signalRule)
+	res["ptrace"] = rules(abstract.Ptrace, ptraceRule)
+	if abstract.Mount != nil {
+		res["mount/mounts"] = rules(abstract.Mount.Mounts, mountRule)
+		res["mount/umounts"] = abstract.Mount.Umounts
+		res["mount/pivotRoots"] = abstract.Mount.PivotRoots
+	}
+	res["unix"] = rules(abstract.Unix, unixRule)
+	res["dbus"] = rules(abstract.DBus, dbusRule)
+	res["changeProfile"] = rules(abstract.ChangeProfile, changeProfileRule)
+	return res
+}
+
+// ValidateRules verifies that the free-form values of the fine-grained file
+// rules and the signal, ptrace, mount, unix, D-Bus and change profile rules
+// cannot alter the structure of the generated AppArmor profile.
+func ValidateRules(abstract *apparmorprofileapi.AppArmorAbstract) error {
+	values := map[string][]string{}
+	if abstract.Filesystem != nil {
+		for _, r := range abstract.Filesystem.FileRules {
+			values["filesystem/fileRules/path"] = append(values["filesystem/fileRules/path"], r.Path)
+		}
+	}
+	for _, r := range abstract.Signal {
+		values["signal/signals"] = append(values["signal/signals"], r.Signals...)
+		values["signal/peer"] = append(values["signal/peer"], r.Peer)
+	}
+	for _, r := range abstract.Ptrace {
+		values["ptrace/peer"] = append(values["ptrace/peer"], r.Peer)
+	}
+	if abstract.Mount != nil {
+		for _, r := range abstract.Mount.Mounts {
+			values["mount/mounts/fsTypes"] = append(values["mount/mounts/fsTypes"], r.FsTypes...)
+			values["mount/mounts/options"] = append(values["mount/mounts/options"], r.Options...)
+			values["mount/mounts/source"] = append(values["mount/mounts/source"], r.Source)
+			values["mount/mounts/mountPoint"] = append(values["mount/mounts/mountPoint"], r.MountPoint)
+		}
+		values["mount/umounts"] = abstract.Mount.Umounts
+		values["mount/pivotRoots"] = abstract.Mount.PivotRoots
+	}
+	for _, r := range abstract.Unix {
+		values["unix/address"] = append(values["unix/address"], r.Address)
+		values["unix/peer"] = append(values["unix/peer"], r.Peer)
+		values["unix/peerAddress"] = append(values["unix/peerAddress"], r.PeerAddress)
+	}
+	for _, r := range abstract.DBus {
+		values["dbus/bus"] = append(values["dbus/bus"], r.Bus)
+		values["dbus/name"] = append(values["dbus/name"], r.Name)
+		values["dbus/path"] = append(values["dbus/path"], r.Path)
+		values["dbus/interface"] = append(values["dbus/interface"], r.Interface)
+		values["dbus/member"] = append(values["dbus/member"], r.Member)
+		values["dbus/peerName"] = append(values["dbus/peerName"], r.PeerName)
+		values["dbus/peer"] = append(values["dbus/peer"], r.Peer)
+	}
+	for _, r := range abstract.ChangeProfile {
+		// An empty target would allow changing to any profile.
+		if r.Target == "" {
+			return fmt.Errorf("empty value for changeProfile/target: %w", ErrInvalidRuleValue)
+		}
+		values["changeProfile/executable"] = append(values["changeProfile/executable"], r.Executable)
+		values["changeProfile/target"] = append(values["changeProfile/target"], r.Target)
+	}
+
+	for _, field := range slices.Sorted(maps.Keys(values)) {
+		for _, value := range values[field] {
+			if !ruleValuePattern.MatchString(value) {
+				return fmt.Errorf("invalid value %q for %s: %w", value, field, ErrInvalidRuleValue)
+			}
+		}
+	}
+	return nil
+}
+
+func rules[T any](list []T, rule func(T) string) []string {
+	res := make([]string, 0, len(list))
+	for _, r := range list {
+		res = append(res, rule(r))
+	}
+	return res
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+// fileRule returns the AppArmor rule for the fine-grained file rule.
+func fileRule(rule apparmorprofileapi.AppArmorFileRule) string {
+	mode := ""
+	for _, p := range FilePermissions {
+		if !slices.Contains(rule.Permissions, p.Permission) {
+			continue
+		}
+		// Write includes append and both cannot be combined in a rule.
+		if p.Permission == apparmorprofileapi.AppArmorFilePermissionAppend &&
+			slices.Contains(rule.Permissions, apparmorprofileapi.AppArmorFilePermissionWrite) {
+			continue
+		}
+		mode += p.Mode
+	}
+
+	res := rule.Path + " " + mode
+	if rule.Owner {
+		res = "owner " + res
+	}
+	return res
+}
+
+// signalRule returns the AppArmor rule for the signal rule.
+func signalRule(rule apparmorprofileapi.AppArmorSignalRule) string {
+	parts := []string{"signal"}
+	parts = appendList(parts, "", rule.Access)
+	parts = appendList(parts, "set=", rule.Signals)
+	parts = appendValue(parts, "peer=", rule.Peer)
+	return strings.Join(parts, " ")
+}
+
+// ptraceRule returns the AppArmor rule for the ptrace rule.
+func ptraceRule(rule apparmorprofileapi.AppArmorPtraceRule) string {
+	parts := []string{"ptrace"}
+	parts = appendList(parts, "", rule.Access)
+	parts = appendValue(parts, "peer=", rule.Peer)
+	return strings.Join(parts, " ")
+}
+
+// mountRule returns the AppArmor rule for the mount rule.
+func mountRule(rule apparmorprofileapi.AppArmorMountRule) string {
+	parts := []string{"mount"}
+	parts = appendList(parts, "fstype=", rule.FsTypes)
+	parts = appendList(parts, "options=", rule.Options)
+	parts = appendValue(parts, "", rule.Source)
+	parts = appendValue(parts, "-> ", rule.MountPoint)
+	return strings.Join(parts, " ")
+}
+
+// unixRule returns the AppArmor rule for the unix socket rule.
+func unixRule(rule apparmorprofileapi.AppArmorUnixRule) string {
+	parts := []string{"unix"}
+	parts = appendList(parts, "", rule.Access)
+	parts = appendValue(parts, "type=", rule.Type)
+	parts = appendValue(parts, "addr=", rule.Address)
+	peer := appendValue(appendValue(nil, "label=", rule.Peer), "addr=", rule.PeerAddress)
+	if len(peer) > 0 {
+		parts = append(parts, "peer=("+strings.Join(peer, " ")+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+// dbusRule returns the AppArmor rule for the D-Bus rule.
+func dbusRule(rule apparmorprofileapi.AppArmorDBusRule) string {
+	parts := []string{"dbus"}
+	parts = appendList(parts, "", rule.Access)
+	parts = appendValue(parts, "bus=", rule.Bus)
+	parts = appendValue(parts, "name=", rule.Name)
+	parts = appendValue(parts, "path=", rule.Path)
+	parts = appendValue(parts, "interface=", rule.Interface)
+	parts = appendValue(parts, "member=", rule.Member)
+	peer := appendValue(appendValue(nil, "name=", rule.PeerName), "label=", rule.Peer)
+	if len(peer) > 0 {
+		parts = append(parts, "peer=("+strings.Join(peer, " ")+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+// changeProfileRule returns the AppArmor rule for the change profile rule.
+func changeProfileRule(rule apparmorprofileapi.AppArmorChangeProfileRule) string {
+	parts := []string{"change_profile"}
+	parts = appendValue(parts, "", rule.Executable)
+	parts = appendValue(parts, "-> ", rule.Target)
+	return strings.Join(parts, " ")
+}
+
+func appendValue(parts []string, prefix, value string) []string {
+	if value == "" {
+		return parts
+	}
+	return append(parts, prefix+value)
+}
+
+func appendList[T ~string](parts []string, prefix string, values []T) []string {
+	if len(values) == 0 {
+		return parts
+	}
+	list := make([]string, 0, len(values))
+	for _, v := range values {
+		list = append(list, string(v))
+	}
+	return append(parts, prefix+"("+strings.Join(list, ", ")+")")
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crd2armor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+func TestValidateRules(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		abstract apparmorprofileapi.AppArmorAbstract
+		wantErr  string
+	}{
+		{
+			name: "valid values",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				Filesystem: &apparmorprofileapi.AppArmorFsRules{
+					FileRules: []apparmorprofileapi.AppArmorFileRule{{Path: "/etc/{passwd,group}"}},
+				},
+				Signal: []apparmorprofileapi.AppArmorSignalRule{{Signals: []string{"term"}, Peer: "other"}},
+				Mount: &apparmorprofileapi.AppArmorMountRules{
+					Mounts: []apparmorprofileapi.AppArmorMountRule{{Options: []string{"ro"}, MountPoint: "/mnt/**"}},
+				},
+				DBus: []apparmorprofileapi.AppArmorDBusRule{{Bus: "system", Path: "/org/freedesktop/DBus"}},
+			},
+		},
+		{
+			name: "comma in peer",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				Ptrace: []apparmorprofileapi.AppArmorPtraceRule{{Peer: "other, capability sys_admin"}},
+			},
+			wantErr: `invalid value "other, capability sys_admin" for ptrace/peer`,
+		},
+		{
+			name: "closing brace in path",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				DBus: []apparmorprofileapi.AppArmorDBusRule{{Path: "/org}"}},
+			},
+			wantErr: `invalid value "/org}" for dbus/path`,
+		},
+		{
+			name: "newline in mount option",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				Mount: &apparmorprofileapi.AppArmorMountRules{
+					Mounts: []apparmorprofileapi.AppArmorMountRule{{Options: []string{"ro\n  file"}}},
+				},
+			},
+			wantErr: `invalid value "ro\n  file" for mount/mounts/options`,
+		},
+		{
+			name: "parenthesis in unix peer address",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				Unix: []apparmorprofileapi.AppArmorUnixRule{{PeerAddress: "@a) label=(b"}},
+			},
+			wantErr: `invalid value "@a) label=(b" for unix/peerAddress`,
+		},
+		{
+			name: "empty change profile target",
+			abstract: apparmorprofileapi.AppArmorAbstract{
+				ChangeProfile: []apparmorprofileapi.AppArmorChangeProfileRule{{Executable: "/usr/bin/app"}},
+			},
+			wantErr: "empty value for changeProfile/target",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateRules(&tc.abstract)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidRuleValue)
+			require.ErrorContains(t, err, tc.wantErr)
+
+			_, err = GenerateProfile("test", false, &tc.abstract)
+			require.ErrorIs(t, err, ErrInvalidRuleValue)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/armor2crd"
+)
+
+// NewRawController returns a new empty controller instance for
+// RawAppArmorProfiles.
+func NewRawController() controller.Controller {
+	return &Reconciler{raw: true}
+}
+
+// validateRawPolicy validates the syntax of the policy of the
+// RawAppArmorProfile, which has to define exactly one profile named like the
+// RawAppArmorProfile.
+func validateRawPolicy(profile *v1alpha1.RawAppArmorProfile) error {
+	return armor2crd.ValidateProfile(profile.Spec.Policy, profile.GetProfileName())
+}
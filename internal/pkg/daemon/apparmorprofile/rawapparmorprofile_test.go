@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+func TestValidateRawPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		policy      string
+		expectedErr string
+	}{
+		{
+			name: "valid policy",
+			policy: `#include <tunables/global>
+
+profile raw flags=(attach_disconnected) {
+  #include <abstractions/base>
+  file,
+  ^hat {
+    /etc/hat r,
+  }
+}
+`,
+		},
+		{
+			name:        "not closed",
+			policy:      "profile raw {\n  file,\n",
+			expectedErr: "invalid policy: profile is not closed",
+		},
+		{
+			name:        "no profile",
+			policy:      "#include <tunables/global>\n",
+			expectedErr: "invalid policy: no profile found",
+		},
+		{
+			name:        "invalid permission",
+			policy:      "profile raw {\n  /etc/raw rz,\n}\n",
+			expectedErr: `invalid policy: line 2: /etc/raw rz: invalid permission 'z' in "rz"`,
+		},
+		{
+			name:        "multiple profiles",
+			policy:      "profile raw {\n}\nprofile other {\n}\n",
+			expectedErr: "policy defines 2 profiles instead of one",
+		},
+		{
+			name:        "other name",
+			policy:      "/usr/bin/raw {\n}\n",
+			expectedErr: `policy defines profile "/usr/bin/raw" instead of "raw"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRawPolicy(&v1alpha1.RawAppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "raw"},
+				Spec:       v1alpha1.RawAppArmorProfileSpec{Policy: tc.policy},
+			})
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
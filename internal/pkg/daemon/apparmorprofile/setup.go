@@ -18,10 +18,10 @@ package apparmorprofile
 
 import (
 	"context"
+	"strings"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
@@ -33,15 +33,18 @@ func (r *Reconciler) Setup(
 ) error {
 	r.client = mgr.GetClient()
 	r.log = ctrl.Log.WithName(r.Name())
-	r.record = mgr.GetEventRecorderFor("apparmorprofile")
+	r.record = mgr.GetEventRecorderFor(strings.ToLower(r.kind()))
 	r.metrics = met
 	r.manager = NewAppArmorProfileManager(r.log)
 
-	r.logNodeInfo()
+	if !r.raw {
+		r.logNodeInfo()
+	}
 
-	// Register the regular reconciler to manage AppArmorProfiles
+	// Register the regular reconciler to manage AppArmorProfiles or
+	// RawAppArmorProfiles
 	return ctrl.NewControllerManagedBy(mgr).
-		Named("apparmorprofile").
-		For(&v1alpha1.AppArmorProfile{}).
+		Named(strings.ToLower(r.kind())).
+		For(r.newProfile()).
 		Complete(r)
 }
@@ -11,6 +11,8 @@
 #define MAX_ENTRIES 8 * 1024
 #define MAX_SYSCALLS 1024
 #define MAX_CHILD_PIDS 1024
+#define MAX_SYSCALL_ARGS 6
+#define MAX_SYSCALL_ARG_ENTRIES 64 * 1024
 
 // We don't have TASK_COMM_LEN in userspace, so we define
 // a static MAX_COMM_LEN which is supposed to be >= TASK_COMM_LEN
@@ -22,6 +24,7 @@
 #define EVENT_TYPE_APPARMOR_SOCKET 3
 #define EVENT_TYPE_APPARMOR_CAP 4
 #define EVENT_TYPE_CLEAR_MNTNS 5
+#define EVENT_TYPE_SYSCALL 6
 
 #define FLAG_READ 0x1
 #define FLAG_WRITE 0x2
@@ -80,6 +83,29 @@ struct {
     __type(value, u8[MAX_SYSCALLS]);  // syscall IDs
 } mntns_syscalls SEC(".maps");
 
+// Argument masks for all syscalls where the argument values should be
+// recorded, populated from userspace. An argument with a zero mask is ignored.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_SYSCALLS);
+    __type(key, u32);                      // syscall ID
+    __type(value, u64[MAX_SYSCALL_ARGS]);  // argument masks
+} syscall_arg_masks SEC(".maps");
+
+typedef struct __attribute__((__packed__)) syscall_args {
+    u32 mntns;
+    u32 syscall_id;
+    u64 args[MAX_SYSCALL_ARGS];  // masked argument values
+} syscall_args_t;
+
+// Track the observed syscall arguments for each mntns
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __uint(max_entries, MAX_SYSCALL_ARG_ENTRIES);
+    __type(key, syscall_args_t);
+    __type(value, bool);
+} mntns_syscall_args SEC(".maps");
+
 // Track active (known) PIDs
 struct {
     __uint(type, BPF_MAP_TYPE_HASH);
@@ -444,13 +470,12 @@ int BPF_PROG(path_unlink, struct path * dir, struct dentry * dentry)
     return register_fs_event(&path, 0, FLAG_READ | FLAG_WRITE, true);
 }
 
-SEC("tracepoint/syscalls/sys_enter_socket")
-int sys_enter_socket(struct trace_event_raw_sys_enter * ctx)
+static __always_inline int
+register_socket_event(struct trace_event_raw_sys_enter * ctx)
 {
     u32 mntns = get_mntns();
     if (!mntns)
         return 0;
-    trace_hook("sys_enter_socket");
 
     u32 pid = bpf_get_current_pid_tgid() >> 32;
 
@@ -461,8 +486,14 @@ int sys_enter_socket(struct trace_event_raw_sys_enter * ctx)
         event->mntns = mntns;
         event->type = EVENT_TYPE_APPARMOR_SOCKET;
 
-        u64 type;
+        u64 domain, type;
         int res;
+        res = bpf_core_read(&domain, sizeof(domain), &ctx->args[0]);
+        if (res != 0) {
+            bpf_printk("failed to get socket domain");
+            bpf_ringbuf_discard(event, 0);
+            return 0;
+        }
         res = bpf_core_read(&type, sizeof(type), &ctx->args[1]);
         if (res != 0) {
             bpf_printk("failed to get socket type");
@@ -470,15 +501,30 @@ int sys_enter_socket(struct trace_event_raw_sys_enter * ctx)
             return 0;
         }
 
-        event->flags = type;
+        // The domain is passed in the upper half of the flags.
+        event->flags = (type & 0xFFFFFFFF) | (domain << 32);
 
-        trace_hook("requesting raw socket");
+        trace_hook("requesting socket");
         bpf_ringbuf_submit(event, 0);
     }
 
     return 0;
 }
 
+SEC("tracepoint/syscalls/sys_enter_socket")
+int sys_enter_socket(struct trace_event_raw_sys_enter * ctx)
+{
+    trace_hook("sys_enter_socket");
+    return register_socket_event(ctx);
+}
+
+SEC("tracepoint/syscalls/sys_enter_socketpair")
+int sys_enter_socketpair(struct trace_event_raw_sys_enter * ctx)
+{
+    trace_hook("sys_enter_socketpair");
+    return register_socket_event(ctx);
+}
+
 SEC("kprobe/cap_capable")
 int BPF_KPROBE(cap_capable)
 {
@@ -602,6 +648,53 @@ int sys_exit_clone(struct trace_event_raw_sys_exit * ctx)
     return 0;
 }
 
+// Record the masked argument values of the syscall if requested by userspace.
+// Entries recorded during container initialization are not cleared by
+// clear_mntns, which means they may widen the resulting argument filters
+// slightly for syscalls that are also used by the workload itself.
+static __always_inline void record_syscall_args(
+    struct trace_event_raw_sys_enter * args, u32 mntns, u32 syscall_id)
+{
+    u64 * masks = bpf_map_lookup_elem(&syscall_arg_masks, &syscall_id);
+    if (!masks) {
+        return;
+    }
+
+    // The context cannot be accessed with a variable offset.
+    u64 values[MAX_SYSCALL_ARGS] = {};
+    if (bpf_core_read(&values, sizeof(values), &args->args) != 0) {
+        return;
+    }
+
+    syscall_args_t key = {
+        .mntns = mntns,
+        .syscall_id = syscall_id,
+    };
+    for (int i = 0; i < MAX_SYSCALL_ARGS; i++) {
+        key.args[i] = values[i] & masks[i];
+    }
+
+    bpf_map_update_elem(&mntns_syscall_args, &key, &TRUE, BPF_NOEXIST);
+}
+
+// Notify the userspace about a syscall which got observed for the first time
+// in the mount namespace. This allows to stream the recording progress.
+static __always_inline void notify_syscall(u32 pid, u32 mntns, u32 syscall_id)
+{
+    event_data_t * event =
+        bpf_ringbuf_reserve(&events, sizeof(event_data_t), 0);
+    if (!event) {
+        return;
+    }
+
+    event->type = EVENT_TYPE_SYSCALL;
+    event->pid = pid;
+    event->mntns = mntns;
+    event->flags = syscall_id;
+
+    bpf_ringbuf_submit(event, 0);
+}
+
 SEC("tracepoint/raw_syscalls/sys_enter")
 int sys_enter(struct trace_event_raw_sys_enter * args)
 {
@@ -642,7 +735,10 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
     u8 * const mntns_syscall_value =
         bpf_map_lookup_elem(&mntns_syscalls, &mntns);
     if (mntns_syscall_value) {
-        mntns_syscall_value[syscall_id] = 1;
+        if (mntns_syscall_value[syscall_id] == 0) {
+            mntns_syscall_value[syscall_id] = 1;
+            notify_syscall(pid, mntns, syscall_id);
+        }
     } else {
         // Initialise the syscalls recording buffer and record this syscall.
         static const char init[MAX_SYSCALLS];
@@ -656,8 +752,11 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
             return 0;
         }
         value[syscall_id] = 1;
+        notify_syscall(pid, mntns, syscall_id);
     }
 
+    record_syscall_args(args, mntns, syscall_id);
+
     return 0;
 }
 
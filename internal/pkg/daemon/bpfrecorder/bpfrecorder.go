@@ -70,6 +70,7 @@ const (
 	eventTypeAppArmorSocket int           = 3
 	eventTypeAppArmorCap    int           = 4
 	eventTypeClearMntns     int           = 5
+	eventTypeSyscall        int           = 6
 	excludeMntnsEnabled     byte          = 1
 )
 
@@ -96,6 +97,7 @@ type BpfRecorder struct {
 	Seccomp  *SeccompRecorder
 
 	recordedExits sync.Map
+	progress      *progressBroadcaster
 }
 
 // We use a single shared event ringbuf for all userspace communication.
@@ -133,9 +135,19 @@ func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor b
 		AppArmor:                appArmor,
 		Seccomp:                 seccomp,
 		recordedExits:           sync.Map{},
+		progress:                newProgressBroadcaster(),
 	}
 }
 
+// SetSyscallArgs enables the recording of the argument values for the
+// provided syscalls.
+func (b *BpfRecorder) SetSyscallArgs(syscalls []string) error {
+	if b.Seccomp == nil {
+		return errors.New("seccomp recording is not enabled")
+	}
+	return b.Seccomp.SetSyscallArgs(syscalls)
+}
+
 // Syscalls returns the bpf map containing the PID (key) to syscalls (value)
 // data.
 func (b *BpfRecorder) Syscalls() *bpf.BPFMap {
@@ -329,12 +341,24 @@ func (b *BpfRecorder) SyscallsForProfile(
 		return nil, err
 	}
 	b.attachUnattachMutex.RLock()
-	syscalls, err := b.Seccomp.PopSyscalls(b, mntns)
-	b.attachUnattachMutex.RUnlock()
+	var syscalls []string
+	if r.GetKeep() {
+		syscalls, err = b.Seccomp.Syscalls(b, mntns)
+	} else {
+		syscalls, err = b.Seccomp.PopSyscalls(b, mntns)
+	}
 	if err != nil {
+		b.attachUnattachMutex.RUnlock()
 		b.logger.Error(err, "Failed to get syscalls for mntns", "mntns", mntns)
 		return nil, err
 	}
+	var args []*api.SyscallArgs
+	if r.GetKeep() {
+		args = b.Seccomp.SyscallArgs(b, mntns)
+	} else {
+		args = b.Seccomp.PopSyscallArgs(b, mntns)
+	}
+	b.attachUnattachMutex.RUnlock()
 
 	b.logger.Info(
 		fmt.Sprintf("Found %d syscalls for profile", len(syscalls)),
@@ -345,6 +369,7 @@ func (b *BpfRecorder) SyscallsForProfile(
 	return &api.SyscallsResponse{
 		Syscalls: syscalls,
 		GoArch:   runtime.GOARCH,
+		Args:     args,
 	}, nil
 }
 
@@ -364,21 +389,32 @@ func (b *BpfRecorder) ApparmorForProfile(
 		return nil, err
 	}
 	b.attachUnattachMutex.RLock()
-	apparmor := b.AppArmor.GetAppArmorProcessed(mntns)
+	var apparmor BpfAppArmorProcessed
+	if r.GetKeep() {
+		apparmor = b.AppArmor.PeekAppArmorProcessed(mntns)
+	} else {
+		apparmor = b.AppArmor.GetAppArmorProcessed(mntns)
+	}
 	b.attachUnattachMutex.RUnlock()
+
+	// The API has no fine-grained file rules, but allowing writable
+	// libraries as both libraries and read write paths results in the
+	// same permissions.
+	files := apparmor.FileProcessed
 	return &api.ApparmorResponse{
 		Files: &api.ApparmorResponse_Files{
-			AllowedExecutables: apparmor.FileProcessed.AllowedExecutables,
-			AllowedLibraries:   apparmor.FileProcessed.AllowedLibraries,
-			ReadonlyPaths:      apparmor.FileProcessed.ReadOnlyPaths,
-			WriteonlyPaths:     apparmor.FileProcessed.WriteOnlyPaths,
-			ReadwritePaths:     apparmor.FileProcessed.ReadWritePaths,
+			AllowedExecutables: files.AllowedExecutables,
+			AllowedLibraries:   append(files.AllowedLibraries, files.WritableLibraries...),
+			ReadonlyPaths:      files.ReadOnlyPaths,
+			WriteonlyPaths:     files.WriteOnlyPaths,
+			ReadwritePaths:     append(files.ReadWritePaths, files.WritableLibraries...),
 		},
 		Capabilities: apparmor.Capabilities,
 		Socket: &api.ApparmorResponse_Socket{
-			UseRaw: apparmor.Socket.UseRaw,
-			UseTcp: apparmor.Socket.UseTCP,
-			UseUdp: apparmor.Socket.UseUDP,
+			UseRaw:    apparmor.Socket.UseRaw,
+			UseTcp:    apparmor.Socket.UseTCP,
+			UseUdp:    apparmor.Socket.UseUDP,
+			UnixTypes: apparmor.Socket.UnixTypes,
 		},
 	}, nil
 }
@@ -517,6 +553,12 @@ func (b *BpfRecorder) Load() (err error) {
 		b.logger.Info("Excluding mount namespace", "mntns", b.excludeMountNamespace)
 	}
 
+	if b.Seccomp != nil {
+		if err := b.Seccomp.loadSyscallArgMasks(b); err != nil {
+			return fmt.Errorf("load syscall arg masks: %w", err)
+		}
+	}
+
 	const timeout = 300
 	events := make(chan []byte)
 	ringbuf, err := b.InitRingBuf(
@@ -720,16 +762,20 @@ func (b *BpfRecorder) handleEvent(eventBytes []byte) {
 	case uint8(eventTypeAppArmorFile):
 		// b.AppArmor may be null if debug_add_canary_file reports a file event.
 		if b.AppArmor != nil {
-			b.AppArmor.handleFileEvent(&event)
+			b.publishProgress(b.AppArmor.handleFileEvent(&event))
 		}
 	case uint8(eventTypeAppArmorSocket):
-		b.AppArmor.handleSocketEvent(&event)
+		b.publishProgress(b.AppArmor.handleSocketEvent(&event))
 	case uint8(eventTypeAppArmorCap):
-		b.AppArmor.handleCapabilityEvent(&event)
+		b.publishProgress(b.AppArmor.handleCapabilityEvent(&event))
 	case uint8(eventTypeClearMntns):
 		if b.AppArmor != nil {
 			b.AppArmor.clearMntns(&event)
 		}
+	case uint8(eventTypeSyscall):
+		if b.Seccomp != nil && b.progress.hasSubscribers() {
+			b.publishProgress(b.Seccomp.handleSyscallEvent(b, &event))
+		}
 	}
 }
 
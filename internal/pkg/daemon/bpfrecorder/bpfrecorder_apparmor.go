@@ -30,17 +30,23 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 )
 
 const (
-	flagRead     uint64 = 0x1
-	flagWrite    uint64 = 0x2
-	flagExec     uint64 = 0x4
-	flagSpawn    uint64 = 0x8
-	sockStream   uint64 = 1
-	sockDgram    uint64 = 2
-	sockRaw      uint64 = 3
-	sockTypeMask uint64 = 0xF
+	flagRead      uint64 = 0x1
+	flagWrite     uint64 = 0x2
+	flagExec      uint64 = 0x4
+	flagSpawn     uint64 = 0x8
+	sockStream    uint64 = 1
+	sockDgram     uint64 = 2
+	sockRaw       uint64 = 3
+	sockSeqpacket uint64 = 5
+	sockTypeMask  uint64 = 0xF
+	// The socket domain is passed in the upper half of the flags.
+	sockDomainShift        = 32
+	afUnix          uint64 = 1
 )
 
 var appArmorHooks = []string{
@@ -52,6 +58,7 @@ var appArmorHooks = []string{
 	"path_unlink",
 	"bprm_check_security",
 	"sys_enter_socket",
+	"sys_enter_socketpair",
 	"cap_capable",
 }
 
@@ -88,6 +95,9 @@ type BpfAppArmorSocketTypes struct {
 	UseRaw bool
 	UseTCP bool
 	UseUDP bool
+	// UnixTypes are the types of the used unix domain sockets, like
+	// "stream".
+	UnixTypes []string
 }
 
 type BpfAppArmorProcessed struct {
@@ -102,6 +112,9 @@ type BpfAppArmorFileProcessed struct {
 	ReadOnlyPaths      []string
 	WriteOnlyPaths     []string
 	ReadWritePaths     []string
+	// WritableLibraries are files which are mapped as executable and
+	// written as well, like caches of JIT compilers.
+	WritableLibraries []string
 }
 
 func newAppArmorRecorder(logger logr.Logger, programName string) *AppArmorRecorder {
@@ -155,7 +168,7 @@ func (b *AppArmorRecorder) StopRecording(r *BpfRecorder) (err error) {
 	return err
 }
 
-func (b *AppArmorRecorder) handleFileEvent(fileEvent *bpfEvent) {
+func (b *AppArmorRecorder) handleFileEvent(fileEvent *bpfEvent) *api.ProgressResponse {
 	b.lockRecordedFiles.Lock()
 	defer b.lockRecordedFiles.Unlock()
 
@@ -166,7 +179,7 @@ func (b *AppArmorRecorder) handleFileEvent(fileEvent *bpfEvent) {
 
 	if shouldExcludeFile(fileName) {
 		log.Printf("Excluded File: %s", fileName)
-		return
+		return nil
 	}
 
 	mid := mntnsID(fileEvent.Mntns)
@@ -179,14 +192,44 @@ func (b *AppArmorRecorder) handleFileEvent(fileEvent *bpfEvent) {
 		path = &fileAccess{}
 		b.recordedFiles[mid][fileName] = path
 	}
+	previous := *path
 
 	path.read = path.read || ((fileEvent.Flags & flagRead) > 0)
 	path.write = path.write || ((fileEvent.Flags & flagWrite) > 0)
 	path.exec = path.exec || ((fileEvent.Flags & flagExec) > 0)
 	path.spawn = path.spawn || ((fileEvent.Flags & flagSpawn) > 0)
+
+	if ok && previous == *path {
+		return nil
+	}
+
+	return &api.ProgressResponse{
+		Type:        api.ProgressResponse_APPARMOR_FILE,
+		Mntns:       fileEvent.Mntns,
+		Pid:         fileEvent.Pid,
+		Value:       fileName,
+		Permissions: path.permissions(),
+	}
+}
+
+func (f *fileAccess) permissions() []string {
+	res := []string{}
+	if f.read {
+		res = append(res, "read")
+	}
+	if f.write {
+		res = append(res, "write")
+	}
+	if f.exec {
+		res = append(res, "exec")
+	}
+	if f.spawn {
+		res = append(res, "spawn")
+	}
+	return res
 }
 
-func (b *AppArmorRecorder) handleSocketEvent(socketEvent *bpfEvent) {
+func (b *AppArmorRecorder) handleSocketEvent(socketEvent *bpfEvent) *api.ProgressResponse {
 	b.lockRecordedSocketsUse.Lock()
 	defer b.lockRecordedSocketsUse.Unlock()
 
@@ -194,18 +237,74 @@ func (b *AppArmorRecorder) handleSocketEvent(socketEvent *bpfEvent) {
 	if _, ok := b.recordedSocketsUse[mid]; !ok {
 		b.recordedSocketsUse[mid] = &BpfAppArmorSocketTypes{}
 	}
+	socketsUse := b.recordedSocketsUse[mid]
+
 	socketType := socketEvent.Flags & sockTypeMask
+	if socketEvent.Flags>>sockDomainShift == afUnix {
+		return b.handleUnixSocketEvent(socketEvent, socketsUse, socketType)
+	}
+
+	var value string
 	switch socketType {
 	case sockRaw:
-		b.recordedSocketsUse[mid].UseRaw = true
+		if socketsUse.UseRaw {
+			return nil
+		}
+		socketsUse.UseRaw = true
+		value = "raw"
 	case sockStream:
-		b.recordedSocketsUse[mid].UseTCP = true
+		if socketsUse.UseTCP {
+			return nil
+		}
+		socketsUse.UseTCP = true
+		value = "tcp"
 	case sockDgram:
-		b.recordedSocketsUse[mid].UseUDP = true
+		if socketsUse.UseUDP {
+			return nil
+		}
+		socketsUse.UseUDP = true
+		value = "udp"
+	default:
+		return nil
+	}
+
+	return &api.ProgressResponse{
+		Type:  api.ProgressResponse_APPARMOR_SOCKET,
+		Mntns: socketEvent.Mntns,
+		Pid:   socketEvent.Pid,
+		Value: value,
 	}
 }
 
-func (b *AppArmorRecorder) handleCapabilityEvent(capEvent *bpfEvent) {
+func (b *AppArmorRecorder) handleUnixSocketEvent(
+	socketEvent *bpfEvent, socketsUse *BpfAppArmorSocketTypes, socketType uint64,
+) *api.ProgressResponse {
+	var unixType string
+	switch socketType {
+	case sockStream:
+		unixType = "stream"
+	case sockDgram:
+		unixType = "dgram"
+	case sockSeqpacket:
+		unixType = "seqpacket"
+	default:
+		return nil
+	}
+	if slices.Contains(socketsUse.UnixTypes, unixType) {
+		return nil
+	}
+	socketsUse.UnixTypes = append(socketsUse.UnixTypes, unixType)
+	slices.Sort(socketsUse.UnixTypes)
+
+	return &api.ProgressResponse{
+		Type:  api.ProgressResponse_APPARMOR_SOCKET,
+		Mntns: socketEvent.Mntns,
+		Pid:   socketEvent.Pid,
+		Value: "unix " + unixType,
+	}
+}
+
+func (b *AppArmorRecorder) handleCapabilityEvent(capEvent *bpfEvent) *api.ProgressResponse {
 	b.lockRecordedCapabilities.Lock()
 	defer b.lockRecordedCapabilities.Unlock()
 
@@ -217,7 +316,7 @@ func (b *AppArmorRecorder) handleCapabilityEvent(capEvent *bpfEvent) {
 	requestedCap := int(capEvent.Flags)
 	for _, recordedCap := range b.recordedCapabilities[mid] {
 		if recordedCap == requestedCap {
-			return
+			return nil
 		}
 	}
 
@@ -228,6 +327,13 @@ func (b *AppArmorRecorder) handleCapabilityEvent(capEvent *bpfEvent) {
 		capEvent.Mntns,
 	)
 	b.recordedCapabilities[mid] = append(b.recordedCapabilities[mid], requestedCap)
+
+	return &api.ProgressResponse{
+		Type:  api.ProgressResponse_APPARMOR_CAPABILITY,
+		Mntns: capEvent.Mntns,
+		Pid:   capEvent.Pid,
+		Value: capabilityToString(requestedCap),
+	}
 }
 
 // Delete all data recorded for a particular mount namespace.
@@ -279,20 +385,30 @@ func (b *AppArmorRecorder) GetKnownMntns() []mntnsID {
 }
 
 func (b *AppArmorRecorder) GetAppArmorProcessed(mntns uint32) BpfAppArmorProcessed {
+	processed := b.PeekAppArmorProcessed(mntns)
+
+	// Clean up the recorded data after processing to avoid keeping global state.
+	mid := mntnsID(mntns)
+	delete(b.recordedSocketsUse, mid)
+	delete(b.recordedFiles, mid)
+	delete(b.recordedCapabilities, mid)
+
+	return processed
+}
+
+// PeekAppArmorProcessed returns the processed recording of the mount
+// namespace while keeping the recorded data.
+func (b *AppArmorRecorder) PeekAppArmorProcessed(mntns uint32) BpfAppArmorProcessed {
 	var processed BpfAppArmorProcessed
 
 	mid := mntnsID(mntns)
 	processed.FileProcessed = b.processExecFsEvents(mid)
 	if sockets, ok := b.recordedSocketsUse[mid]; ok && sockets != nil {
 		processed.Socket = *b.recordedSocketsUse[mid]
+		processed.Socket.UnixTypes = slices.Clone(sockets.UnixTypes)
 	}
 	processed.Capabilities = b.processCapabilities(mid)
 
-	// Clean up the recorded data after processing to avoid keeping global state.
-	delete(b.recordedSocketsUse, mid)
-	delete(b.recordedFiles, mid)
-	delete(b.recordedCapabilities, mid)
-
 	return processed
 }
 
@@ -340,6 +456,8 @@ func (b *AppArmorRecorder) processExecFsEvents(mid mntnsID) BpfAppArmorFileProce
 
 		if access.spawn { //nolint:gocritic // better readability
 			processedEvents.AllowedExecutables = append(processedEvents.AllowedExecutables, fileName)
+		} else if access.exec && access.write {
+			processedEvents.WritableLibraries = append(processedEvents.WritableLibraries, fileName)
 		} else if access.exec {
 			if !knownLibrary {
 				processedEvents.AllowedLibraries = append(processedEvents.AllowedLibraries, fileName)
@@ -373,6 +491,7 @@ func (b *AppArmorRecorder) processExecFsEvents(mid mntnsID) BpfAppArmorFileProce
 	slices.Sort(processedEvents.ReadOnlyPaths)
 	slices.Sort(processedEvents.WriteOnlyPaths)
 	slices.Sort(processedEvents.ReadWritePaths)
+	slices.Sort(processedEvents.WritableLibraries)
 
 	return processedEvents
 }
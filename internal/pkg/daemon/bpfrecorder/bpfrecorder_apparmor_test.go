@@ -22,6 +22,7 @@ package bpfrecorder
 import (
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -139,3 +140,48 @@ func TestShouldExcludeFile(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessExecFsEvents(t *testing.T) {
+	t.Parallel()
+
+	recorder := newAppArmorRecorder(logr.Discard(), "/usr/bin/app")
+	recorder.recordedFiles[1] = map[string]*fileAccess{
+		"/usr/bin/helper":     {read: true, exec: true, spawn: true},
+		"/usr/lib/libfoo.so":  {read: true, exec: true},
+		"/tmp/jit-cache":      {read: true, write: true, exec: true},
+		"/etc/app.conf":       {read: true},
+		"/var/log/app.log":    {write: true},
+		"/var/lib/app/db":     {read: true, write: true},
+		"/tmp/gone (deleted)": {read: true},
+	}
+
+	require.Equal(t, BpfAppArmorFileProcessed{
+		AllowedExecutables: []string{"/usr/bin/helper"},
+		AllowedLibraries:   []string{"/usr/lib/libfoo.so"},
+		ReadOnlyPaths:      []string{"/etc/app.conf"},
+		WriteOnlyPaths:     []string{"/var/log/app.log"},
+		ReadWritePaths:     []string{"/var/lib/app/db"},
+		WritableLibraries:  []string{"/tmp/jit-cache"},
+	}, recorder.processExecFsEvents(1))
+}
+
+func TestHandleSocketEvent(t *testing.T) {
+	t.Parallel()
+
+	const sockCloexec = 0x80000
+	recorder := newAppArmorRecorder(logr.Discard(), "/usr/bin/app")
+	for _, flags := range []uint64{
+		sockStream | 2<<sockDomainShift,
+		sockDgram | sockCloexec | afUnix<<sockDomainShift,
+		sockStream | afUnix<<sockDomainShift,
+		sockStream | afUnix<<sockDomainShift,
+		sockSeqpacket | afUnix<<sockDomainShift,
+	} {
+		recorder.handleSocketEvent(&bpfEvent{Mntns: 1, Flags: flags})
+	}
+
+	require.Equal(t, BpfAppArmorSocketTypes{
+		UseTCP:    true,
+		UnixTypes: []string{"dgram", "seqpacket", "stream"},
+	}, recorder.PeekAppArmorProcessed(1).Socket)
+}
@@ -20,20 +20,31 @@ limitations under the License.
 package bpfrecorder
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 	"unsafe"
 
 	bpf "github.com/aquasecurity/libbpfgo"
 	"github.com/go-logr/logr"
 	seccomp "github.com/seccomp/libseccomp-golang"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 )
 
+// syscallArgsKeySize is the size of the packed syscall_args_t BPF struct:
+// u32 mntns, u32 syscall_id and u64 args[maxSyscallArgs].
+const syscallArgsKeySize = 4 + 4 + 8*maxSyscallArgs
+
 type SeccompRecorder struct {
 	logger               logr.Logger
 	syscalls             *bpf.BPFMap
+	syscallArgs          *bpf.BPFMap
+	argSyscalls          []string
 	syscallIDtoNameCache map[string]string
+	syscallIDtoNameLock  sync.Mutex
 }
 
 func newSeccompRecorder(logger logr.Logger) *SeccompRecorder {
@@ -43,6 +54,17 @@ func newSeccompRecorder(logger logr.Logger) *SeccompRecorder {
 	}
 }
 
+// SetSyscallArgs enables the recording of the argument values for the
+// provided syscalls, which is disabled by default. It has to be called before
+// loading the recorder.
+func (s *SeccompRecorder) SetSyscallArgs(syscalls []string) error {
+	if err := ValidateSyscallArgs(syscalls); err != nil {
+		return err
+	}
+	s.argSyscalls = syscalls
+	return nil
+}
+
 func (s *SeccompRecorder) Load(b *BpfRecorder) error {
 	s.logger.Info("Getting syscalls map")
 	syscalls, err := b.GetMap(b.module, "mntns_syscalls")
@@ -50,6 +72,55 @@ func (s *SeccompRecorder) Load(b *BpfRecorder) error {
 		return fmt.Errorf("get syscalls map: %w", err)
 	}
 	s.syscalls = syscalls
+
+	if len(s.argSyscalls) == 0 {
+		return nil
+	}
+
+	s.logger.Info("Getting syscall args map")
+	syscallArgs, err := b.GetMap(b.module, "mntns_syscall_args")
+	if err != nil {
+		return fmt.Errorf("get syscall args map: %w", err)
+	}
+	s.syscallArgs = syscallArgs
+	return nil
+}
+
+// loadSyscallArgMasks enables the argument recording for the configured
+// syscalls. It requires the BPF object to be already loaded.
+func (s *SeccompRecorder) loadSyscallArgMasks(b *BpfRecorder) error {
+	if len(s.argSyscalls) == 0 {
+		return nil
+	}
+
+	masksMap, err := b.GetMap(b.module, "syscall_arg_masks")
+	if err != nil {
+		return fmt.Errorf("get syscall arg masks map: %w", err)
+	}
+
+	for _, name := range s.argSyscalls {
+		masks, ok := syscallArgMasks[name]
+		if !ok {
+			continue
+		}
+
+		id, err := b.GetSyscallFromName(name)
+		if err != nil {
+			// The syscall may not exist on the current architecture.
+			s.logger.Info("Skipping syscall args recording", "syscall", name, "reason", err.Error())
+			continue
+		}
+
+		value := make([]byte, 0, 8*maxSyscallArgs)
+		for _, mask := range masks {
+			value = binary.LittleEndian.AppendUint64(value, mask)
+		}
+		if err := b.UpdateValue(masksMap, uint32(id), value); err != nil {
+			return fmt.Errorf("update syscall arg masks for %s: %w", name, err)
+		}
+	}
+
+	s.logger.Info("Recording syscall arguments", "syscalls", s.argSyscalls)
 	return nil
 }
 
@@ -66,10 +137,35 @@ func (s *SeccompRecorder) StopRecording(b *BpfRecorder) error {
 			return fmt.Errorf("failed to clean up syscalls map: %w", err)
 		}
 	}
+	if s.syscallArgs == nil {
+		return nil
+	}
+	argsIt := b.BPFMapIterator(s.syscallArgs)
+	for b.BPFMapIteratorNext(argsIt) {
+		key := argsIt.Key()
+		if err := s.syscallArgs.DeleteKey(unsafe.Pointer(&key[0])); err != nil {
+			return fmt.Errorf("failed to clean up syscall args map: %w", err)
+		}
+	}
 	return nil
 }
 
 func (s *SeccompRecorder) PopSyscalls(b *BpfRecorder, mntns uint32) ([]string, error) {
+	syscalls, err := s.Syscalls(b, mntns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.DeleteKey(s.syscalls, mntns); err != nil {
+		s.logger.Error(err, "Unable to cleanup syscalls map", "mntns", mntns)
+	}
+
+	return syscalls, nil
+}
+
+// Syscalls returns the recorded syscalls for the provided mount namespace
+// without removing them from the BPF map.
+func (s *SeccompRecorder) Syscalls(b *BpfRecorder, mntns uint32) ([]string, error) {
 	syscalls, err := b.GetValue(s.syscalls, mntns)
 	if err != nil {
 		s.logger.Error(err, "No syscalls found for mntns", "mntns", mntns)
@@ -77,11 +173,73 @@ func (s *SeccompRecorder) PopSyscalls(b *BpfRecorder, mntns uint32) ([]string, e
 	}
 	syscallNames := s.convertSyscallIDsToNames(b, syscalls)
 
-	if err := b.DeleteKey(s.syscalls, mntns); err != nil {
-		s.logger.Error(err, "Unable to cleanup syscalls map", "mntns", mntns)
+	return sortUnique(syscallNames), nil
+}
+
+// SyscallArgs returns the recorded syscall argument values for the provided
+// mount namespace. A mntns of 0 returns the values of all mount namespaces.
+func (s *SeccompRecorder) SyscallArgs(b *BpfRecorder, mntns uint32) []*api.SyscallArgs {
+	args, _ := s.collectSyscallArgs(b, mntns)
+	return args
+}
+
+// PopSyscallArgs returns the recorded syscall argument values for the
+// provided mount namespace and removes them from the BPF map.
+func (s *SeccompRecorder) PopSyscallArgs(b *BpfRecorder, mntns uint32) []*api.SyscallArgs {
+	args, keys := s.collectSyscallArgs(b, mntns)
+	for _, key := range keys {
+		if err := s.syscallArgs.DeleteKey(unsafe.Pointer(&key[0])); err != nil {
+			s.logger.Error(err, "Unable to cleanup syscall args map", "mntns", mntns)
+		}
 	}
+	return args
+}
 
-	return sortUnique(syscallNames), nil
+func (s *SeccompRecorder) collectSyscallArgs(
+	b *BpfRecorder, mntns uint32,
+) (args []*api.SyscallArgs, keys [][]byte) {
+	if s.syscallArgs == nil {
+		return nil, nil
+	}
+
+	byName := map[string]*api.SyscallArgs{}
+	it := b.BPFMapIterator(s.syscallArgs)
+	for b.BPFMapIteratorNext(it) {
+		key := it.Key()
+		if len(key) < syscallArgsKeySize {
+			continue
+		}
+		if mntns != 0 && binary.LittleEndian.Uint32(key[0:4]) != mntns {
+			continue
+		}
+		keys = append(keys, key)
+
+		id := binary.LittleEndian.Uint32(key[4:8])
+		name, err := s.syscallNameForID(b, int(id))
+		if err != nil {
+			s.logger.Error(err, "unable to convert syscall ID", "id", id)
+			continue
+		}
+
+		values := make([]uint64, maxSyscallArgs)
+		for i := range values {
+			offset := 8 + 8*i
+			values[i] = binary.LittleEndian.Uint64(key[offset : offset+8])
+		}
+
+		entry, ok := byName[name]
+		if !ok {
+			entry = &api.SyscallArgs{Name: name}
+			byName[name] = entry
+			args = append(args, entry)
+		}
+		entry.Observed = append(entry.Observed, &api.SyscallArgs_Values{Values: values})
+	}
+
+	sort.Slice(args, func(i, j int) bool {
+		return args[i].GetName() < args[j].GetName()
+	})
+	return args, keys
 }
 
 func sortUnique(input []string) (result []string) {
@@ -111,7 +269,27 @@ func (s *SeccompRecorder) convertSyscallIDsToNames(b *BpfRecorder, syscalls []by
 	return result
 }
 
+// handleSyscallEvent converts a syscall observed for the first time in a mount
+// namespace into a progress event.
+func (s *SeccompRecorder) handleSyscallEvent(b *BpfRecorder, event *bpfEvent) *api.ProgressResponse {
+	name, err := s.syscallNameForID(b, int(event.Flags))
+	if err != nil {
+		s.logger.Error(err, "unable to convert syscall ID", "id", event.Flags)
+		return nil
+	}
+
+	return &api.ProgressResponse{
+		Type:  api.ProgressResponse_SYSCALL,
+		Mntns: event.Mntns,
+		Pid:   event.Pid,
+		Value: name,
+	}
+}
+
 func (s *SeccompRecorder) syscallNameForID(b *BpfRecorder, id int) (string, error) {
+	s.syscallIDtoNameLock.Lock()
+	defer s.syscallIDtoNameLock.Unlock()
+
 	key := strconv.Itoa(id)
 	item, ok := s.syscallIDtoNameCache[key]
 	if ok {
@@ -31,6 +31,7 @@ import (
 	"testing"
 	"time"
 
+	bpf "github.com/aquasecurity/libbpfgo"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -419,8 +420,9 @@ func TestLoad(t *testing.T) {
 	t.Parallel()
 
 	for _, tc := range []struct {
-		prepare func(*bpfrecorderfakes.FakeImpl)
-		assert  func(*BpfRecorder, error)
+		syscallArgs []string
+		prepare     func(*bpfrecorderfakes.FakeImpl)
+		assert      func(*BpfRecorder, error)
 	}{
 		{ // Success
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
@@ -430,6 +432,38 @@ func TestLoad(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{ // Success without syscall args maps if not enabled
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapCalls(func(_ *bpf.Module, name string) (*bpf.BPFMap, error) {
+					if name == "mntns_syscall_args" || name == "syscall_arg_masks" {
+						return nil, errTest
+					}
+					return nil, nil
+				})
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.NoError(t, err)
+				mock, ok := sut.impl.(*bpfrecorderfakes.FakeImpl)
+				require.True(t, ok)
+				require.Zero(t, mock.GetSyscallFromNameCallCount())
+			},
+		},
+		{ // load failed on missing syscall args map if enabled
+			syscallArgs: []string{"prctl"},
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapCalls(func(_ *bpf.Module, name string) (*bpf.BPFMap, error) {
+					if name == "mntns_syscall_args" {
+						return nil, errTest
+					}
+					return nil, nil
+				})
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.ErrorContains(t, err, "get syscall args map")
+			},
+		},
 		{ // load failed wrong GOARCH
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns("invalid")
@@ -438,12 +472,50 @@ func TestLoad(t *testing.T) {
 				require.Error(t, err)
 			},
 		},
+		{ // Success with syscall args masks
+			syscallArgs: SupportedSyscallArgs(),
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.GetSyscallFromNameReturnsOnCall(0, 56, nil)
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.NoError(t, err)
+				mock, ok := sut.impl.(*bpfrecorderfakes.FakeImpl)
+				require.True(t, ok)
+				require.Equal(t, len(SupportedSyscallArgs()), mock.GetSyscallFromNameCallCount())
+				require.Equal(t, len(SupportedSyscallArgs()), mock.UpdateValueCallCount())
+				_, id, value := mock.UpdateValueArgsForCall(0)
+				require.Equal(t, uint32(56), id)
+				require.Len(t, value, 8*maxSyscallArgs)
+			},
+		},
+		{ // Success with unknown syscall for args masks
+			syscallArgs: []string{"prctl"},
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.GetSyscallFromNameReturns(0, errTest)
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{ // load failed on UpdateValue for syscall args masks
+			syscallArgs: []string{"prctl"},
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				mock.UpdateValueReturns(errTest)
+			},
+			assert: func(sut *BpfRecorder, err error) {
+				require.Error(t, err)
+			},
+		},
 	} {
 		mock := &bpfrecorderfakes.FakeImpl{}
 		tc.prepare(mock)
 
 		sut := New("", logr.Discard(), true, true)
 		sut.impl = mock
+		require.NoError(t, sut.SetSyscallArgs(tc.syscallArgs))
 
 		err := sut.Load()
 		tc.assert(sut, err)
@@ -1003,3 +1075,138 @@ func TestNewPidEvent(t *testing.T) {
 		tc.assert(sut, logSink)
 	}
 }
+
+type progressStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *api.ProgressResponse
+	err    error
+}
+
+func (p *progressStream) Context() context.Context {
+	return p.ctx
+}
+
+func (p *progressStream) Send(event *api.ProgressResponse) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events <- event
+	return nil
+}
+
+func TestWatchProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		request *api.ProfileRequest
+		prepare func(*BpfRecorder, *bpfrecorderfakes.FakeImpl, *progressStream) bpfEvent
+		assert  func(*progressStream, error)
+	}{
+		{ // Success syscall
+			request: &api.ProfileRequest{Name: profile},
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, _ *progressStream) bpfEvent {
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				mock.GetNameReturns("openat", nil)
+				return bpfEvent{Pid: 42, Mntns: mntns, Type: uint8(eventTypeSyscall), Flags: 257}
+			},
+			assert: func(stream *progressStream, err error) {
+				require.NoError(t, err)
+				require.Len(t, stream.events, 1)
+				event := <-stream.events
+				require.Equal(t, api.ProgressResponse_SYSCALL, event.GetType())
+				require.Equal(t, profile, event.GetProfile())
+				require.Equal(t, mntns, event.GetMntns())
+				require.Equal(t, uint32(42), event.GetPid())
+				require.Equal(t, "openat", event.GetValue())
+			},
+		},
+		{ // Success capability for all profiles
+			request: &api.ProfileRequest{},
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, _ *progressStream) bpfEvent {
+				return bpfEvent{Pid: 42, Mntns: mntns, Type: uint8(eventTypeAppArmorCap), Flags: 21}
+			},
+			assert: func(stream *progressStream, err error) {
+				require.NoError(t, err)
+				require.Len(t, stream.events, 1)
+				event := <-stream.events
+				require.Equal(t, api.ProgressResponse_APPARMOR_CAPABILITY, event.GetType())
+				require.Empty(t, event.GetProfile())
+				require.Equal(t, "sys_admin", event.GetValue())
+			},
+		},
+		{ // Success other profile is filtered
+			request: &api.ProfileRequest{Name: "other"},
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, _ *progressStream) bpfEvent {
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				return bpfEvent{Pid: 42, Mntns: mntns, Type: uint8(eventTypeAppArmorSocket), Flags: 1}
+			},
+			assert: func(stream *progressStream, err error) {
+				require.NoError(t, err)
+				require.Empty(t, stream.events)
+			},
+		},
+		{ // Failure on Send
+			request: &api.ProfileRequest{Name: profile},
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl, stream *progressStream) bpfEvent {
+				stream.err = errTest
+				sut.containerIDToProfileMap.Insert(containerID, profile)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				mock.GetNameReturns("openat", nil)
+				return bpfEvent{Pid: 42, Mntns: mntns, Type: uint8(eventTypeSyscall), Flags: 257}
+			},
+			assert: func(stream *progressStream, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		sut := New("", logr.Discard(), true, true)
+		mock := &bpfrecorderfakes.FakeImpl{}
+		sut.impl = mock
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &progressStream{ctx: ctx, events: make(chan *api.ProgressResponse, 1)}
+		event := tc.prepare(sut, mock, stream)
+
+		errCh := make(chan error)
+		go func() {
+			errCh <- sut.WatchProfile(tc.request, stream)
+		}()
+		require.Eventually(t, sut.progress.hasSubscribers, time.Second, 10*time.Millisecond)
+
+		var buf bytes.Buffer
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, &event))
+		sut.handleEvent(buf.Bytes())
+
+		if stream.err != nil {
+			// The stream terminates on its own
+			err := <-errCh
+			cancel()
+			tc.assert(stream, err)
+			continue
+		}
+
+		// Give the stream the chance to forward the event
+		require.Eventually(t, func() bool {
+			return pendingProgress(sut.progress) == 0
+		}, time.Second, 10*time.Millisecond)
+		cancel()
+
+		tc.assert(stream, <-errCh)
+	}
+}
+
+// pendingProgress returns the amount of events which have not been received
+// by the subscribers yet.
+func pendingProgress(p *progressBroadcaster) int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	pending := 0
+	for events := range p.subscribers {
+		pending += len(events)
+	}
+	return pending
+}
@@ -39,6 +39,12 @@ func New(programName string, logger logr.Logger, recordSeccomp, recordAppArmor b
 	return &BpfRecorder{}
 }
 
+// SetSyscallArgs enables the recording of the argument values for the
+// provided syscalls.
+func (b *BpfRecorder) SetSyscallArgs([]string) error {
+	return errUnsupported
+}
+
 // Run the BpfRecorder.
 func (b *BpfRecorder) Run() error {
 	return errUnsupported
@@ -68,3 +74,10 @@ func (b *BpfRecorder) SyscallsForProfile(
 ) (*api.SyscallsResponse, error) {
 	return nil, errUnsupported
 }
+
+// WatchProfile streams the recording progress for the provided profile name.
+func (b *BpfRecorder) WatchProfile(
+	*api.ProfileRequest, grpc.ServerStreamingServer[api.ProgressResponse],
+) error {
+	return errUnsupported
+}
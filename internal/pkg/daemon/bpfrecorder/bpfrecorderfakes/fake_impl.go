@@ -230,6 +230,19 @@ type FakeImpl struct {
 		result1 *libbpfgo.BPFProg
 		result2 error
 	}
+	GetSyscallFromNameStub        func(string) (seccomp.ScmpSyscall, error)
+	getSyscallFromNameMutex       sync.RWMutex
+	getSyscallFromNameArgsForCall []struct {
+		arg1 string
+	}
+	getSyscallFromNameReturns struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
+	getSyscallFromNameReturnsOnCall map[int]struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}
 	GetValueStub        func(*libbpfgo.BPFMap, uint32) ([]byte, error)
 	getValueMutex       sync.RWMutex
 	getValueArgsForCall []struct {
@@ -1517,6 +1530,70 @@ func (fake *FakeImpl) GetProgramReturnsOnCall(i int, result1 *libbpfgo.BPFProg,
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetSyscallFromName(arg1 string) (seccomp.ScmpSyscall, error) {
+	fake.getSyscallFromNameMutex.Lock()
+	ret, specificReturn := fake.getSyscallFromNameReturnsOnCall[len(fake.getSyscallFromNameArgsForCall)]
+	fake.getSyscallFromNameArgsForCall = append(fake.getSyscallFromNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetSyscallFromNameStub
+	fakeReturns := fake.getSyscallFromNameReturns
+	fake.recordInvocation("GetSyscallFromName", []interface{}{arg1})
+	fake.getSyscallFromNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCallCount() int {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	return len(fake.getSyscallFromNameArgsForCall)
+}
+
+func (fake *FakeImpl) GetSyscallFromNameCalls(stub func(string) (seccomp.ScmpSyscall, error)) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = stub
+}
+
+func (fake *FakeImpl) GetSyscallFromNameArgsForCall(i int) string {
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
+	argsForCall := fake.getSyscallFromNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturns(result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	fake.getSyscallFromNameReturns = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSyscallFromNameReturnsOnCall(i int, result1 seccomp.ScmpSyscall, result2 error) {
+	fake.getSyscallFromNameMutex.Lock()
+	defer fake.getSyscallFromNameMutex.Unlock()
+	fake.GetSyscallFromNameStub = nil
+	if fake.getSyscallFromNameReturnsOnCall == nil {
+		fake.getSyscallFromNameReturnsOnCall = make(map[int]struct {
+			result1 seccomp.ScmpSyscall
+			result2 error
+		})
+	}
+	fake.getSyscallFromNameReturnsOnCall[i] = struct {
+		result1 seccomp.ScmpSyscall
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetValue(arg1 *libbpfgo.BPFMap, arg2 uint32) ([]byte, error) {
 	fake.getValueMutex.Lock()
 	ret, specificReturn := fake.getValueReturnsOnCall[len(fake.getValueArgsForCall)]
@@ -3105,6 +3182,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.getNameMutex.RUnlock()
 	fake.getProgramMutex.RLock()
 	defer fake.getProgramMutex.RUnlock()
+	fake.getSyscallFromNameMutex.RLock()
+	defer fake.getSyscallFromNameMutex.RUnlock()
 	fake.getValueMutex.RLock()
 	defer fake.getValueMutex.RUnlock()
 	fake.getValue64Mutex.RLock()
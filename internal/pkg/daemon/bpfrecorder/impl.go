@@ -79,6 +79,7 @@ type impl interface {
 	DeleteKey64(*bpf.BPFMap, uint64) error
 	ListPods(context.Context, *kubernetes.Clientset, string) (*v1.PodList, error)
 	GetName(seccomp.ScmpSyscall) (string, error)
+	GetSyscallFromName(string) (seccomp.ScmpSyscall, error)
 	RemoveAll(string) error
 	Chown(string, int, int) error
 	CloseModule(*bpf.Module)
@@ -233,6 +234,10 @@ func (d *defaultImpl) GetName(s seccomp.ScmpSyscall) (string, error) {
 	return s.GetName()
 }
 
+func (d *defaultImpl) GetSyscallFromName(name string) (seccomp.ScmpSyscall, error) {
+	return seccomp.GetSyscallFromName(name)
+}
+
 func (d *defaultImpl) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
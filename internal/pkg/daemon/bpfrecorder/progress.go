@@ -0,0 +1,143 @@
+//go:build linux && !no_bpf
+// +build linux,!no_bpf
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+)
+
+// progressBufferSize is the amount of progress events buffered per
+// subscriber. Events get dropped for slow subscribers if the buffer is full.
+const progressBufferSize = 1024
+
+// progressBroadcaster distributes the recording progress to all subscribers.
+type progressBroadcaster struct {
+	lock        sync.RWMutex
+	subscribers map[chan *api.ProgressResponse]string
+}
+
+func newProgressBroadcaster() *progressBroadcaster {
+	return &progressBroadcaster{
+		subscribers: map[chan *api.ProgressResponse]string{},
+	}
+}
+
+// subscribe registers a new subscriber for the provided profile. An empty
+// profile subscribes to the progress of all profiles.
+func (p *progressBroadcaster) subscribe(profile string) chan *api.ProgressResponse {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	ch := make(chan *api.ProgressResponse, progressBufferSize)
+	p.subscribers[ch] = profile
+	return ch
+}
+
+func (p *progressBroadcaster) unsubscribe(ch chan *api.ProgressResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.subscribers, ch)
+}
+
+func (p *progressBroadcaster) hasSubscribers() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return len(p.subscribers) > 0
+}
+
+// publish sends the event to all matching subscribers and returns the
+// number of subscribers which dropped the event.
+func (p *progressBroadcaster) publish(event *api.ProgressResponse) (dropped int) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for ch, profile := range p.subscribers {
+		if profile != "" && profile != event.GetProfile() {
+			continue
+		}
+
+		select {
+		case ch <- event:
+		default:
+			dropped++
+		}
+	}
+
+	return dropped
+}
+
+// WatchProfile streams the recording progress for the provided profile
+// name. Every newly observed syscall and AppArmor file, socket or capability
+// event results in a single message. An empty profile name streams the
+// progress of all recordings.
+//
+// The stream is best effort: Events observed before the profile has been
+// assigned to the mount namespace will be reported with an empty profile,
+// while events for slow clients may get dropped. The final result should
+// still be retrieved by using SyscallsForProfile or ApparmorForProfile.
+func (b *BpfRecorder) WatchProfile(
+	r *api.ProfileRequest, stream grpc.ServerStreamingServer[api.ProgressResponse],
+) error {
+	b.logger.Info("Watching recording progress", "profile", r.GetName())
+	defer b.logger.Info("Stopped watching recording progress", "profile", r.GetName())
+
+	events := b.progress.subscribe(r.GetName())
+	defer b.progress.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return fmt.Errorf("send progress: %w", err)
+			}
+		}
+	}
+}
+
+// publishProgress assigns the profile to the event and sends it to all
+// progress subscribers.
+func (b *BpfRecorder) publishProgress(event *api.ProgressResponse) {
+	if event == nil || !b.progress.hasSubscribers() {
+		return
+	}
+
+	if containerID, ok := b.mntnsToContainerIDMap.Get(event.GetMntns()); ok {
+		if profile, ok := b.containerIDToProfileMap.Get(containerID); ok {
+			event.Profile = profile
+		}
+	}
+
+	if dropped := b.progress.publish(event); dropped > 0 {
+		b.logger.V(1).Info(
+			"Dropped progress event for slow subscribers",
+			"subscribers", dropped, "profile", event.GetProfile(),
+		)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+const (
+	// maxSyscallArgs is the amount of syscall arguments seccomp is able to
+	// filter on.
+	maxSyscallArgs = 6
+
+	// fullArgMask is used for arguments where the whole value is recorded,
+	// which results in SCMP_CMP_EQ rules.
+	fullArgMask uint64 = ^uint64(0)
+
+	// socketTypeMask strips SOCK_NONBLOCK and SOCK_CLOEXEC from the socket type.
+	socketTypeMask uint64 = 0xf
+
+	// cloneNamespaceMask matches all namespace flags of clone, which is
+	// similar to what the default container runtime profiles are doing.
+	cloneNamespaceMask uint64 = 0x7e020000
+)
+
+// syscallArgMasks contains all syscalls for which the argument values can be
+// recorded. Every entry defines the mask per argument, where a zero mask means
+// that the argument will not be recorded.
+var syscallArgMasks = map[string][maxSyscallArgs]uint64{
+	// flags
+	"clone": {cloneNamespaceMask},
+	// fd, request
+	"ioctl": {0, fullArgMask},
+	// persona
+	"personality": {fullArgMask},
+	// option
+	"prctl": {fullArgMask},
+	// domain, type
+	"socket": {fullArgMask, socketTypeMask},
+}
+
+// SupportedSyscallArgs returns the sorted names of all syscalls for which
+// the argument values can be recorded.
+func SupportedSyscallArgs() []string {
+	res := make([]string, 0, len(syscallArgMasks))
+	for name := range syscallArgMasks {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ValidateSyscallArgs verifies that argument recording is supported for all
+// provided syscalls.
+func ValidateSyscallArgs(syscalls []string) error {
+	for _, name := range syscalls {
+		if _, ok := syscallArgMasks[name]; !ok {
+			return fmt.Errorf(
+				"recording arguments of syscall %q is not supported, supported are: %s",
+				name, strings.Join(SupportedSyscallArgs(), ", "),
+			)
+		}
+	}
+	return nil
+}
+
+// SyscallRules builds the seccomp rules for the recorded syscall names. The
+// syscalls selected for argument filtering are only allowed for the recorded
+// argument values, while all other syscalls are allowed unconditionally.
+func SyscallRules(
+	names []string, args []*api.SyscallArgs, selected []string,
+) []*seccompprofileapi.Syscall {
+	selectedSet := map[string]bool{}
+	for _, name := range selected {
+		if _, ok := syscallArgMasks[name]; ok {
+			selectedSet[name] = true
+		}
+	}
+
+	argRules := []*seccompprofileapi.Syscall{}
+	filtered := map[string]bool{}
+	for _, arg := range args {
+		if !selectedSet[arg.GetName()] || len(arg.GetObserved()) == 0 {
+			continue
+		}
+		filtered[arg.GetName()] = true
+		argRules = append(argRules, argRulesForSyscall(arg)...)
+	}
+
+	allowed := make([]string, 0, len(names))
+	for _, name := range names {
+		if !filtered[name] {
+			allowed = append(allowed, name)
+		}
+	}
+
+	sort.SliceStable(argRules, func(i, j int) bool {
+		return argRules[i].Names[0] < argRules[j].Names[0]
+	})
+
+	if len(allowed) == 0 {
+		return argRules
+	}
+
+	return append([]*seccompprofileapi.Syscall{{
+		Action: seccomp.ActAllow,
+		Names:  allowed,
+	}}, argRules...)
+}
+
+func argRulesForSyscall(arg *api.SyscallArgs) []*seccompprofileapi.Syscall {
+	masks := syscallArgMasks[arg.GetName()]
+	seen := map[string]bool{}
+	res := []*seccompprofileapi.Syscall{}
+
+	for _, observed := range arg.GetObserved() {
+		// Apply the masks to support unmasked values, for example from the
+		// audit log. Missing arguments are assumed to be zero.
+		values := make([]uint64, maxSyscallArgs)
+		for i, value := range observed.GetValues() {
+			if i >= maxSyscallArgs {
+				break
+			}
+			values[i] = value & masks[i]
+		}
+
+		key := fmt.Sprint(values)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		rule := &seccompprofileapi.Syscall{
+			Action: seccomp.ActAllow,
+			Names:  []string{arg.GetName()},
+		}
+		for i, mask := range masks {
+			switch mask {
+			case 0:
+				continue
+			case fullArgMask:
+				rule.Args = append(rule.Args, &seccompprofileapi.Arg{
+					Index: uint(i),
+					Value: values[i],
+					Op:    seccomp.OpEqualTo,
+				})
+			default:
+				rule.Args = append(rule.Args, &seccompprofileapi.Arg{
+					Index:    uint(i),
+					Value:    mask,
+					ValueTwo: values[i],
+					Op:       seccomp.OpMaskedEqual,
+				})
+			}
+		}
+		res = append(res, rule)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		for k := range res[i].Args {
+			a, b := res[i].Args[k], res[j].Args[k]
+			if a.Value != b.Value {
+				return a.Value < b.Value
+			}
+			if a.ValueTwo != b.ValueTwo {
+				return a.ValueTwo < b.ValueTwo
+			}
+		}
+		return false
+	})
+
+	return res
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bpfrecorder
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/stretchr/testify/require"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestValidateSyscallArgs(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateSyscallArgs(nil))
+	require.NoError(t, ValidateSyscallArgs([]string{"prctl", "socket"}))
+	require.Error(t, ValidateSyscallArgs([]string{"prctl", "read"}))
+}
+
+func TestSyscallRules(t *testing.T) {
+	t.Parallel()
+
+	values := func(v ...uint64) *api.SyscallArgs_Values {
+		res := make([]uint64, maxSyscallArgs)
+		copy(res, v)
+		return &api.SyscallArgs_Values{Values: res}
+	}
+
+	args := []*api.SyscallArgs{
+		{Name: "socket", Observed: []*api.SyscallArgs_Values{
+			values(10, 2), values(2, 1), values(2, 1),
+		}},
+		{Name: "prctl", Observed: []*api.SyscallArgs_Values{values(38)}},
+		{Name: "clone", Observed: []*api.SyscallArgs_Values{
+			values(0), {Values: []uint64{0x11}},
+		}},
+	}
+	names := []string{"clone", "prctl", "read", "socket"}
+
+	for _, tc := range []struct {
+		name     string
+		names    []string
+		selected []string
+		expected []*seccompprofileapi.Syscall
+	}{
+		{
+			name:  "nothing selected",
+			names: names,
+			expected: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: names},
+			},
+		},
+		{
+			name:     "selected syscalls",
+			names:    names,
+			selected: []string{"socket", "prctl", "clone", "ioctl"},
+			expected: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read"}},
+				{Action: seccomp.ActAllow, Names: []string{"clone"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: cloneNamespaceMask, ValueTwo: 0, Op: seccomp.OpMaskedEqual},
+				}},
+				{Action: seccomp.ActAllow, Names: []string{"prctl"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: 38, Op: seccomp.OpEqualTo},
+				}},
+				{Action: seccomp.ActAllow, Names: []string{"socket"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: 2, Op: seccomp.OpEqualTo},
+					{Index: 1, Value: socketTypeMask, ValueTwo: 1, Op: seccomp.OpMaskedEqual},
+				}},
+				{Action: seccomp.ActAllow, Names: []string{"socket"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: 10, Op: seccomp.OpEqualTo},
+					{Index: 1, Value: socketTypeMask, ValueTwo: 2, Op: seccomp.OpMaskedEqual},
+				}},
+			},
+		},
+		{
+			name:     "all syscalls filtered",
+			names:    []string{"prctl"},
+			selected: []string{"prctl"},
+			expected: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"prctl"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: 38, Op: seccomp.OpEqualTo},
+				}},
+			},
+		},
+	} {
+		rules := SyscallRules(tc.names, args, tc.selected)
+		require.Equal(t, tc.expected, rules, tc.name)
+	}
+}
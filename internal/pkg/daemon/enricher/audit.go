@@ -22,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/containers/common/pkg/seccomp"
+
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
@@ -31,7 +33,10 @@ var (
 		`(type=SECCOMP|audit:.+type=1326).+audit\((.+)\).+pid=(\b\d+\b).+exe="(.+)".+syscall=(\b\d+\b).*`,
 	)
 	selinuxLineRegex = regexp.MustCompile(
-		`type=AVC.+audit\((.+)\).+{ (.+) }.+pid=(\b\d+\b).*scontext=(.+) tcontext=(.+) tclass=(\b\w+\b).*`,
+		`(?:type=AVC|audit:.+type=1400).+audit\((.+)\).+{ (.+) }.+pid=(\b\d+\b).*scontext=(.+) tcontext=(.+) tclass=(\b\w+\b).*`,
+	)
+	syscallLineRegex = regexp.MustCompile(
+		`(?:type=SYSCALL|audit:.+type=1300).+audit\(([^)]+)\).+syscall=(\b\d+\b).*`,
 	)
 	apparmorLineRegex = regexp.MustCompile(
 		//nolint:lll // no need to wrap regex
@@ -39,10 +44,45 @@ var (
 	)
 )
 
+// auditFieldRegex matches key=value pairs of audit records, where the value
+// can be quoted.
+var (
+	auditFieldRegex = regexp.MustCompile(`\b([a-z0-9_]+)=("[^"]*"|[^\s\x1d]+)`)
+	auditCodeRegex  = regexp.MustCompile(`^0x([0-9a-f]{1,8})`)
+)
+
+// Seccomp action return values, see
+// https://elixir.bootlin.com/linux/latest/source/include/uapi/linux/seccomp.h
+const (
+	seccompRetKillProcess uint32 = 0x80000000
+	seccompRetKillThread  uint32 = 0x00000000
+	seccompRetTrap        uint32 = 0x00030000
+	seccompRetErrno       uint32 = 0x00050000
+	seccompRetUserNotif   uint32 = 0x7fc00000
+	seccompRetTrace       uint32 = 0x7ff00000
+	seccompRetLog         uint32 = 0x7ffc0000
+	seccompRetAllow       uint32 = 0x7fff0000
+	seccompRetActionFull  uint32 = 0xffff0000
+
+	maxAuditSyscallArgs = 4
+)
+
+var seccompActions = map[uint32]seccomp.Action{
+	seccompRetKillProcess: seccomp.ActKillProcess,
+	seccompRetKillThread:  seccomp.ActKillThread,
+	seccompRetTrap:        seccomp.ActTrap,
+	seccompRetErrno:       seccomp.ActErrno,
+	seccompRetUserNotif:   seccomp.ActNotify,
+	seccompRetTrace:       seccomp.ActTrace,
+	seccompRetLog:         seccomp.ActLog,
+	seccompRetAllow:       seccomp.ActAllow,
+}
+
 var (
 	minSeccompCapturesExpected  = 5
 	minSelinuxCapturesExpected  = 7
 	minAppArmorCapturesExpected = 9
+	minSyscallCapturesExpected  = 3
 )
 
 // IsAuditLine checks whether logLine is a supported audit line.
@@ -58,7 +98,12 @@ func IsAuditLine(logLine string) bool {
 	}
 
 	captures = apparmorLineRegex.FindStringSubmatch(logLine)
-	return len(captures) >= minAppArmorCapturesExpected
+	if len(captures) >= minAppArmorCapturesExpected {
+		return true
+	}
+
+	captures = syscallLineRegex.FindStringSubmatch(logLine)
+	return len(captures) >= minSyscallCapturesExpected
 }
 
 // ExtractAuditLine extracts an auditline from logLine.
@@ -75,6 +120,10 @@ func ExtractAuditLine(logLine string) (*types.AuditLine, error) {
 		return apparmor, nil
 	}
 
+	if syscall := extractSyscallLine(logLine); syscall != nil {
+		return syscall, nil
+	}
+
 	return nil, fmt.Errorf("unsupported log line: %s", logLine)
 }
 
@@ -100,9 +149,98 @@ func extractSeccompLine(logLine string) *types.AuditLine {
 		line.SystemCallID = int32(v)
 	}
 
+	extractSeccompFields(&line, logLine)
+
+	return &line
+}
+
+// extractSeccompFields parses the optional fields of a seccomp audit record.
+// The record does not contain the syscall arguments, which are only part of
+// the SYSCALL record of the same audit event.
+func extractSeccompFields(line *types.AuditLine, logLine string) {
+	const (
+		base    = 10
+		hexBase = 16
+		bitSize = 32
+	)
+
+	fields := auditFields(logLine)
+
+	line.Arch = fields["arch"]
+
+	if v, err := strconv.Atoi(fields["sig"]); err == nil {
+		line.Signal = v
+	}
+
+	if v, err := strconv.ParseUint(fields["uid"], base, bitSize); err == nil {
+		line.UID = uint32(v)
+	}
+
+	if v, err := strconv.ParseUint(fields["auid"], base, bitSize); err == nil {
+		line.AUID = uint32(v)
+	}
+
+	if code := auditCodeRegex.FindStringSubmatch(fields["code"]); len(code) > 1 {
+		if v, err := strconv.ParseUint(code[1], hexBase, bitSize); err == nil {
+			line.Code = uint32(v)
+			line.Action = string(seccompActions[line.Code&seccompRetActionFull])
+		}
+	}
+}
+
+// extractSyscallLine parses a SYSCALL audit record, which the kernel emits
+// on exit of audited syscalls. The record is part of the same audit event as
+// the seccomp record of the syscall and contains its first four arguments.
+func extractSyscallLine(logLine string) *types.AuditLine {
+	captures := syscallLineRegex.FindStringSubmatch(logLine)
+	if len(captures) < minSyscallCapturesExpected {
+		return nil
+	}
+
+	const (
+		base    = 10
+		hexBase = 16
+		bitSize = 32
+	)
+
+	line := types.AuditLine{}
+	line.AuditType = types.AuditTypeSyscall
+	line.TimestampID = captures[1]
+	if v, err := strconv.ParseInt(captures[2], base, bitSize); err == nil {
+		line.SystemCallID = int32(v)
+	}
+
+	fields := auditFields(logLine)
+	line.Arch = fields["arch"]
+	if v, err := strconv.Atoi(fields["pid"]); err == nil {
+		line.ProcessID = v
+	}
+
+	for i := range maxAuditSyscallArgs {
+		arg, ok := fields[fmt.Sprintf("a%d", i)]
+		if !ok {
+			break
+		}
+		v, err := strconv.ParseUint(arg, hexBase, 64)
+		if err != nil {
+			break
+		}
+		line.SystemCallArgs = append(line.SystemCallArgs, v)
+	}
+
 	return &line
 }
 
+// auditFields returns the key=value pairs of an audit record, with the
+// quotes of the values removed.
+func auditFields(logLine string) map[string]string {
+	fields := map[string]string{}
+	for _, match := range auditFieldRegex.FindAllStringSubmatch(logLine, -1) {
+		fields[match[1]] = strings.Trim(match[2], `"`)
+	}
+	return fields
+}
+
 func extractSelinuxLine(logLine string) *types.AuditLine {
 	captures := selinuxLineRegex.FindStringSubmatch(logLine)
 	if len(captures) < minSelinuxCapturesExpected {
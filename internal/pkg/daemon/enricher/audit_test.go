@@ -50,6 +50,12 @@ func Test_isAuditLine(t *testing.T) {
 			`type=SECCOMP msg=audit(1613596317.899:6461): auid=4294967295 uid=0 gid=0 ses=4294967295 subj=system_u:system_r:spc_t:s0:c284,c594 pid=2039886 comm="ls" exe="/bin/ls" sig=0 arch=c000003e syscall=3 compat=0 ip=0x7f62dce3d4c7 code=0x7ffc0000AUID="unset" UID="root" GID="root" ARCH=x86_64 SYSCALL=close`,
 			true,
 		},
+		{
+			"Should identify type=1300 log lines",
+			//nolint:lll // no need to wrap
+			`audit: type=1300 audit(1624537480.362:8478): arch=c000003e syscall=157 success=yes exit=0 a0=26 a1=1 a2=0 a3=0 items=0 ppid=2060380 pid=2060394 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=pts0 ses=1 comm="sleep" exe="/bin/busybox" key=(null)`,
+			true,
+		},
 		{
 			"Should ignore unsupported log types",
 			//nolint:lll // no need to wrap
@@ -113,6 +119,10 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 0,
 				ProcessID:    3109464,
 				Executable:   "/bin/busybox",
+				Arch:         "c000003e",
+				Code:         0x7ffc0000,
+				Action:       "SCMP_ACT_LOG",
+				AUID:         4294967295,
 			},
 			nil,
 		},
@@ -126,6 +136,57 @@ func Test_extractAuditLine(t *testing.T) {
 				SystemCallID: 3,
 				ProcessID:    2039886,
 				Executable:   "/bin/ls",
+				Arch:         "c000003e",
+				Code:         0x7ffc0000,
+				Action:       "SCMP_ACT_LOG",
+				AUID:         4294967295,
+			},
+			nil,
+		},
+		{
+			"Should extract killing seccomp log lines",
+			//nolint:lll // no need to wrap
+			`type=SECCOMP msg=audit(1613596317.899:6462): auid=1000 uid=1000 gid=1000 ses=3 pid=2039887 comm="ls" exe="/bin/ls" sig=31 arch=c000003e syscall=157 compat=0 ip=0x7f62dce3d4c7 code=0x80000000`,
+			&types.AuditLine{
+				AuditType:    "seccomp",
+				TimestampID:  "1613596317.899:6462",
+				SystemCallID: 157,
+				ProcessID:    2039887,
+				Executable:   "/bin/ls",
+				Arch:         "c000003e",
+				Signal:       31,
+				Code:         0x80000000,
+				Action:       "SCMP_ACT_KILL_PROCESS",
+				UID:          1000,
+				AUID:         1000,
+			},
+			nil,
+		},
+		{
+			"Should extract syscall log lines",
+			//nolint:lll // no need to wrap
+			`type=SYSCALL msg=audit(1613596317.899:6463): arch=c000003e syscall=157 success=yes exit=0 a0=26 a1=7ffd4a1c a2=0 a3=0 items=0 ppid=2039880 pid=2039887 auid=1000 uid=1000 gid=1000 euid=1000 suid=1000 fsuid=1000 egid=1000 sgid=1000 fsgid=1000 tty=pts0 ses=3 comm="ls" exe="/bin/ls" key=(null)`,
+			&types.AuditLine{
+				AuditType:      "syscall",
+				TimestampID:    "1613596317.899:6463",
+				SystemCallID:   157,
+				ProcessID:      2039887,
+				SystemCallArgs: []uint64{0x26, 0x7ffd4a1c, 0, 0},
+				Arch:           "c000003e",
+			},
+			nil,
+		},
+		{
+			"Should extract syscall kernel log lines",
+			//nolint:lll // no need to wrap
+			`audit: type=1300 audit(1624537480.362:8478): arch=c000003e syscall=16 success=no exit=-25 a0=1 a1=5401 a2=7ffc a3=0 items=0 ppid=2060380 pid=2060394 auid=1000 uid=0 gid=0 euid=0 suid=0 fsuid=0 egid=0 sgid=0 fsgid=0 tty=(none) ses=1 comm="sleep" exe="/bin/busybox" key=(null)`,
+			&types.AuditLine{
+				AuditType:      "syscall",
+				TimestampID:    "1624537480.362:8478",
+				SystemCallID:   16,
+				ProcessID:      2060394,
+				SystemCallArgs: []uint64{1, 0x5401, 0x7ffc, 0},
+				Arch:           "c000003e",
 			},
 			nil,
 		},
@@ -146,6 +207,23 @@ func Test_extractAuditLine(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"Should extract selinux kernel log lines",
+			//nolint:lll // no need to wrap
+			`audit: type=1400 audit(1613173578.156:2945): avc:  denied  { read } for  pid=75593 comm="security-profil" name="token" dev="tmpfs" ino=612459 scontext=system_u:system_r:container_t:s0:c4,c808 tcontext=system_u:object_r:var_lib_t:s0 tclass=lnk_file permissive=0`,
+			&types.AuditLine{
+				AuditType:    "selinux",
+				TimestampID:  "1613173578.156:2945",
+				SystemCallID: 0,
+				ProcessID:    75593,
+				Executable:   "",
+				Perm:         "read",
+				Scontext:     "system_u:system_r:container_t:s0:c4,c808",
+				Tcontext:     "system_u:object_r:var_lib_t:s0",
+				Tclass:       "lnk_file",
+			},
+			nil,
+		},
 		{
 			"Should extract selinux log lines with multiple permissions",
 			//nolint:lll // no need to wrap
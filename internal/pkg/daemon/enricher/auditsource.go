@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/nxadm/tail"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+// auditSource provides the raw audit lines to the enricher.
+//
+//counterfeiter:generate . auditSource
+type auditSource interface {
+	// Name returns a human readable description of the source.
+	Name() string
+
+	// Lines starts reading from the source and returns the channel of audit
+	// lines. The channel gets closed as soon as the source stops.
+	Lines() (chan *tail.Line, error)
+
+	// Reason returns the error which caused the source to stop.
+	Reason() error
+}
+
+// newAuditSource creates the audit source for the provided type.
+func (e *Enricher) newAuditSource() (auditSource, error) {
+	switch e.sourceType {
+	case "", spodv1alpha1.LogEnricherSourceFile:
+		return &fileSource{impl: e.impl, filePath: LogFilePath()}, nil
+	case spodv1alpha1.LogEnricherSourceJournald:
+		return newJournaldSource()
+	case spodv1alpha1.LogEnricherSourceNetlink:
+		return newNetlinkSource()
+	default:
+		return nil, fmt.Errorf("unsupported audit source: %s", e.sourceType)
+	}
+}
+
+// fileSource tails an audit log file.
+type fileSource struct {
+	impl     impl
+	filePath string
+	tailFile *tail.Tail
+}
+
+func (f *fileSource) Name() string {
+	return "file " + f.filePath
+}
+
+func (f *fileSource) Lines() (chan *tail.Line, error) {
+	// If the file does not exist, then tail will wait for it to appear
+	tailFile, err := f.impl.TailFile(
+		f.filePath,
+		tail.Config{
+			ReOpen: true,
+			Follow: true,
+			Location: &tail.SeekInfo{
+				Offset: 0,
+				Whence: io.SeekEnd,
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tailing file: %w", err)
+	}
+
+	f.tailFile = tailFile
+	return f.impl.Lines(tailFile), nil
+}
+
+func (f *fileSource) Reason() error {
+	return f.impl.Reason(f.tailFile)
+}
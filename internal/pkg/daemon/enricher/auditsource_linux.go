@@ -0,0 +1,268 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nxadm/tail"
+	"golang.org/x/sys/unix"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	// maxJournalEntrySize is the maximum size of a single JSON encoded
+	// journal entry.
+	maxJournalEntrySize = 1024 * 1024
+
+	// netlinkBufferSize is the receive buffer size for audit netlink
+	// messages, which is MAX_AUDIT_MESSAGE_LENGTH plus some slack.
+	netlinkBufferSize = 16 * 1024
+)
+
+// journaldSource follows the host journal by using journalctl.
+type journaldSource struct {
+	err error
+}
+
+func newJournaldSource() (auditSource, error) {
+	return &journaldSource{}, nil
+}
+
+func (j *journaldSource) Name() string {
+	return "journald"
+}
+
+func (j *journaldSource) Lines() (chan *tail.Line, error) {
+	//nolint:gosec // the arguments are constant
+	cmd := exec.Command(
+		config.JournalctlPath,
+		"--follow",
+		"--lines=0",
+		"--output=json",
+		"_TRANSPORT=audit", "+", "_TRANSPORT=kernel",
+	)
+	// Use the journalctl binary and journal of the host.
+	cmd.SysProcAttr = &unix.SysProcAttr{Chroot: config.HostRootPath}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("get journalctl stdout: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("start journalctl: %w", err)
+	}
+
+	lines := make(chan *tail.Line)
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxJournalEntrySize)
+		for scanner.Scan() {
+			text, err := journalEntryLine(scanner.Bytes())
+			if err == nil && text == "" {
+				continue
+			}
+			lines <- &tail.Line{Text: text, Time: time.Now(), Err: err}
+		}
+
+		j.err = errors.Join(scanner.Err(), cmd.Wait())
+	}()
+
+	return lines, nil
+}
+
+func (j *journaldSource) Reason() error {
+	return j.err
+}
+
+// journalEntry contains the fields of a JSON encoded journal entry used by
+// the enricher. The message can be a byte array for non UTF-8 content.
+type journalEntry struct {
+	Message         any    `json:"MESSAGE"`
+	Transport       string `json:"_TRANSPORT"`
+	AuditType       string `json:"_AUDIT_TYPE"`
+	AuditID         string `json:"_AUDIT_ID"`
+	SourceTimestamp string `json:"_SOURCE_REALTIME_TIMESTAMP"`
+}
+
+// journalEntryLine converts a JSON encoded journal entry into an audit line.
+// Entries from the kernel transport are returned as they are, while entries
+// from the audit transport get converted back into the kernel format. Empty
+// lines are returned for unsupported entries.
+func journalEntryLine(data []byte) (string, error) {
+	entry := &journalEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return "", fmt.Errorf("unmarshal journal entry: %w", err)
+	}
+
+	message, ok := entry.Message.(string)
+	if !ok {
+		return "", nil
+	}
+
+	if entry.Transport != "audit" {
+		return message, nil
+	}
+
+	// journald prefixes the message with the type name and moves the
+	// timestamp and serial into separate fields.
+	_, payload, _ := strings.Cut(message, " ")
+	usec, err := strconv.ParseInt(entry.SourceTimestamp, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("parse audit timestamp: %w", err)
+	}
+	timestamp := time.UnixMicro(usec)
+
+	return fmt.Sprintf(
+		"audit: type=%s audit(%d.%03d:%s): %s",
+		entry.AuditType, timestamp.Unix(), timestamp.Nanosecond()/int(time.Millisecond),
+		entry.AuditID, payload,
+	), nil
+}
+
+// netlinkSource reads the audit records from the kernel by joining the
+// NETLINK_AUDIT multicast group, which works in parallel to auditd.
+type netlinkSource struct {
+	err error
+}
+
+func newNetlinkSource() (auditSource, error) {
+	return &netlinkSource{}, nil
+}
+
+func (n *netlinkSource) Name() string {
+	return "netlink"
+}
+
+func (n *netlinkSource) Lines() (chan *tail.Line, error) {
+	fd, err := netlinkAuditSocket()
+	if err != nil {
+		return nil, fmt.Errorf("create audit netlink socket: %w", err)
+	}
+
+	lines := make(chan *tail.Line)
+	go func() {
+		defer close(lines)
+		defer unix.Close(fd)
+
+		buf := make([]byte, netlinkBufferSize)
+		for {
+			size, _, err := unix.Recvfrom(fd, buf, 0)
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			if errors.Is(err, unix.ENOBUFS) {
+				// The kernel dropped messages because we were too slow.
+				lines <- &tail.Line{Time: time.Now(), Err: fmt.Errorf("receive audit message: %w", err)}
+				continue
+			}
+			if err != nil {
+				n.err = fmt.Errorf("receive audit message: %w", err)
+				return
+			}
+
+			text, err := netlinkAuditLine(buf[:size])
+			lines <- &tail.Line{Text: text, Time: time.Now(), Err: err}
+		}
+	}()
+
+	return lines, nil
+}
+
+func (n *netlinkSource) Reason() error {
+	return n.err
+}
+
+// netlinkAuditSocket creates the audit netlink socket and subscribes to the
+// read log multicast group. The kernel only sends audit records to the
+// initial network namespace, which is why the socket gets created there.
+func netlinkAuditSocket() (int, error) {
+	type result struct {
+		fd  int
+		err error
+	}
+	resultChan := make(chan result)
+
+	go func() {
+		// The thread never gets unlocked, which causes the runtime to
+		// terminate it rather than reusing it in the host network namespace.
+		runtime.LockOSThread()
+
+		fd, err := hostNetlinkAuditSocket()
+		resultChan <- result{fd, err}
+	}()
+
+	res := <-resultChan
+	return res.fd, res.err
+}
+
+func hostNetlinkAuditSocket() (int, error) {
+	hostNs, err := unix.Open(config.HostNetNsPath, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return -1, fmt.Errorf("open host network namespace: %w", err)
+	}
+	defer unix.Close(hostNs)
+
+	if err := unix.Setns(hostNs, unix.CLONE_NEWNET); err != nil {
+		return -1, fmt.Errorf("join host network namespace: %w", err)
+	}
+
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_AUDIT)
+	if err != nil {
+		return -1, fmt.Errorf("create socket: %w", err)
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{
+		Family: unix.AF_NETLINK,
+		Groups: unix.AUDIT_NLGRP_READLOG,
+	}); err != nil {
+		unix.Close(fd)
+		return -1, fmt.Errorf("bind to audit multicast group: %w", err)
+	}
+
+	return fd, nil
+}
+
+// netlinkAuditLine converts a netlink audit message into the kernel log
+// format of audit lines.
+func netlinkAuditLine(msg []byte) (string, error) {
+	if len(msg) < unix.NLMSG_HDRLEN {
+		return "", fmt.Errorf("audit message too short: %d bytes", len(msg))
+	}
+
+	// The header consists of the length followed by the message type.
+	msgType := binary.NativeEndian.Uint16(msg[4:6])
+	payload := strings.TrimRight(string(msg[unix.NLMSG_HDRLEN:]), "\x00\n")
+
+	return fmt.Sprintf("audit: type=%d %s", msgType, payload), nil
+}
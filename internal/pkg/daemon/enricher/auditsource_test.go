@@ -0,0 +1,263 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nxadm/tail"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/export"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/export/exportfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// replayAuditSource returns a fake audit source which replays the recorded
+// audit lines of the provided file.
+func replayAuditSource(t *testing.T, path string) *enricherfakes.FakeAuditSource {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	recorded := strings.Split(strings.TrimSpace(string(content)), "\n")
+	lines := make(chan *tail.Line, len(recorded))
+	for _, text := range recorded {
+		lines <- &tail.Line{Text: text, Time: time.Now()}
+	}
+	close(lines)
+
+	source := &enricherfakes.FakeAuditSource{}
+	source.NameReturns(path)
+	source.LinesReturns(lines, nil)
+	return source
+}
+
+func TestRunAuditSource(t *testing.T) {
+	t.Parallel()
+
+	const (
+		containerName = "container"
+		profile       = "profile"
+	)
+
+	mock := &enricherfakes.FakeImpl{}
+	mock.GetenvReturns(node)
+	mock.DialReturns(nil, func() {}, nil)
+	mock.ContainerIDForPIDReturns(containerID, nil)
+	mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey + containerName: profile,
+			},
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:        containerName,
+				ContainerID: crioPrefix + containerID,
+			}},
+		},
+	}}}, nil)
+
+	sink := &exportfakes.FakeSink{}
+	sink.SendReturnsOnCall(0, errTest)
+	sut := New(logr.Discard(), "", []export.Sink{sink})
+	sut.impl = mock
+	source := replayAuditSource(t, "testdata/audit.log")
+	sut.auditSource = source
+
+	err := sut.Run()
+	require.ErrorContains(t, err, "audit source testdata/audit.log stopped")
+	require.Equal(t, 1, source.LinesCallCount())
+	require.Equal(t, 0, mock.TailFileCallCount())
+
+	// Two seccomp and one SELinux line, while the end of event line gets skipped
+	require.Equal(t, 3, mock.SendMetricCallCount())
+
+	// All events get exported, even if a previous one failed
+	require.Equal(t, 3, sink.SendCallCount())
+	require.Equal(t, 1, sink.CloseCallCount())
+	for i := range sink.SendCallCount() {
+		event := sink.SendArgsForCall(i)
+		require.Equal(t, node, event.Node)
+		require.Equal(t, namespace, event.Namespace)
+		require.Equal(t, pod, event.Pod)
+		require.Equal(t, containerName, event.Container)
+		require.Equal(t, profile, event.Profile)
+		require.Equal(t, event.Type == types.AuditTypeSeccomp, event.Seccomp != nil)
+		require.Equal(t, event.Type == types.AuditTypeSelinux, event.Selinux != nil)
+	}
+
+	res, err := sut.Syscalls(context.Background(), &api.SyscallsRequest{Profile: profile})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"mprotect", "prctl"}, res.GetSyscalls())
+
+	// Only prctl has a SYSCALL record containing the arguments
+	args := map[string][]uint64{}
+	for _, event := range res.GetEvents() {
+		args[event.GetSyscall()] = event.GetArgs()
+	}
+	require.Equal(t, []uint64{0x26, 1, 0, 0}, args["prctl"])
+	require.Contains(t, args, "mprotect")
+	require.Empty(t, args["mprotect"])
+}
+
+func TestRunAuditSourceFailure(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*Enricher, *enricherfakes.FakeAuditSource)
+		wantErr string
+	}{
+		{
+			name: "unsupported source",
+			prepare: func(sut *Enricher, _ *enricherfakes.FakeAuditSource) {
+				sut.auditSource = nil
+				sut.sourceType = "wrong"
+			},
+			wantErr: "unsupported audit source: wrong",
+		},
+		{
+			name: "failure on Lines",
+			prepare: func(_ *Enricher, source *enricherfakes.FakeAuditSource) {
+				source.LinesReturns(nil, errTest)
+			},
+			wantErr: "read audit source",
+		},
+		{
+			name: "failure on Reason",
+			prepare: func(_ *Enricher, source *enricherfakes.FakeAuditSource) {
+				lines := make(chan *tail.Line)
+				close(lines)
+				source.LinesReturns(lines, nil)
+				source.ReasonReturns(errTest)
+			},
+			wantErr: "enricher failed: test",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &enricherfakes.FakeImpl{}
+			mock.GetenvReturns(node)
+			mock.DialReturns(nil, func() {}, nil)
+
+			source := &enricherfakes.FakeAuditSource{}
+			sut := New(logr.Discard(), spodv1alpha1.LogEnricherSourceJournald, nil)
+			sut.impl = mock
+			sut.auditSource = source
+			tc.prepare(sut, source)
+
+			require.ErrorContains(t, sut.Run(), tc.wantErr)
+		})
+	}
+}
+
+func Test_journalEntryLine(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		entry   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "audit transport",
+			//nolint:lll // no need to wrap
+			entry: `{"MESSAGE":"SECCOMP auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm=\"sleep\" exe=\"/bin/busybox\" sig=0 arch=c000003e syscall=10 compat=0 ip=0x7f4ce626349b code=0x7ffc0000","_TRANSPORT":"audit","_AUDIT_TYPE":"1326","_AUDIT_ID":"8477","_SOURCE_REALTIME_TIMESTAMP":"1624537480360123"}`,
+			//nolint:lll // no need to wrap
+			want: `audit: type=1326 audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm="sleep" exe="/bin/busybox" sig=0 arch=c000003e syscall=10 compat=0 ip=0x7f4ce626349b code=0x7ffc0000`,
+		},
+		{
+			name: "kernel transport",
+			//nolint:lll // no need to wrap
+			entry: `{"MESSAGE":"audit: type=1326 audit(1624537480.360:8477): pid=1 exe=\"/bin/sh\" syscall=10 code=0x7ffc0000","_TRANSPORT":"kernel"}`,
+			want:  `audit: type=1326 audit(1624537480.360:8477): pid=1 exe="/bin/sh" syscall=10 code=0x7ffc0000`,
+		},
+		{
+			name:  "binary message",
+			entry: `{"MESSAGE":[1,2,3],"_TRANSPORT":"kernel"}`,
+			want:  "",
+		},
+		{
+			name:    "invalid timestamp",
+			entry:   `{"MESSAGE":"SECCOMP pid=1","_TRANSPORT":"audit","_SOURCE_REALTIME_TIMESTAMP":"wrong"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			entry:   `{`,
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := journalEntryLine([]byte(tc.entry))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			if got != "" {
+				require.True(t, IsAuditLine(got))
+			}
+		})
+	}
+}
+
+func Test_netlinkAuditLine(t *testing.T) {
+	t.Parallel()
+
+	payload := `audit(1624537480.360:8477): auid=1000 uid=0 gid=0 ses=1 pid=2060394 comm="sleep" ` +
+		`exe="/bin/busybox" sig=0 arch=c000003e syscall=10 compat=0 ip=0x7f4ce626349b code=0x7ffc0000`
+
+	msg := make([]byte, unix.NLMSG_HDRLEN, unix.NLMSG_HDRLEN+len(payload)+1)
+	binary.NativeEndian.PutUint32(msg[0:4], uint32(cap(msg)))
+	binary.NativeEndian.PutUint16(msg[4:6], 1326)
+	msg = append(msg, payload+"\x00"...)
+
+	got, err := netlinkAuditLine(msg)
+	require.NoError(t, err)
+	require.Equal(t, "audit: type=1326 "+payload, got)
+	require.True(t, IsAuditLine(got))
+
+	_, err = netlinkAuditLine(msg[:4])
+	require.Error(t, err)
+}
@@ -19,12 +19,15 @@ package enricher
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
@@ -140,12 +143,16 @@ func (e *Enricher) populateCacheEntryForContainer(
 			if !ok {
 				recordProfile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
 			}
+			workloadKind, workloadName := podWorkload(pod)
 			info := &types.ContainerInfo{
-				PodName:       pod.Name,
-				ContainerName: containerStatus.Name,
-				Namespace:     pod.Namespace,
-				ContainerID:   rawContainerID,
-				RecordProfile: recordProfile,
+				PodName:        pod.Name,
+				ContainerName:  containerStatus.Name,
+				Namespace:      pod.Namespace,
+				ContainerID:    rawContainerID,
+				RecordProfile:  recordProfile,
+				WorkloadKind:   workloadKind,
+				WorkloadName:   workloadName,
+				SeccompProfile: containerSeccompProfile(pod, containerName),
 			}
 
 			// Update the cache
@@ -156,6 +163,53 @@ func (e *Enricher) populateCacheEntryForContainer(
 	})
 }
 
+// podWorkload returns the kind and name of the workload controlling the pod.
+// Pods of deployments are resolved by their pod template hash, while
+// uncontrolled pods are their own workload.
+func podWorkload(pod *v1.Pod) (kind, name string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+
+	if owner.Kind == "ReplicaSet" {
+		hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		if ok && strings.HasSuffix(owner.Name, "-"+hash) {
+			return "Deployment", strings.TrimSuffix(owner.Name, "-"+hash)
+		}
+	}
+
+	return owner.Kind, owner.Name
+}
+
+// containerSeccompProfile returns the seccomp profile of a container, which
+// falls back to the one of the pod.
+func containerSeccompProfile(pod *v1.Pod, containerName string) string {
+	var profile *v1.SeccompProfile
+	if pod.Spec.SecurityContext != nil {
+		profile = pod.Spec.SecurityContext.SeccompProfile
+	}
+
+	//nolint:gocritic // This is what we expect and want
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for i := range containers {
+		container := &containers[i]
+		if container.Name == containerName &&
+			container.SecurityContext != nil &&
+			container.SecurityContext.SeccompProfile != nil {
+			profile = container.SecurityContext.SeccompProfile
+		}
+	}
+
+	if profile == nil {
+		return ""
+	}
+	if profile.Type == v1.SeccompProfileTypeLocalhost && profile.LocalhostProfile != nil {
+		return *profile.LocalhostProfile
+	}
+	return string(profile.Type)
+}
+
 func (e *Enricher) handleContainerIDEmpty(podName, containerName string, containerStatus *v1.ContainerStatus) error {
 	if containerStatus.State.Waiting != nil &&
 		(containerStatus.State.Waiting.Reason == "ContainerCreating" ||
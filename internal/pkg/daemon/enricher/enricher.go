@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -29,7 +28,6 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
-	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -39,7 +37,9 @@ import (
 
 	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	apimetrics "sigs.k8s.io/security-profiles-operator/api/grpc/metrics"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/export"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
@@ -63,16 +63,34 @@ type Enricher struct {
 	containerIDCache *ttlcache.Cache[string, string]
 	infoCache        *ttlcache.Cache[string, *types.ContainerInfo]
 	syscalls         sync.Map
+	syscallEvents    sync.Map
+	syscallEventsMu  sync.Mutex
 	avcs             sync.Map
+	violations       map[string]*apienricher.Violation
+	violationsMu     sync.Mutex
 	auditLineCache   *ttlcache.Cache[string, []*types.AuditLine]
-	clientset        kubernetes.Interface
+	// pendingSyscallEvents are the recorded seccomp events by their audit
+	// ID, which wait for the arguments of the SYSCALL record.
+	pendingSyscallEvents   map[string]*pendingSyscallEvent
+	pendingSyscallEventsMu sync.Mutex
+	clientset              kubernetes.Interface
+	sourceType             spodv1alpha1.LogEnricherSource
+	auditSource            auditSource
+	sinks                  []export.Sink
 }
 
-// New returns a new Enricher instance.
-func New(logger logr.Logger) *Enricher {
+// New returns a new Enricher instance, which reads the audit lines from the
+// provided source type and exports the enriched events to the sinks.
+func New(
+	logger logr.Logger,
+	sourceType spodv1alpha1.LogEnricherSource,
+	sinks []export.Sink,
+) *Enricher {
 	return &Enricher{
-		impl:   &defaultImpl{},
-		logger: logger,
+		impl:       &defaultImpl{},
+		logger:     logger,
+		sourceType: sourceType,
+		sinks:      sinks,
 		containerIDCache: ttlcache.New(
 			ttlcache.WithTTL[string, string](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, string](maxCacheItems),
@@ -81,8 +99,11 @@ func New(logger logr.Logger) *Enricher {
 			ttlcache.WithTTL[string, *types.ContainerInfo](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, *types.ContainerInfo](maxCacheItems),
 		),
-		syscalls: sync.Map{},
-		avcs:     sync.Map{},
+		syscalls:             sync.Map{},
+		syscallEvents:        sync.Map{},
+		avcs:                 sync.Map{},
+		violations:           map[string]*apienricher.Violation{},
+		pendingSyscallEvents: map[string]*pendingSyscallEvent{},
 		auditLineCache: ttlcache.New(
 			ttlcache.WithTTL[string, []*types.AuditLine](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, []*types.AuditLine](maxCacheItems),
@@ -120,6 +141,7 @@ func (e *Enricher) Run() error {
 	}
 
 	e.logger.Info("Starting log-enricher on node: " + nodeName)
+	defer e.closeSinks()
 
 	e.logger.Info("Connecting to local GRPC server")
 	var (
@@ -153,32 +175,28 @@ func (e *Enricher) Run() error {
 		return fmt.Errorf("start GRPC server: %w", err)
 	}
 
-	// Use auditd logs as main source or syslog as fallback.
-	filePath := LogFilePath()
+	source := e.auditSource
+	if source == nil {
+		source, err = e.newAuditSource()
+		if err != nil {
+			return fmt.Errorf("create audit source: %w", err)
+		}
+	}
 
-	// If the file does not exist, then tail will wait for it to appear
-	tailFile, err := e.TailFile(
-		filePath,
-		tail.Config{
-			ReOpen: true,
-			Follow: true,
-			Location: &tail.SeekInfo{
-				Offset: 0,
-				Whence: io.SeekEnd,
-			},
-		},
-	)
+	lines, err := source.Lines()
 	if err != nil {
-		return fmt.Errorf("tailing file: %w", err)
+		return fmt.Errorf("read audit source %s: %w", source.Name(), err)
 	}
 
-	e.logger.Info("Reading from file " + filePath)
-	for l := range e.Lines(tailFile) {
+	e.logger.Info("Reading from audit source " + source.Name())
+	for l := range lines {
 		if l.Err != nil {
-			e.logger.Error(l.Err, "failed to tail")
+			e.logger.Error(l.Err, "failed to read audit source")
 			continue
 		}
 
+		e.flushExpiredSyscallEvents()
+
 		line := l.Text
 		e.logger.V(config.VerboseLevel).Info("Got line: " + line)
 		if !IsAuditLine(line) {
@@ -192,6 +210,12 @@ func (e *Enricher) Run() error {
 			continue
 		}
 
+		if auditLine.AuditType == types.AuditTypeSyscall {
+			// SYSCALL records only complement the recorded seccomp events
+			e.addSyscallArgs(auditLine)
+			continue
+		}
+
 		e.logger.V(config.VerboseLevel).Info(fmt.Sprintf("Get container ID for PID: %d", auditLine.ProcessID))
 		cID, err := e.ContainerIDForPID(e.containerIDCache, auditLine.ProcessID)
 		if errors.Is(err, os.ErrNotExist) {
@@ -237,7 +261,10 @@ func (e *Enricher) Run() error {
 		e.dispatchBacklog(metricsClient, nodeName, info, auditLine.ProcessID)
 	}
 
-	return fmt.Errorf("enricher failed: %w", e.Reason(tailFile))
+	if err := source.Reason(); err != nil {
+		return fmt.Errorf("enricher failed: %w", err)
+	}
+	return fmt.Errorf("enricher failed: audit source %s stopped", source.Name())
 }
 
 func (e *Enricher) startGrpcServer() error {
@@ -388,6 +415,24 @@ func (e *Enricher) dispatchSelinuxLine(
 		"tclass", auditLine.Tclass,
 	)
 
+	event := newExportEvent(nodeName, auditLine, info)
+	event.Selinux = &export.SelinuxEvent{
+		Perm:     auditLine.Perm,
+		Scontext: auditLine.Scontext,
+		Tcontext: auditLine.Tcontext,
+		Tclass:   auditLine.Tclass,
+	}
+	e.export(event)
+
+	for _, perm := range strings.Split(auditLine.Perm, " ") {
+		violation := newViolation(apienricher.Violation_SELINUX, info)
+		violation.Perm = perm
+		violation.Scontext = auditLine.Scontext
+		violation.Tcontext = auditLine.Tcontext
+		violation.Tclass = auditLine.Tclass
+		e.recordViolation(violation, auditLine.TimestampID)
+	}
+
 	if err := e.SendMetric(
 		metricsClient,
 		&apimetrics.AuditRequest{
@@ -454,8 +499,29 @@ func (e *Enricher) dispatchSeccompLine(
 		"pid", auditLine.ProcessID,
 		"syscallID", auditLine.SystemCallID,
 		"syscallName", syscallName,
+		"action", auditLine.Action,
 	)
 
+	event := newExportEvent(nodeName, auditLine, info)
+	event.Seccomp = &export.SeccompEvent{
+		SyscallID:   auditLine.SystemCallID,
+		SyscallName: syscallName,
+		Arch:        auditLine.Arch,
+		Signal:      auditLine.Signal,
+		Code:        auditLine.Code,
+		Action:      auditLine.Action,
+		UID:         auditLine.UID,
+		AUID:        auditLine.AUID,
+	}
+	e.export(event)
+
+	if isReportedSeccompAction(auditLine.Action, info.SeccompProfile) {
+		violation := newViolation(apienricher.Violation_SECCOMP, info)
+		violation.Syscall = syscallName
+		violation.Action = auditLine.Action
+		e.recordViolation(violation, auditLine.TimestampID)
+	}
+
 	if err := e.SendMetric(
 		metricsClient,
 		&apimetrics.AuditRequest{
@@ -478,6 +544,17 @@ func (e *Enricher) dispatchSeccompLine(
 		if ok {
 			stringSet.Insert(syscallName)
 		}
+
+		// The arguments are part of the SYSCALL record of the audit event
+		e.addPendingSyscallEvent(auditLine, info.RecordProfile, &apienricher.SyscallEvent{
+			Syscall: syscallName,
+			Arch:    auditLine.Arch,
+			Signal:  int32(auditLine.Signal),
+			Code:    auditLine.Code,
+			Action:  auditLine.Action,
+			Uid:     auditLine.UID,
+			Auid:    auditLine.AUID,
+		})
 	}
 }
 
@@ -506,6 +583,63 @@ func (e *Enricher) dispatchApparmorLine(
 	}
 
 	e.logger.Info("audit", values...)
+
+	event := newExportEvent(nodeName, auditLine, info)
+	event.Apparmor = &export.ApparmorEvent{
+		Apparmor:  auditLine.Apparmor,
+		Operation: auditLine.Operation,
+		Profile:   auditLine.Profile,
+		Name:      auditLine.Name,
+		Extra:     auditLine.ExtraInfo,
+	}
+	e.export(event)
+
+	if auditLine.Apparmor == apparmorDenied {
+		violation := newViolation(apienricher.Violation_APPARMOR, info)
+		violation.ApparmorProfile = auditLine.Profile
+		violation.Operation = auditLine.Operation
+		violation.Name = auditLine.Name
+		violation.DeniedMask = apparmorDeniedMask(auditLine.ExtraInfo)
+		e.recordViolation(violation, auditLine.TimestampID)
+	}
+}
+
+// newExportEvent returns the export event with the common fields of the audit
+// line and container set.
+func newExportEvent(
+	nodeName string,
+	auditLine *types.AuditLine,
+	info *types.ContainerInfo,
+) *export.Event {
+	return &export.Event{
+		Timestamp:   auditLine.TimestampID,
+		Type:        auditLine.AuditType,
+		Node:        nodeName,
+		Namespace:   info.Namespace,
+		Pod:         info.PodName,
+		Container:   info.ContainerName,
+		ContainerID: info.ContainerID,
+		Executable:  auditLine.Executable,
+		PID:         auditLine.ProcessID,
+		Profile:     info.RecordProfile,
+	}
+}
+
+// export sends the event to all configured sinks.
+func (e *Enricher) export(event *export.Event) {
+	for _, sink := range e.sinks {
+		if err := sink.Send(event); err != nil {
+			e.logger.Error(err, "unable to export event", "sink", sink.Name())
+		}
+	}
+}
+
+func (e *Enricher) closeSinks() {
+	for _, sink := range e.sinks {
+		if err := sink.Close(); err != nil {
+			e.logger.Error(err, "unable to close sink", "sink", sink.Name())
+		}
+	}
 }
 
 // LogFilePath returns either the path to the audit logs or falls back to
@@ -324,7 +324,7 @@ func TestRun(t *testing.T) {
 		mock := &enricherfakes.FakeImpl{}
 		tc.prepare(mock, lineChan)
 
-		sut := New(logr.Discard())
+		sut := New(logr.Discard(), "", nil)
 		sut.impl = mock
 
 		var err error
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package export delivers the enriched audit events of the log enricher to
+// external consumers.
+package export
+
+// Sink is the destination of enriched audit events.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . Sink
+type Sink interface {
+	// Name returns a human readable description of the sink.
+	Name() string
+
+	// Send delivers a single event. Implementations may buffer the event
+	// and deliver it asynchronously.
+	Send(event *Event) error
+
+	// Close flushes all pending events and releases the resources of the
+	// sink.
+	Close() error
+}
+
+// Event is an enriched audit event, which gets serialized as JSON.
+type Event struct {
+	// Timestamp is the audit timestamp and serial, for example
+	// "1624537480.360:8477".
+	Timestamp string `json:"timestamp"`
+	// Type is the audit type, which is one of seccomp, selinux or apparmor.
+	Type        string `json:"type"`
+	Node        string `json:"node"`
+	Namespace   string `json:"namespace"`
+	Pod         string `json:"pod"`
+	Container   string `json:"container"`
+	ContainerID string `json:"containerID,omitempty"`
+	Executable  string `json:"executable,omitempty"`
+	PID         int    `json:"pid,omitempty"`
+	// Profile is the recording profile of the container, if any.
+	Profile string `json:"profile,omitempty"`
+
+	Seccomp  *SeccompEvent  `json:"seccomp,omitempty"`
+	Selinux  *SelinuxEvent  `json:"selinux,omitempty"`
+	Apparmor *ApparmorEvent `json:"apparmor,omitempty"`
+}
+
+// SeccompEvent contains the details of a seccomp audit event.
+type SeccompEvent struct {
+	SyscallID   int32  `json:"syscallID"`
+	SyscallName string `json:"syscallName"`
+	Arch        string `json:"arch,omitempty"`
+	Signal      int    `json:"signal,omitempty"`
+	Code        uint32 `json:"code,omitempty"`
+	Action      string `json:"action,omitempty"`
+	UID         uint32 `json:"uid"`
+	AUID        uint32 `json:"auid"`
+}
+
+// SelinuxEvent contains the details of a SELinux AVC.
+type SelinuxEvent struct {
+	Perm     string `json:"perm"`
+	Scontext string `json:"scontext"`
+	Tcontext string `json:"tcontext"`
+	Tclass   string `json:"tclass"`
+}
+
+// ApparmorEvent contains the details of an AppArmor audit event.
+type ApparmorEvent struct {
+	Apparmor  string `json:"apparmor"`
+	Operation string `json:"operation"`
+	Profile   string `json:"profile"`
+	Name      string `json:"name,omitempty"`
+	Extra     string `json:"extra,omitempty"`
+}
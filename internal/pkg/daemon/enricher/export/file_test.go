@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readEvents(t *testing.T, path string) []*Event {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	events := []*Event{}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		event := &Event{}
+		require.NoError(t, json.Unmarshal([]byte(line), event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "export", "events.jsonl")
+	sut, err := NewFileSink(path, 0, -1)
+	require.NoError(t, err)
+	require.Equal(t, "file "+path, sut.Name())
+
+	require.NoError(t, sut.Send(&Event{
+		Type: "seccomp",
+		Pod:  "pod",
+		Seccomp: &SeccompEvent{
+			SyscallID:   10,
+			SyscallName: "mprotect",
+		},
+	}))
+	require.NoError(t, sut.Send(&Event{
+		Type:    "selinux",
+		Selinux: &SelinuxEvent{Perm: "read"},
+	}))
+	require.NoError(t, sut.Close())
+	require.NoError(t, sut.Close())
+	require.Error(t, sut.Send(&Event{}))
+
+	events := readEvents(t, path)
+	require.Len(t, events, 2)
+	require.Equal(t, "mprotect", events[0].Seccomp.SyscallName)
+	require.Nil(t, events[0].Selinux)
+	require.Equal(t, "read", events[1].Selinux.Perm)
+
+	// Reopening appends to the existing file
+	sut, err = NewFileSink(path, 0, -1)
+	require.NoError(t, err)
+	require.NoError(t, sut.Send(&Event{Type: "apparmor"}))
+	require.NoError(t, sut.Close())
+	require.Len(t, readEvents(t, path), 3)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		maxBackups  int
+		wantBackups []string
+	}{
+		{
+			name:        "with backups",
+			maxBackups:  2,
+			wantBackups: []string{"events.jsonl.1", "events.jsonl.2"},
+		},
+		{
+			name:       "without backups",
+			maxBackups: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			path := filepath.Join(dir, "events.jsonl")
+
+			event := &Event{Type: "seccomp", Pod: "pod"}
+			line, err := json.Marshal(event)
+			require.NoError(t, err)
+
+			// Every event exceeds the maximum size of the file
+			sut, err := NewFileSink(path, int64(len(line)), tc.maxBackups)
+			require.NoError(t, err)
+			for range 5 {
+				require.NoError(t, sut.Send(event))
+			}
+			require.NoError(t, sut.Close())
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			names := []string{}
+			for _, entry := range entries {
+				if entry.Name() != "events.jsonl" {
+					names = append(names, entry.Name())
+				}
+			}
+			require.ElementsMatch(t, tc.wantBackups, names)
+
+			require.Len(t, readEvents(t, path), 1)
+			for _, backup := range tc.wantBackups {
+				require.Len(t, readEvents(t, filepath.Join(dir, backup)), 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// DefaultWebhookBatchSize is the default maximum number of events per
+	// request.
+	DefaultWebhookBatchSize = 100
+
+	// DefaultWebhookFlushInterval is the default interval after which
+	// pending events get sent, even if the batch is not full.
+	DefaultWebhookFlushInterval = 5 * time.Second
+
+	// DefaultWebhookMaxRetries is the default number of retries for a
+	// failed request.
+	DefaultWebhookMaxRetries = 5
+
+	// webhookQueueBatches is the number of batches which can be queued
+	// before new events get dropped.
+	webhookQueueBatches = 10
+
+	webhookRequestTimeout = 30 * time.Second
+	webhookRetryDuration  = time.Second
+	webhookRetryFactor    = 2
+)
+
+// errPermanent marks webhook failures which are not worth retrying.
+var errPermanent = errors.New("permanent failure")
+
+// WebhookOptions configures the webhook sink.
+type WebhookOptions struct {
+	// URL is the HTTP(S) endpoint which receives the events as JSON array
+	// via POST requests.
+	URL string
+	// Authorization is the optional value of the Authorization header.
+	Authorization string
+	// BatchSize is the maximum number of events per request.
+	BatchSize int
+	// FlushInterval is the interval after which pending events get sent.
+	FlushInterval time.Duration
+	// MaxRetries is the number of retries for a failed request.
+	MaxRetries int
+}
+
+// WebhookSink sends the events in batches to a HTTP(S) endpoint. Failed
+// requests are retried with an exponential backoff, while events get
+// dropped if the endpoint cannot keep up.
+type WebhookSink struct {
+	logger  logr.Logger
+	opts    WebhookOptions
+	client  *http.Client
+	backoff wait.Backoff
+	queue   chan *Event
+	closeMu sync.RWMutex
+	closed  bool
+	done    chan struct{}
+}
+
+// NewWebhookSink creates a new webhook sink and starts its background
+// sender.
+func NewWebhookSink(logger logr.Logger, opts *WebhookOptions) (*WebhookSink, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported webhook URL scheme: %q", u.Scheme)
+	}
+
+	o := *opts
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultWebhookBatchSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultWebhookFlushInterval
+	}
+	if o.MaxRetries < 0 {
+		o.MaxRetries = DefaultWebhookMaxRetries
+	}
+
+	w := &WebhookSink{
+		logger: logger,
+		opts:   o,
+		client: &http.Client{Timeout: webhookRequestTimeout},
+		backoff: wait.Backoff{
+			Duration: webhookRetryDuration,
+			Factor:   webhookRetryFactor,
+			Steps:    o.MaxRetries + 1,
+		},
+		queue: make(chan *Event, o.BatchSize*webhookQueueBatches),
+		done:  make(chan struct{}),
+	}
+	go w.run()
+
+	return w, nil
+}
+
+func (w *WebhookSink) Name() string {
+	return "webhook " + w.opts.URL
+}
+
+func (w *WebhookSink) Send(event *Event) error {
+	w.closeMu.RLock()
+	defer w.closeMu.RUnlock()
+
+	if w.closed {
+		return errors.New("webhook sink is closed")
+	}
+
+	select {
+	case w.queue <- event:
+		return nil
+	default:
+		return errors.New("webhook queue is full, dropping event")
+	}
+}
+
+func (w *WebhookSink) Close() error {
+	w.closeMu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.closeMu.Unlock()
+
+	<-w.done
+	return nil
+}
+
+// run collects the queued events and sends them as soon as the batch is
+// full or the flush interval passed.
+func (w *WebhookSink) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, w.opts.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := w.post(batch); err != nil {
+			w.logger.Error(err, "unable to export events", "sink", w.Name(), "events", len(batch))
+		}
+		batch = make([]*Event, 0, w.opts.BatchSize)
+	}
+
+	for {
+		select {
+		case event, ok := <-w.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, event)
+			if len(batch) >= w.opts.BatchSize {
+				flush()
+			}
+
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// post sends a batch of events and retries on temporary failures.
+func (w *WebhookSink) post(batch []*Event) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("marshal events: %w", err)
+	}
+
+	var lastErr error
+	if err := util.RetryEx(&w.backoff, func() error {
+		lastErr = w.request(body)
+		return lastErr
+	}, func(err error) bool {
+		return !errors.Is(err, errPermanent)
+	}); err != nil {
+		return fmt.Errorf("send events (last error: %v): %w", lastErr, err)
+	}
+	return nil
+}
+
+func (w *WebhookSink) request(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w: %w", errPermanent, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.opts.Authorization != "" {
+		req.Header.Set("Authorization", w.opts.Authorization)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post events: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body to allow reusing the connection.
+	//nolint:errcheck // the content is not required
+	io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	default:
+		return fmt.Errorf("unexpected status: %s: %w", resp.Status, errPermanent)
+	}
+}
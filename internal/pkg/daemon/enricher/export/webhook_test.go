@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookServer records the received batches and responds with the
+// provided status codes in order, followed by http.StatusOK.
+type webhookServer struct {
+	mu       sync.Mutex
+	statuses []int
+	requests int
+	batches  [][]*Event
+	headers  []http.Header
+}
+
+func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	s.headers = append(s.headers, r.Header.Clone())
+
+	if len(s.statuses) > 0 {
+		status := s.statuses[0]
+		s.statuses = s.statuses[1:]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+	}
+
+	batch := []*Event{}
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.batches = append(s.batches, batch)
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		opts         WebhookOptions
+		statuses     []int
+		events       int
+		wantRequests int
+		wantBatches  []int
+	}{
+		{
+			name:         "batches by size",
+			opts:         WebhookOptions{BatchSize: 2, FlushInterval: time.Hour},
+			events:       5,
+			wantRequests: 3,
+			wantBatches:  []int{2, 2, 1},
+		},
+		{
+			name:         "flushes on interval",
+			opts:         WebhookOptions{BatchSize: 100, FlushInterval: 10 * time.Millisecond},
+			events:       1,
+			wantRequests: 1,
+			wantBatches:  []int{1},
+		},
+		{
+			name:         "retries temporary failures",
+			opts:         WebhookOptions{BatchSize: 3, FlushInterval: time.Hour, MaxRetries: 3},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusTooManyRequests},
+			events:       3,
+			wantRequests: 3,
+			wantBatches:  []int{3},
+		},
+		{
+			name:         "gives up after max retries",
+			opts:         WebhookOptions{BatchSize: 1, FlushInterval: time.Hour, MaxRetries: 1},
+			statuses:     []int{http.StatusInternalServerError, http.StatusInternalServerError},
+			events:       1,
+			wantRequests: 2,
+		},
+		{
+			name:         "does not retry permanent failures",
+			opts:         WebhookOptions{BatchSize: 1, FlushInterval: time.Hour, MaxRetries: 3},
+			statuses:     []int{http.StatusUnauthorized},
+			events:       1,
+			wantRequests: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &webhookServer{statuses: tc.statuses}
+			ts := httptest.NewServer(server)
+			defer ts.Close()
+
+			opts := tc.opts
+			opts.URL = ts.URL
+			opts.Authorization = "Bearer token"
+			sut, err := NewWebhookSink(logr.Discard(), &opts)
+			require.NoError(t, err)
+			sut.backoff.Duration = time.Millisecond
+
+			for range tc.events {
+				require.NoError(t, sut.Send(&Event{Type: "seccomp", Pod: "pod"}))
+			}
+
+			if opts.FlushInterval < time.Hour {
+				require.Eventually(t, func() bool {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					return server.requests == tc.wantRequests
+				}, 5*time.Second, 10*time.Millisecond)
+			}
+
+			require.NoError(t, sut.Close())
+			require.Error(t, sut.Send(&Event{}))
+
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			require.Equal(t, tc.wantRequests, server.requests)
+			sizes := []int{}
+			for _, batch := range server.batches {
+				sizes = append(sizes, len(batch))
+			}
+			require.ElementsMatch(t, tc.wantBatches, sizes)
+			for _, header := range server.headers {
+				require.Equal(t, "application/json", header.Get("Content-Type"))
+				require.Equal(t, "Bearer token", header.Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestNewWebhookSinkFailure(t *testing.T) {
+	t.Parallel()
+
+	for _, url := range []string{"ftp://localhost", "://wrong"} {
+		_, err := NewWebhookSink(logr.Discard(), &WebhookOptions{URL: url})
+		require.Error(t, err)
+	}
+}
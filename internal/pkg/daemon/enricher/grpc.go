@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
@@ -50,9 +51,35 @@ func (e *Enricher) Syscalls(
 	if !ok {
 		return nil, errors.New("syscalls are no string set")
 	}
+
+	// Events whose SYSCALL record did not arrive yet are returned without
+	// arguments.
+	e.flushPendingSyscallEvents(func(pending *pendingSyscallEvent) bool {
+		return pending.profile == r.GetProfile()
+	})
+
+	e.syscallEventsMu.Lock()
+	defer e.syscallEventsMu.Unlock()
+
+	events := []*api.SyscallEvent{}
+	if eventsValue, ok := e.syscallEvents.Load(r.GetProfile()); ok {
+		eventSet, ok := eventsValue.(sets.Set[string])
+		if !ok {
+			return nil, errors.New("syscall events are no string set")
+		}
+		for _, eventJSON := range sets.List(eventSet) {
+			event := &api.SyscallEvent{}
+			if err := protojson.Unmarshal([]byte(eventJSON), event); err != nil {
+				return nil, fmt.Errorf("unmarshall JSON: %w", err)
+			}
+			events = append(events, event)
+		}
+	}
+
 	return &api.SyscallsResponse{
 		Syscalls: stringSet.UnsortedList(),
 		GoArch:   runtime.GOARCH,
+		Events:   events,
 	}, nil
 }
 
@@ -61,6 +88,18 @@ func (e *Enricher) ResetSyscalls(
 	_ context.Context, r *api.SyscallsRequest,
 ) (*api.EmptyResponse, error) {
 	e.syscalls.Delete(r.GetProfile())
+
+	e.pendingSyscallEventsMu.Lock()
+	for id, pending := range e.pendingSyscallEvents {
+		if pending.profile == r.GetProfile() {
+			delete(e.pendingSyscallEvents, id)
+		}
+	}
+	e.pendingSyscallEventsMu.Unlock()
+
+	e.syscallEventsMu.Lock()
+	e.syscallEvents.Delete(r.GetProfile())
+	e.syscallEventsMu.Unlock()
 	return &api.EmptyResponse{}, nil
 }
 
@@ -99,3 +138,54 @@ func (e *Enricher) ResetAvcs(
 	e.avcs.Delete(r.GetProfile())
 	return &api.EmptyResponse{}, nil
 }
+
+// Violations returns the violations observed since they got acknowledged
+// the last time.
+func (e *Enricher) Violations(
+	context.Context, *api.ViolationsRequest,
+) (*api.ViolationsResponse, error) {
+	e.violationsMu.Lock()
+	defer e.violationsMu.Unlock()
+
+	violations := make([]*api.Violation, 0, len(e.violations))
+	for _, violation := range e.violations {
+		clone, ok := proto.Clone(violation).(*api.Violation)
+		if !ok {
+			return nil, errors.New("violation is no violation")
+		}
+		violations = append(violations, clone)
+	}
+
+	return &api.ViolationsResponse{Violations: violations}, nil
+}
+
+// AckViolations removes the provided violations after they got reported.
+// Occurrences counted after the violations were returned by Violations are
+// kept for the next report.
+func (e *Enricher) AckViolations(
+	_ context.Context, r *api.AckViolationsRequest,
+) (*api.EmptyResponse, error) {
+	e.violationsMu.Lock()
+	defer e.violationsMu.Unlock()
+
+	for _, acked := range r.GetViolations() {
+		key, err := violationKey(acked)
+		if err != nil {
+			return nil, fmt.Errorf("get violation key: %w", err)
+		}
+
+		existing, ok := e.violations[key]
+		if !ok {
+			continue
+		}
+
+		existing.Count -= acked.GetCount()
+		if existing.GetCount() <= 0 {
+			delete(e.violations, key)
+			continue
+		}
+		existing.FirstSeen = max(existing.GetFirstSeen(), acked.GetLastSeen())
+	}
+
+	return &api.EmptyResponse{}, nil
+}
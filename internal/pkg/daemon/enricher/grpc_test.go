@@ -0,0 +1,248 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/enricherfakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func TestSyscalls(t *testing.T) {
+	t.Parallel()
+
+	const profile = "profile"
+
+	sut := New(logr.Discard(), "", nil)
+	sut.impl = &enricherfakes.FakeImpl{}
+
+	info := &types.ContainerInfo{RecordProfile: profile}
+	for _, line := range []*types.AuditLine{
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1.000:1",
+			SystemCallID: 157,
+			Arch:         "c000003e",
+			Code:         0x7ffc0000,
+			Action:       "SCMP_ACT_LOG",
+		},
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1.000:2",
+			SystemCallID: 157,
+			Arch:         "c000003e",
+			Code:         0x7ffc0000,
+			Action:       "SCMP_ACT_LOG",
+		},
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1.000:3",
+			SystemCallID: 0,
+			Arch:         "c000003e",
+			Signal:       31,
+			Code:         0x80000000,
+			Action:       "SCMP_ACT_KILL_PROCESS",
+		},
+	} {
+		sut.dispatchSeccompLine(nil, node, line, info)
+	}
+
+	// The SYSCALL records of the same audit events contain the arguments,
+	// while the killed read has none and unrelated records get ignored.
+	for _, line := range []*types.AuditLine{
+		{
+			AuditType:      types.AuditTypeSyscall,
+			TimestampID:    "1.000:1",
+			SystemCallID:   157,
+			SystemCallArgs: []uint64{38, 1, 0, 0},
+			Arch:           "c000003e",
+		},
+		{
+			AuditType:      types.AuditTypeSyscall,
+			TimestampID:    "1.000:2",
+			SystemCallID:   157,
+			SystemCallArgs: []uint64{38, 1, 0, 0},
+			Arch:           "c000003e",
+		},
+		{
+			AuditType:      types.AuditTypeSyscall,
+			TimestampID:    "1.000:4",
+			SystemCallID:   157,
+			SystemCallArgs: []uint64{22, 0, 0, 0},
+			Arch:           "c000003e",
+		},
+	} {
+		sut.addSyscallArgs(line)
+	}
+
+	res, err := sut.Syscalls(context.Background(), &api.SyscallsRequest{Profile: profile})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"prctl", "read"}, res.GetSyscalls())
+	require.Len(t, res.GetEvents(), 2)
+
+	events := map[string]*api.SyscallEvent{}
+	for _, event := range res.GetEvents() {
+		events[event.GetSyscall()] = event
+	}
+	require.Equal(t, []uint64{38, 1, 0, 0}, events["prctl"].GetArgs())
+	require.Equal(t, "SCMP_ACT_LOG", events["prctl"].GetAction())
+	require.Equal(t, "c000003e", events["prctl"].GetArch())
+	require.Empty(t, events["read"].GetArgs())
+	require.Equal(t, "SCMP_ACT_KILL_PROCESS", events["read"].GetAction())
+	require.Equal(t, int32(31), events["read"].GetSignal())
+
+	_, err = sut.ResetSyscalls(context.Background(), &api.SyscallsRequest{Profile: profile})
+	require.NoError(t, err)
+
+	_, err = sut.Syscalls(context.Background(), &api.SyscallsRequest{Profile: profile})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestViolations(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard(), "", nil)
+	sut.impl = &enricherfakes.FakeImpl{}
+
+	info := &types.ContainerInfo{
+		Namespace:     "default",
+		ContainerName: "nginx",
+		WorkloadKind:  "Deployment",
+		WorkloadName:  "nginx",
+	}
+	for _, line := range []*types.AuditLine{
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1624537480.360:8477",
+			SystemCallID: 83,
+			Arch:         "c000003e",
+			Action:       "SCMP_ACT_ERRNO",
+		},
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1624537490.360:8478",
+			SystemCallID: 83,
+			Arch:         "c000003e",
+			Action:       "SCMP_ACT_ERRNO",
+		},
+		{
+			AuditType:    types.AuditTypeSeccomp,
+			TimestampID:  "1624537490.360:8479",
+			SystemCallID: 0,
+			Arch:         "c000003e",
+			Action:       "SCMP_ACT_LOG",
+		},
+	} {
+		sut.dispatchSeccompLine(nil, node, line, info)
+	}
+	sut.dispatchApparmorLine(node, &types.AuditLine{
+		AuditType:   types.AuditTypeApparmor,
+		TimestampID: "1624537500.000:8480",
+		Apparmor:    "DENIED",
+		Operation:   "open",
+		Profile:     "nginx-profile",
+		Name:        "/etc/shadow",
+		ExtraInfo:   "requested_mask='r' denied_mask='r' fsuid=0 ouid=0",
+	}, info)
+
+	res, err := sut.Violations(context.Background(), &api.ViolationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.GetViolations(), 2)
+
+	violations := map[api.Violation_Type]*api.Violation{}
+	for _, violation := range res.GetViolations() {
+		violations[violation.GetType()] = violation
+	}
+
+	seccompViolation := violations[api.Violation_SECCOMP]
+	require.Equal(t, "mkdir", seccompViolation.GetSyscall())
+	require.Equal(t, "Deployment", seccompViolation.GetWorkloadKind())
+	require.Equal(t, "nginx", seccompViolation.GetWorkloadName())
+	require.EqualValues(t, 2, seccompViolation.GetCount())
+	require.EqualValues(t, 1624537480, seccompViolation.GetFirstSeen())
+	require.EqualValues(t, 1624537490, seccompViolation.GetLastSeen())
+
+	apparmorViolation := violations[api.Violation_APPARMOR]
+	require.Equal(t, "open", apparmorViolation.GetOperation())
+	require.Equal(t, "/etc/shadow", apparmorViolation.GetName())
+	require.Equal(t, "r", apparmorViolation.GetDeniedMask())
+	require.EqualValues(t, 1, apparmorViolation.GetCount())
+
+	// Violations are kept until they got acknowledged
+	unacked, err := sut.Violations(context.Background(), &api.ViolationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, unacked.GetViolations(), 2)
+
+	sut.dispatchSeccompLine(nil, node, &types.AuditLine{
+		AuditType:    types.AuditTypeSeccomp,
+		TimestampID:  "1624537495.360:8481",
+		SystemCallID: 83,
+		Arch:         "c000003e",
+		Action:       "SCMP_ACT_ERRNO",
+	}, info)
+
+	_, err = sut.AckViolations(context.Background(), &api.AckViolationsRequest{
+		Violations: res.GetViolations(),
+	})
+	require.NoError(t, err)
+
+	res, err = sut.Violations(context.Background(), &api.ViolationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.GetViolations(), 1)
+	require.Equal(t, "mkdir", res.GetViolations()[0].GetSyscall())
+	require.EqualValues(t, 1, res.GetViolations()[0].GetCount())
+	require.EqualValues(t, 1624537495, res.GetViolations()[0].GetLastSeen())
+
+	_, err = sut.AckViolations(context.Background(), &api.AckViolationsRequest{
+		Violations: res.GetViolations(),
+	})
+	require.NoError(t, err)
+
+	res, err = sut.Violations(context.Background(), &api.ViolationsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.GetViolations())
+}
+
+func TestIsReportedSeccompAction(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		action, profile string
+		expected        bool
+	}{
+		{"SCMP_ACT_ERRNO", "", true},
+		{"SCMP_ACT_KILL_PROCESS", "operator/default/profile.json", true},
+		{"SCMP_ACT_ALLOW", "operator/default/profile.json", false},
+		{"SCMP_ACT_LOG", "operator/default/profile.json", true},
+		{"SCMP_ACT_LOG", "custom/profile.json", false},
+		{"SCMP_ACT_LOG", "RuntimeDefault", false},
+		{"", "operator/default/profile.json", false},
+	} {
+		require.Equal(t, tc.expected, isReportedSeccompAction(tc.action, tc.profile), "%s %s", tc.action, tc.profile)
+	}
+}
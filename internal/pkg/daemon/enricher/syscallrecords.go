@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// syscallRecordTimeout is the time to wait for the SYSCALL record of a
+// recorded seccomp event. The kernel emits the SYSCALL record on exit of the
+// syscall, which means that it usually follows the seccomp record directly.
+const syscallRecordTimeout = 5 * time.Second
+
+// pendingSyscallEvent is a recorded seccomp event which waits for the
+// arguments of the SYSCALL record of the same audit event.
+type pendingSyscallEvent struct {
+	profile   string
+	syscallID int32
+	event     *apienricher.SyscallEvent
+	received  time.Time
+}
+
+// addPendingSyscallEvent holds back the event of the seccomp record until
+// the SYSCALL record of the same audit event arrives.
+func (e *Enricher) addPendingSyscallEvent(
+	auditLine *types.AuditLine, profile string, event *apienricher.SyscallEvent,
+) {
+	e.pendingSyscallEventsMu.Lock()
+	defer e.pendingSyscallEventsMu.Unlock()
+
+	e.pendingSyscallEvents[auditLine.TimestampID] = &pendingSyscallEvent{
+		profile:   profile,
+		syscallID: auditLine.SystemCallID,
+		event:     event,
+		received:  time.Now(),
+	}
+}
+
+// addSyscallArgs adds the arguments of the SYSCALL record to the pending
+// seccomp event of the same audit event and stores it. SYSCALL records of
+// syscalls which are not recorded get ignored.
+func (e *Enricher) addSyscallArgs(auditLine *types.AuditLine) {
+	e.pendingSyscallEventsMu.Lock()
+	pending, ok := e.pendingSyscallEvents[auditLine.TimestampID]
+	if ok {
+		delete(e.pendingSyscallEvents, auditLine.TimestampID)
+	}
+	e.pendingSyscallEventsMu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	if pending.syscallID == auditLine.SystemCallID && pending.event.GetArch() == auditLine.Arch {
+		pending.event.Args = auditLine.SystemCallArgs
+	}
+	e.storeSyscallEvent(pending.profile, pending.event)
+}
+
+// flushPendingSyscallEvents stores all pending events selected by the filter
+// without arguments.
+func (e *Enricher) flushPendingSyscallEvents(filter func(*pendingSyscallEvent) bool) {
+	e.pendingSyscallEventsMu.Lock()
+	flushed := []*pendingSyscallEvent{}
+	for id, pending := range e.pendingSyscallEvents {
+		if filter(pending) {
+			flushed = append(flushed, pending)
+			delete(e.pendingSyscallEvents, id)
+		}
+	}
+	e.pendingSyscallEventsMu.Unlock()
+
+	for _, pending := range flushed {
+		e.storeSyscallEvent(pending.profile, pending.event)
+	}
+}
+
+// flushExpiredSyscallEvents stores the pending events whose SYSCALL record
+// did not arrive in time without arguments. This is for example the case if
+// auditing is disabled.
+func (e *Enricher) flushExpiredSyscallEvents() {
+	e.flushPendingSyscallEvents(func(pending *pendingSyscallEvent) bool {
+		return time.Since(pending.received) > syscallRecordTimeout
+	})
+}
+
+// storeSyscallEvent stores the event for the recorded profile.
+func (e *Enricher) storeSyscallEvent(profile string, event *apienricher.SyscallEvent) {
+	jsonBytes, err := protojson.Marshal(event)
+	if err != nil {
+		e.logger.Error(err, "marshall protobuf")
+		return
+	}
+
+	e.syscallEventsMu.Lock()
+	defer e.syscallEventsMu.Unlock()
+
+	ev, _ := e.syscallEvents.LoadOrStore(profile, sets.New[string]())
+	eventSet, ok := ev.(sets.Set[string])
+	if ok {
+		eventSet.Insert(string(jsonBytes))
+	}
+}
@@ -20,6 +20,9 @@ const (
 	AuditTypeSeccomp  = "seccomp"
 	AuditTypeSelinux  = "selinux"
 	AuditTypeApparmor = "apparmor"
+	// AuditTypeSyscall is a SYSCALL record, which only complements the
+	// seccomp record of the same audit event.
+	AuditTypeSyscall = "syscall"
 )
 
 type AuditLine struct {
@@ -32,6 +35,22 @@ type AuditLine struct {
 	// seccomp
 	SystemCallID int32
 	Executable   string
+	// SystemCallArgs are the first four syscall arguments (a0..a3), which
+	// are only part of SYSCALL records.
+	SystemCallArgs []uint64
+	// Arch is the audit architecture, for example c000003e for x86_64.
+	Arch string
+	// Signal is the signal sent to the process (sig).
+	Signal int
+	// Code is the raw seccomp action return value (code).
+	Code uint32
+	// Action is the seccomp action derived from Code, for example
+	// SCMP_ACT_LOG or SCMP_ACT_KILL_PROCESS.
+	Action string
+	// UID is the user ID of the process.
+	UID uint32
+	// AUID is the audit (login) user ID of the process.
+	AUID uint32
 
 	// selinux
 	Scontext string
@@ -57,4 +76,11 @@ type ContainerInfo struct {
 	Namespace     string
 	ContainerID   string
 	RecordProfile string
+	// WorkloadKind and WorkloadName identify the top level controller of
+	// the pod, or the pod itself if it is not controlled.
+	WorkloadKind string
+	WorkloadName string
+	// SeccompProfile is the seccomp profile the container runs with, which
+	// is either the localhost profile path or the profile type.
+	SeccompProfile string
 }
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/common/pkg/seccomp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	apienricher "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+// maxViolations is the maximum number of distinct violations kept until they
+// get acknowledged. Further occurrences of known violations are still
+// counted.
+const maxViolations = 10000
+
+// apparmorDenied is the apparmor field value of denied operations.
+const apparmorDenied = "DENIED"
+
+// deniedMaskRegex matches the denied access mask within the extra info of an
+// AppArmor audit line.
+var deniedMaskRegex = regexp.MustCompile(`denied_mask='([^']*)'`)
+
+// newViolation returns the violation with the workload fields of the
+// container set.
+func newViolation(
+	violationType apienricher.Violation_Type,
+	info *types.ContainerInfo,
+) *apienricher.Violation {
+	return &apienricher.Violation{
+		Type:           violationType,
+		Namespace:      info.Namespace,
+		WorkloadKind:   info.WorkloadKind,
+		WorkloadName:   info.WorkloadName,
+		Container:      info.ContainerName,
+		SeccompProfile: info.SeccompProfile,
+	}
+}
+
+// isDeniedSeccompAction returns true if the seccomp action did not allow the
+// system call.
+func isDeniedSeccompAction(action string) bool {
+	switch seccomp.Action(action) {
+	case "", seccomp.ActLog, seccomp.ActAllow:
+		return false
+	default:
+		return true
+	}
+}
+
+// isReportedSeccompAction returns true if the seccomp action has to be
+// reported as violation. Logged system calls of operator managed profiles are
+// reported as well, because they may be denials of profiles in complain mode.
+func isReportedSeccompAction(action, seccompProfile string) bool {
+	if isDeniedSeccompAction(action) {
+		return true
+	}
+	return seccomp.Action(action) == seccomp.ActLog &&
+		strings.HasPrefix(seccompProfile, config.OperatorProfilesFolder+"/")
+}
+
+// apparmorDeniedMask returns the denied access mask of an AppArmor audit
+// line, like "r" or "wc".
+func apparmorDeniedMask(extraInfo string) string {
+	captures := deniedMaskRegex.FindStringSubmatch(extraInfo)
+	if len(captures) < 2 {
+		return ""
+	}
+	return captures[1]
+}
+
+// violationKey returns the key of the violation which is independent of its
+// counters.
+func violationKey(violation *apienricher.Violation) (string, error) {
+	keyViolation, ok := proto.Clone(violation).(*apienricher.Violation)
+	if !ok {
+		return "", errors.New("violation is no violation")
+	}
+	keyViolation.Count = 0
+	keyViolation.FirstSeen = 0
+	keyViolation.LastSeen = 0
+
+	key, err := protojson.Marshal(keyViolation)
+	if err != nil {
+		return "", fmt.Errorf("marshall protobuf: %w", err)
+	}
+	return string(key), nil
+}
+
+// recordViolation counts the violation observed at the audit timestamp.
+func (e *Enricher) recordViolation(violation *apienricher.Violation, timestampID string) {
+	key, err := violationKey(violation)
+	if err != nil {
+		e.logger.Error(err, "get violation key")
+		return
+	}
+	seen := auditTime(timestampID).Unix()
+
+	e.violationsMu.Lock()
+	defer e.violationsMu.Unlock()
+
+	existing, ok := e.violations[key]
+	if !ok {
+		if len(e.violations) >= maxViolations {
+			e.logger.V(config.VerboseLevel).Info("Dropping violation because of too many distinct violations")
+			return
+		}
+		violation.FirstSeen = seen
+		violation.LastSeen = seen
+		violation.Count = 1
+		e.violations[key] = violation
+		return
+	}
+
+	existing.Count++
+	existing.FirstSeen = min(existing.FirstSeen, seen)
+	existing.LastSeen = max(existing.LastSeen, seen)
+}
+
+// auditTime parses the time of an audit timestamp ID like
+// "1624537480.360:8477" and falls back to the current time.
+func auditTime(timestampID string) time.Time {
+	timestamp, _, _ := strings.Cut(timestampID, ":")
+	seconds, err := strconv.ParseFloat(timestamp, 64)
+	if err != nil {
+		return time.Now()
+	}
+	return time.UnixMilli(int64(seconds * float64(time.Second/time.Millisecond)))
+}
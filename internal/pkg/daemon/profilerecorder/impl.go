@@ -25,9 +25,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -50,7 +52,7 @@ type impl interface {
 	ClientGet(context.Context, client.Client, client.ObjectKey, client.Object) error
 	NewControllerManagedBy(
 		manager.Manager, string, func(obj runtime.Object) bool,
-		func(obj runtime.Object) bool, reconcile.Reconciler) error
+		func(obj runtime.Object) bool, handler.EventHandler, reconcile.Reconciler) error
 	ManagerGetClient(manager.Manager) client.Client
 	ManagerGetEventRecorderFor(manager.Manager, string) record.EventRecorder
 	GetPod(context.Context, client.Client, client.ObjectKey) (*corev1.Pod, error)
@@ -81,6 +83,7 @@ type impl interface {
 	) error
 	DialEnricher() (*grpc.ClientConn, context.CancelFunc, error)
 	GetRecording(context.Context, client.Client, client.ObjectKey) (*profilerecording1alpha1.ProfileRecording, error)
+	UpdateRecordingStatus(context.Context, client.Client, *profilerecording1alpha1.ProfileRecording) error
 	ApparmorForProfile(
 		context.Context,
 		bpfrecorderapi.BpfRecorderClient,
@@ -103,17 +106,18 @@ func (*defaultImpl) NewControllerManagedBy(
 	name string,
 	p1 func(obj runtime.Object) bool,
 	p2 func(obj runtime.Object) bool,
+	recordings handler.EventHandler,
 	r reconcile.Reconciler,
 ) error {
 	return ctrl.NewControllerManagedBy(m).
 		Named(name).
-		WithEventFilter(predicate.Funcs{
+		For(&corev1.Pod{}, builder.WithPredicates(predicate.Funcs{
 			CreateFunc:  func(e event.CreateEvent) bool { return p1(e.Object) && p2(e.Object) },
 			DeleteFunc:  func(e event.DeleteEvent) bool { return p1(e.Object) && p2(e.Object) },
 			UpdateFunc:  func(e event.UpdateEvent) bool { return p1(e.ObjectNew) && p2(e.ObjectNew) },
 			GenericFunc: func(e event.GenericEvent) bool { return p1(e.Object) && p2(e.Object) },
-		}).
-		For(&corev1.Pod{}).
+		})).
+		Watches(&profilerecording1alpha1.ProfileRecording{}, recordings).
 		Complete(r)
 }
 
@@ -228,3 +232,11 @@ func (*defaultImpl) GetRecording(
 	err := cli.Get(ctx, key, &recording)
 	return &recording, err
 }
+
+func (*defaultImpl) UpdateRecordingStatus(
+	ctx context.Context,
+	cli client.Client,
+	recording *profilerecording1alpha1.ProfileRecording,
+) error {
+	return cli.Status().Update(ctx, recording)
+}
@@ -43,6 +43,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
@@ -94,6 +95,7 @@ type RecorderReconciler struct {
 	log           logr.Logger
 	record        record.EventRecorder
 	nodeAddresses []string
+	nodeName      string
 	podsToWatch   sync.Map
 }
 
@@ -106,6 +108,9 @@ type podToWatch struct {
 	baseName types.NamespacedName
 	recorder profilerecording1alpha1.ProfileRecorder
 	profiles []profileToCollect
+	// lastSnapshot is the time when the profiles got collected last while
+	// the pod was still running.
+	lastSnapshot time.Time
 }
 
 // Name returns the name of the controller.
@@ -120,6 +125,7 @@ func (r *RecorderReconciler) SchemeBuilder() *scheme.Builder {
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 
 // Setup is the initialization of the controller.
 func (r *RecorderReconciler) Setup(
@@ -156,13 +162,54 @@ func (r *RecorderReconciler) Setup(
 
 	r.client = r.ManagerGetClient(mgr)
 	r.nodeAddresses = nodeAddresses
+	r.nodeName = node.Name
 	r.record = r.ManagerGetEventRecorderFor(mgr, name)
 
 	return r.NewControllerManagedBy(
-		mgr, name, r.isPodWithTraceAnnotation, r.isPodOnLocalNode, r,
+		mgr, name, r.isPodWithTraceAnnotation, r.isPodOnLocalNode,
+		handler.EnqueueRequestsFromMapFunc(r.podsOfFinishedRecording), r,
 	)
 }
 
+// podsOfFinishedRecording returns the tracked pods of a recording which got
+// finished by one of its stop conditions, to collect their profiles.
+func (r *RecorderReconciler) podsOfFinishedRecording(_ context.Context, obj client.Object) []reconcile.Request {
+	recording, ok := obj.(*profilerecording1alpha1.ProfileRecording)
+	if !ok || !recording.IsFinished() {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	r.podsToWatch.Range(func(key, value any) bool {
+		podKey, ok := key.(string)
+		if !ok {
+			return true
+		}
+
+		podToWatch, ok := value.(podToWatch)
+		if !ok || len(podToWatch.profiles) == 0 {
+			return true
+		}
+
+		namespace, name, found := strings.Cut(podKey, string(types.Separator))
+		if !found || namespace != recording.Namespace {
+			return true
+		}
+
+		parsedProfileAnnotation, err := parseProfileAnnotation(podToWatch.profiles[0].name)
+		if err != nil || parsedProfileAnnotation.profileName != recording.Name {
+			return true
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: namespace, Name: name},
+		})
+		return true
+	})
+
+	return requests
+}
+
 func (r *RecorderReconciler) getSPOD(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
 	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
@@ -293,11 +340,22 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 
 		r.podsToWatch.Store(
 			req.NamespacedName.String(),
-			podToWatch{baseName, recorder, profiles},
+			podToWatch{baseName, recorder, profiles, time.Now()},
 		)
 		r.record.Event(pod, util.EventTypeNormal, reasonProfileRecording, "Recording profiles")
 	}
 
+	if pod.Status.Phase == corev1.PodRunning {
+		res, snapshotErr := r.snapshotProfiles(ctx, req.NamespacedName)
+		if errors.Is(snapshotErr, errNameNotValid) {
+			logger.Error(snapshotErr, "cannot snapshot profile")
+			return reconcile.Result{}, nil
+		} else if snapshotErr != nil {
+			return reconcile.Result{}, fmt.Errorf("snapshot profile for running pod: %w", snapshotErr)
+		}
+		return res, nil
+	}
+
 	if pod.Status.Phase == corev1.PodSucceeded {
 		collErr := r.collectProfile(ctx, req.NamespacedName)
 		if errors.Is(collErr, errNameNotValid) {
@@ -381,6 +439,112 @@ func (r *RecorderReconciler) collectProfile(
 		return errors.New("type assert pod to watch")
 	}
 
+	if err := r.collect(ctx, podName, &podToWatch, false); err != nil {
+		return err
+	}
+
+	r.podsToWatch.Delete(n)
+	return nil
+}
+
+// snapshotProfiles updates the profiles of a running pod if its recording is
+// in the continuous mode, and requeues the pod for the next snapshot. The
+// profiles get collected a final time if the recording got finished.
+func (r *RecorderReconciler) snapshotProfiles(
+	ctx context.Context, podName types.NamespacedName,
+) (reconcile.Result, error) {
+	n := podName.String()
+
+	value, ok := r.podsToWatch.Load(n)
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return reconcile.Result{}, errors.New("type assert pod to watch")
+	}
+
+	parsedProfileAnnotation, err := parseProfileAnnotation(podToWatch.profiles[0].name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("parse profile raw annotation: %w", err)
+	}
+
+	recording, err := r.GetRecording(ctx, r.client, types.NamespacedName{
+		Name: parsedProfileAnnotation.profileName, Namespace: podName.Namespace,
+	})
+	if err != nil || recording == nil {
+		return reconcile.Result{}, nil //nolint:nilerr // the recording may be gone already
+	}
+
+	if recording.IsFinished() {
+		r.log.Info("Recording finished, collecting profiles", "pod", n, "reason", recording.Status.StopReason)
+		return reconcile.Result{}, r.collectProfile(ctx, podName)
+	}
+
+	if !recording.IsContinuous() {
+		return reconcile.Result{}, nil
+	}
+
+	interval := recording.GetSnapshotInterval()
+	if wait := time.Until(podToWatch.lastSnapshot.Add(interval)); wait > 0 {
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	r.log.Info("Taking snapshot of recorded profiles", "pod", n)
+	if err := r.collect(ctx, podName, &podToWatch, true); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	podToWatch.lastSnapshot = time.Now()
+	r.podsToWatch.Store(n, podToWatch)
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// collect creates or updates the recorded profiles of a pod and reports them
+// in the status of their recordings. A snapshot keeps the recorded data, so
+// that it is part of all following collections.
+func (r *RecorderReconciler) collect(
+	ctx context.Context, podName types.NamespacedName, podToWatch *podToWatch, snapshot bool,
+) error {
+	r.updateRecordingStatus(ctx, podName.Namespace, podToWatch.profiles,
+		func(_ string, status *profilerecording1alpha1.ProfileRecordingStatus) {
+			status.SetConditions(profilerecording1alpha1.CollectingCondition(podName.Name))
+		})
+
+	replicaSuffix, err := r.collectProfiles(ctx, podName, podToWatch, snapshot)
+	if err != nil {
+		r.updateRecordingStatus(ctx, podName.Namespace, podToWatch.profiles,
+			func(_ string, status *profilerecording1alpha1.ProfileRecordingStatus) {
+				status.LastError = err.Error()
+				status.SetConditions(
+					profilerecording1alpha1.CollectedCondition(podName.Name),
+					profilerecording1alpha1.FailedCondition(profilerecording1alpha1.ReasonCollectionFailed, err),
+				)
+			})
+		return err
+	}
+
+	recorded := r.recordedProfiles(ctx, replicaSuffix, podName, podToWatch.profiles)
+	r.updateRecordingStatus(ctx, podName.Namespace, podToWatch.profiles,
+		func(recordingName string, status *profilerecording1alpha1.ProfileRecordingStatus) {
+			for _, profile := range recorded[recordingName] {
+				status.SetRecordedProfile(profile)
+			}
+			status.SetConditions(
+				profilerecording1alpha1.CollectedCondition(podName.Name),
+				profilerecording1alpha1.SucceededCondition(profilerecording1alpha1.ReasonCollected),
+			)
+		})
+
+	return nil
+}
+
+// collectProfiles creates or updates the recorded profiles of a pod and
+// returns the replica suffix of their names.
+func (r *RecorderReconciler) collectProfiles(
+	ctx context.Context, podName types.NamespacedName, podToWatch *podToWatch, snapshot bool,
+) (string, error) {
 	replicaSuffix := ""
 	if podToWatch.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, podToWatch.baseName.Name) {
 		// this is a replica, we need to strip the suffix from the pod name
@@ -389,22 +553,21 @@ func (r *RecorderReconciler) collectProfile(
 
 	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderLogs {
 		if err := r.collectLogProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+			ctx, replicaSuffix, podName, podToWatch.profiles, snapshot,
 		); err != nil {
-			return fmt.Errorf("collect log profile: %w", err)
+			return "", fmt.Errorf("collect log profile: %w", err)
 		}
 	}
 
 	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
 		if err := r.collectBpfProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+			ctx, replicaSuffix, podName, podToWatch.profiles, snapshot,
 		); err != nil {
-			return fmt.Errorf("collect bpf profile: %w", err)
+			return "", fmt.Errorf("collect bpf profile: %w", err)
 		}
 	}
 
-	r.podsToWatch.Delete(n)
-	return nil
+	return replicaSuffix, nil
 }
 
 func (r *RecorderReconciler) collectLogProfiles(
@@ -412,6 +575,7 @@ func (r *RecorderReconciler) collectLogProfiles(
 	replicaSuffix string,
 	podName types.NamespacedName,
 	profiles []profileToCollect,
+	snapshot bool,
 ) error {
 	r.log.Info("Checking if enricher is enabled")
 
@@ -450,9 +614,11 @@ func (r *RecorderReconciler) collectLogProfiles(
 
 		switch prf.kind {
 		case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-			err = r.collectLogSeccompProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			err = r.collectLogSeccompProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name, snapshot)
 		case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
-			err = r.collectLogSelinuxProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			err = r.collectLogSelinuxProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name, snapshot)
 		case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
 			err = errors.New("log recorder doesn't support apparmor profile recording")
 		default:
@@ -473,6 +639,7 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
 	profileID string,
+	snapshot bool,
 ) error {
 	labels, err := profileLabels(
 		ctx,
@@ -512,13 +679,13 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		return fmt.Errorf("get seccomp arch: %w", err)
 	}
 
+	recordSyscallArgs := r.recordSyscallArgs(ctx, parsedProfileName.profileName, profileNamespacedName.Namespace)
 	profileSpec := seccompprofileapi.SeccompProfileSpec{
 		DefaultAction: seccomp.ActErrno,
 		Architectures: []seccompprofileapi.Arch{arch},
-		Syscalls: []*seccompprofileapi.Syscall{{
-			Action: seccomp.ActAllow,
-			Names:  response.GetSyscalls(),
-		}},
+		Syscalls: bpfrecorder.SyscallRules(
+			response.GetSyscalls(), syscallArgsFromEvents(response.GetEvents()), recordSyscallArgs,
+		),
 	}
 
 	profile := &seccompprofileapi.SeccompProfile{
@@ -530,6 +697,12 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		Spec: profileSpec,
 	}
 
+	if denied := deniedSyscalls(response.GetEvents()); len(denied) > 0 {
+		r.log.Info("Syscalls got denied during recording", "profile", profileNamespacedName, "syscalls", denied)
+		r.record.Event(profile, util.EventTypeWarning, reasonProfileRecording,
+			"syscalls denied during recording: "+strings.Join(denied, ", "))
+	}
+
 	if err := r.setDisabled(ctx, r.client,
 		parsedProfileName.profileName, profileNamespacedName.Namespace,
 		&profileSpec.SpecBase); err != nil {
@@ -553,6 +726,11 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	r.log.Info("Created/updated profile", "action", res, "name", profileNamespacedName.Name)
 	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
 
+	if snapshot {
+		// Keep the syscalls for the next snapshot
+		return nil
+	}
+
 	// Reset the syscalls for further recordings
 	if err := r.ResetSyscalls(ctx, enricherClient, request); err != nil {
 		return fmt.Errorf("reset syscalls for profile %s: %w", profileID, err)
@@ -567,6 +745,7 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
 	profileID string,
+	snapshot bool,
 ) error {
 	labels, err := profileLabels(
 		ctx,
@@ -649,6 +828,11 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	r.log.Info("Created/updated selinux profile", "action", res, "name", profileNamespacedName)
 	r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "selinuxprofile profile created")
 
+	if snapshot {
+		// Keep the AVCs for the next snapshot
+		return nil
+	}
+
 	// Reset the selinuxprofile for further recordings
 	if err := r.ResetAvcs(ctx, enricherClient, request); err != nil {
 		return fmt.Errorf("reset selinuxprofile for profile %s: %w", profileNamespacedName, err)
@@ -680,6 +864,7 @@ func (r *RecorderReconciler) collectBpfProfiles(
 	replicaSuffix string,
 	podName types.NamespacedName,
 	profiles []profileToCollect,
+	snapshot bool,
 ) error {
 	recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
@@ -720,7 +905,8 @@ func (r *RecorderReconciler) collectBpfProfiles(
 
 		switch profileToCollect.kind {
 		case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-			seccompProfile, err := r.collectSeccompBpfProfile(ctx, recorderClient, &ptc, profileNamespacedName, labels)
+			seccompProfile, err := r.collectSeccompBpfProfile(
+				ctx, recorderClient, &ptc, parsedProfileName.profileName, profileNamespacedName, labels, snapshot)
 			if err != nil {
 				// skip empty profiles
 				if errors.Is(err, errRecordedProfileNotFound) {
@@ -734,7 +920,8 @@ func (r *RecorderReconciler) collectBpfProfiles(
 				return fmt.Errorf("creating/updating seccomp profile %s: %w", profileToCollect.name, err)
 			}
 		case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
-			apparmorProfile, err := r.collectApparmorBpfProfile(ctx, recorderClient, &ptc, profileNamespacedName, labels)
+			apparmorProfile, err := r.collectApparmorBpfProfile(
+				ctx, recorderClient, &ptc, profileNamespacedName, labels, snapshot)
 			if err != nil {
 				// skip empty profiles
 				if errors.Is(err, errRecordedProfileNotFound) {
@@ -753,6 +940,11 @@ func (r *RecorderReconciler) collectBpfProfiles(
 		}
 	}
 
+	if snapshot {
+		// The recording continues
+		return nil
+	}
+
 	if err := r.stopBpfRecorder(ctx); err != nil {
 		r.log.Error(err, "Unable to stop bpf recorder")
 		return fmt.Errorf("stop bpf recorder: %w", err)
@@ -764,11 +956,13 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 	ctx context.Context,
 	recorderClient bpfrecorderapi.BpfRecorderClient,
 	profileToCollect *profileToCollect,
+	profileRecordingName string,
 	profileNamespacedName types.NamespacedName,
 	profileLabels map[string]string,
+	keep bool,
 ) (*seccompprofileapi.SeccompProfile, error) {
 	response, err := r.SyscallsForProfile(
-		ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profileToCollect.name},
+		ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profileToCollect.name, Keep: keep},
 	)
 	if err != nil {
 		// Recording was not found for this profile, this might be an init container
@@ -786,13 +980,13 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 		return nil, fmt.Errorf("getting seccomp arch: %w", err)
 	}
 
+	recordSyscallArgs := r.recordSyscallArgs(ctx, profileRecordingName, profileNamespacedName.Namespace)
 	profileSpec := seccompprofileapi.SeccompProfileSpec{
 		DefaultAction: seccomp.ActErrno,
 		Architectures: []seccompprofileapi.Arch{arch},
-		Syscalls: []*seccompprofileapi.Syscall{{
-			Action: seccomp.ActAllow,
-			Names:  response.GetSyscalls(),
-		}},
+		Syscalls: bpfrecorder.SyscallRules(
+			response.GetSyscalls(), response.GetArgs(), recordSyscallArgs,
+		),
 	}
 
 	profile := &seccompprofileapi.SeccompProfile{
@@ -807,6 +1001,57 @@ func (r *RecorderReconciler) collectSeccompBpfProfile(
 	return profile, nil
 }
 
+// recordSyscallArgs returns the syscalls for which the recorded argument
+// values should be part of the profile.
+func (r *RecorderReconciler) recordSyscallArgs(
+	ctx context.Context, profileRecordingName, namespace string,
+) []string {
+	recording, err := r.GetRecording(ctx, r.client, types.NamespacedName{Name: profileRecordingName, Namespace: namespace})
+	if err != nil || recording == nil {
+		r.log.Info("Cannot get recording for syscall args, recording syscalls only",
+			"name", profileRecordingName, "namespace", namespace)
+		return nil
+	}
+	return recording.Spec.RecordSyscallArgs
+}
+
+// syscallArgsFromEvents groups the syscall arguments of the enricher events
+// by their syscall name. Syscalls with at least one event without arguments
+// are skipped, because the SYSCALL record of the event was missing and
+// filtering on the partial set would deny legit invocations.
+func syscallArgsFromEvents(events []*enricherapi.SyscallEvent) []*bpfrecorderapi.SyscallArgs {
+	observed := map[string][]*bpfrecorderapi.SyscallArgs_Values{}
+	incomplete := sets.New[string]()
+	for _, event := range events {
+		if len(event.GetArgs()) == 0 {
+			incomplete.Insert(event.GetSyscall())
+			continue
+		}
+		observed[event.GetSyscall()] = append(observed[event.GetSyscall()],
+			&bpfrecorderapi.SyscallArgs_Values{Values: event.GetArgs()})
+	}
+
+	res := []*bpfrecorderapi.SyscallArgs{}
+	for _, name := range sets.List(sets.KeySet(observed).Difference(incomplete)) {
+		res = append(res, &bpfrecorderapi.SyscallArgs{Name: name, Observed: observed[name]})
+	}
+	return res
+}
+
+// deniedSyscalls returns the sorted names of all syscalls which have not
+// only been logged but actually blocked by seccomp during the recording.
+func deniedSyscalls(events []*enricherapi.SyscallEvent) []string {
+	denied := sets.New[string]()
+	for _, event := range events {
+		switch event.GetAction() {
+		case "", string(seccomp.ActLog), string(seccomp.ActAllow):
+		default:
+			denied.Insert(event.GetSyscall())
+		}
+	}
+	return sets.List(denied)
+}
+
 //nolint:dupl // This requires a specific profile type which prevents the reducton of duplicated code
 func (r *RecorderReconciler) updateOrCreateSeccompResource(
 	ctx context.Context,
@@ -849,9 +1094,10 @@ func (r *RecorderReconciler) collectApparmorBpfProfile(
 	profileToCollect *profileToCollect,
 	profileNamespacedName types.NamespacedName,
 	profileLabels map[string]string,
+	keep bool,
 ) (*apparmorprofileapi.AppArmorProfile, error) {
 	response, err := r.ApparmorForProfile(
-		ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profileToCollect.name},
+		ctx, recorderClient, &bpfrecorderapi.ProfileRequest{Name: profileToCollect.name, Keep: keep},
 	)
 	if err != nil {
 		// Recording was not found for this profile, this might be an init container
@@ -942,6 +1188,10 @@ func (r *RecorderReconciler) generateAppArmorProfileAbstract(
 		abstract.Network = &net
 	}
 
+	for _, unixType := range response.GetSocket().GetUnixTypes() {
+		abstract.Unix = append(abstract.Unix, apparmorprofileapi.AppArmorUnixRule{Type: unixType})
+	}
+
 	if len(response.GetCapabilities()) != 0 {
 		capabilities := apparmorprofileapi.AppArmorCapabilityRules{}
 		capabilities.AllowedCapabilities = response.GetCapabilities()
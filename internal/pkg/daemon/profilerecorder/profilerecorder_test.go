@@ -38,9 +38,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
 	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
 	recordingapi "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
@@ -246,6 +248,71 @@ func TestReconcile(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{ // seccomp BPF success collect with syscall args
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderBpf,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(
+					&bpfrecorderapi.SyscallsResponse{
+						Syscalls: []string{"mkdir", "prctl"},
+						GoArch:   runtime.GOARCH,
+						Args: []*bpfrecorderapi.SyscallArgs{{
+							Name: "prctl",
+							Observed: []*bpfrecorderapi.SyscallArgs_Values{
+								{Values: []uint64{38, 0, 0, 0, 0, 0}},
+							},
+						}},
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.NoError(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Len(t, profile.Spec.Syscalls, 2)
+					assert.Equal(t, []string{"mkdir"}, profile.Spec.Syscalls[0].Names)
+					assert.Equal(t, []string{"prctl"}, profile.Spec.Syscalls[1].Names)
+					assert.Len(t, profile.Spec.Syscalls[1].Args, 1)
+					assert.Equal(t, uint64(38), profile.Spec.Syscalls[1].Args[0].Value)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						RecordSyscallArgs: []string{"prctl"},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NoError(t, err)
+			},
+		},
 		{ //nolint:dupl // test duplicates are fine
 			// seccomp BPF GoArchToSeccompArch fails
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
@@ -635,7 +702,8 @@ func TestReconcile(t *testing.T) {
 							AllowedExecutables: []string{"/usr/bin/test"},
 						},
 						Socket: &bpfrecorderapi.ApparmorResponse_Socket{
-							UseTcp: true,
+							UseTcp:    true,
+							UnixTypes: []string{"stream"},
 						},
 						Capabilities: []string{"test-cap"},
 					}, nil,
@@ -648,6 +716,10 @@ func TestReconcile(t *testing.T) {
 				) (controllerutil.OperationResult, error) {
 					err := f()
 					assert.NoError(t, err)
+					profile, ok := obj.(*apparmorprofileapi.AppArmorProfile)
+					assert.True(t, ok)
+					assert.Equal(t, []apparmorprofileapi.AppArmorUnixRule{{Type: "stream"}},
+						profile.Spec.Abstract.Unix)
 					return "", nil
 				})
 				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
@@ -1102,6 +1174,74 @@ func TestReconcile(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{ // logs seccomp success collect with syscall args
+			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
+				profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+				value := podToWatch{
+					recorder: recordingapi.ProfileRecorderLogs,
+					profiles: []profileToCollect{
+						{
+							kind: recordingapi.ProfileRecordingKindSeccompProfile,
+							name: profileName,
+						},
+					},
+				}
+				sut.podsToWatch.Store(testRequest.NamespacedName.String(), value)
+
+				mock.GetPodReturns(&corev1.Pod{
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							config.SeccompProfileRecordLogsAnnotationKey: profileName,
+						},
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.SyscallsReturns(
+					&enricherapi.SyscallsResponse{
+						Syscalls: []string{"mkdir", "prctl", "socket"},
+						GoArch:   runtime.GOARCH,
+						Events: []*enricherapi.SyscallEvent{
+							{Syscall: "prctl", Args: []uint64{38, 1, 0, 0}, Action: "SCMP_ACT_LOG"},
+							{Syscall: "prctl", Args: []uint64{38, 0, 0, 0}, Action: "SCMP_ACT_LOG"},
+							{Syscall: "socket", Args: []uint64{2, 0x80001, 0, 0}, Action: "SCMP_ACT_LOG"},
+							{Syscall: "socket", Action: "SCMP_ACT_KILL_PROCESS"},
+						},
+					}, nil,
+				)
+				mock.CreateOrUpdateCalls(func(
+					ctx context.Context,
+					c client.Client,
+					obj client.Object,
+					f controllerutil.MutateFn,
+				) (controllerutil.OperationResult, error) {
+					err := f()
+					assert.NoError(t, err)
+					profile, ok := obj.(*seccompprofileapi.SeccompProfile)
+					assert.True(t, ok)
+					assert.Len(t, profile.Spec.Syscalls, 2)
+					assert.Equal(t, []string{"mkdir", "socket"}, profile.Spec.Syscalls[0].Names)
+					assert.Equal(t, []string{"prctl"}, profile.Spec.Syscalls[1].Names)
+					assert.Len(t, profile.Spec.Syscalls[1].Args, 1)
+					assert.Equal(t, uint64(38), profile.Spec.Syscalls[1].Args[0].Value)
+					return "", nil
+				})
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Spec: recordingapi.ProfileRecordingSpec{
+						RecordSyscallArgs: []string{"prctl", "socket"},
+					},
+				}, nil)
+			},
+			assert: func(sut *RecorderReconciler, err error) {
+				assert.NoError(t, err)
+				recorder, ok := sut.record.(*record.FakeRecorder)
+				assert.True(t, ok)
+				assert.Contains(t, <-recorder.Events, "syscalls denied during recording: socket")
+			},
+		},
 		{ // logs seccomp failed ResetSyscalls
 			prepare: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl) {
 				profileName := fmt.Sprintf("profile_replica-123_%d", time.Now().Unix())
@@ -1596,6 +1736,277 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileSnapshot(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileName := fmt.Sprintf("profile_replica-123_4bbwm_%d", time.Now().Unix())
+	continuous := func() *recordingapi.ProfileRecording {
+		return &recordingapi.ProfileRecording{
+			Spec: recordingapi.ProfileRecordingSpec{
+				Mode:             recordingapi.ProfileRecordingModeContinuous,
+				SnapshotInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+	}
+	runningPod := &corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}
+	createOrUpdate := func(
+		_ context.Context, _ client.Client, _ client.Object, f controllerutil.MutateFn,
+	) (controllerutil.OperationResult, error) {
+		return "", f()
+	}
+
+	for _, tc := range []struct {
+		name    string
+		value   podToWatch
+		prepare func(*profilerecorderfakes.FakeImpl)
+		assert  func(*RecorderReconciler, *profilerecorderfakes.FakeImpl, reconcile.Result, error)
+	}{
+		{
+			name: "bpf snapshot keeps recording",
+			value: podToWatch{
+				recorder: recordingapi.ProfileRecorderBpf,
+				profiles: []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(continuous(), nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(&bpfrecorderapi.SyscallsResponse{
+					Syscalls: []string{"mkdir"},
+					GoArch:   runtime.GOARCH,
+				}, nil)
+				mock.CreateOrUpdateCalls(createOrUpdate)
+			},
+			assert: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.Minute, res.RequeueAfter)
+				require.Equal(t, 1, mock.CreateOrUpdateCallCount())
+				_, _, req := mock.SyscallsForProfileArgsForCall(0)
+				require.True(t, req.GetKeep())
+				require.Zero(t, mock.StopBpfRecorderCallCount())
+
+				v, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+				require.True(t, ok)
+				pod, ok := v.(podToWatch)
+				require.True(t, ok)
+				require.False(t, pod.lastSnapshot.IsZero())
+
+				require.Equal(t, 2, mock.UpdateRecordingStatusCallCount())
+				_, _, recording := mock.UpdateRecordingStatusArgsForCall(1)
+				require.Len(t, recording.Status.Profiles, 1)
+				require.Equal(t, "profile-replica-123-name", recording.Status.Profiles[0].Name)
+				require.Equal(t, "replica-123", recording.Status.Profiles[0].Container)
+				require.Empty(t, recording.Status.LastError)
+				cond, ok := recording.Status.GetCondition(recordingapi.ConditionTypeCollecting)
+				require.True(t, ok)
+				require.Equal(t, recordingapi.ReasonCollected, cond.Reason)
+			},
+		},
+		{
+			name: "bpf snapshot removes least recently collected profiles",
+			value: podToWatch{
+				recorder: recordingapi.ProfileRecorderBpf,
+				profiles: []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				recording := continuous()
+				for i := range recordingapi.MaxRecordedProfiles {
+					recording.Status.Profiles = append(recording.Status.Profiles, recordingapi.RecordedProfile{
+						Name:          fmt.Sprintf("old-%d", i),
+						LastCollected: metav1.Unix(int64(i+1), 0),
+					})
+				}
+				mock.GetRecordingReturns(recording, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(&bpfrecorderapi.SyscallsResponse{
+					Syscalls: []string{"mkdir"},
+					GoArch:   runtime.GOARCH,
+				}, nil)
+				mock.CreateOrUpdateCalls(createOrUpdate)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, _ reconcile.Result, err error) {
+				require.NoError(t, err)
+				_, _, recording := mock.UpdateRecordingStatusArgsForCall(1)
+				require.Len(t, recording.Status.Profiles, recordingapi.MaxRecordedProfiles)
+				require.Equal(t, "old-1", recording.Status.Profiles[0].Name)
+				require.Equal(t, "profile-replica-123-name",
+					recording.Status.Profiles[recordingapi.MaxRecordedProfiles-1].Name)
+			},
+		},
+		{
+			name: "failed snapshot reports error",
+			value: podToWatch{
+				recorder: recordingapi.ProfileRecorderBpf,
+				profiles: []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(continuous(), nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(nil, errTest)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, _ reconcile.Result, _ error) {
+				require.Zero(t, mock.CreateOrUpdateCallCount())
+				require.Equal(t, 2, mock.UpdateRecordingStatusCallCount())
+				_, _, recording := mock.UpdateRecordingStatusArgsForCall(1)
+				require.Empty(t, recording.Status.Profiles)
+				require.Contains(t, recording.Status.LastError, errTest.Error())
+				cond, ok := recording.Status.GetCondition(recordingapi.ConditionTypeFailed)
+				require.True(t, ok)
+				require.Equal(t, corev1.ConditionTrue, cond.Status)
+				require.Equal(t, recordingapi.ReasonCollectionFailed, cond.Reason)
+			},
+		},
+		{
+			name: "logs snapshot keeps syscalls",
+			value: podToWatch{
+				recorder: recordingapi.ProfileRecorderLogs,
+				profiles: []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(continuous(), nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+				}, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.SyscallsReturns(&enricherapi.SyscallsResponse{
+					Syscalls: []string{"mkdir"},
+					GoArch:   runtime.GOARCH,
+				}, nil)
+				mock.CreateOrUpdateCalls(createOrUpdate)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.Minute, res.RequeueAfter)
+				require.Equal(t, 1, mock.CreateOrUpdateCallCount())
+				require.Zero(t, mock.ResetSyscallsCallCount())
+			},
+		},
+		{
+			name: "finished recording collects profiles",
+			value: podToWatch{
+				recorder:     recordingapi.ProfileRecorderBpf,
+				profiles:     []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+				lastSnapshot: time.Now(),
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{
+					Status: recordingapi.ProfileRecordingStatus{
+						FinishedAt: &metav1.Time{Time: time.Now()},
+						StopReason: recordingapi.ProfileRecordingStopReasonMaxDuration,
+					},
+				}, nil)
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+				}, nil)
+				mock.DialBpfRecorderReturns(nil, func() {}, nil)
+				mock.SyscallsForProfileReturns(&bpfrecorderapi.SyscallsResponse{
+					Syscalls: []string{"mkdir"},
+					GoArch:   runtime.GOARCH,
+				}, nil)
+				mock.CreateOrUpdateCalls(createOrUpdate)
+			},
+			assert: func(sut *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				_, _, req := mock.SyscallsForProfileArgsForCall(0)
+				require.False(t, req.GetKeep())
+				require.Equal(t, 1, mock.StopBpfRecorderCallCount())
+
+				_, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "snapshot not yet due",
+			value: podToWatch{
+				recorder:     recordingapi.ProfileRecorderBpf,
+				profiles:     []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+				lastSnapshot: time.Now(),
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(continuous(), nil)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Positive(t, res.RequeueAfter)
+				require.LessOrEqual(t, res.RequeueAfter, time.Minute)
+				require.Zero(t, mock.SyscallsForProfileCallCount())
+			},
+		},
+		{
+			name: "one shot recording",
+			value: podToWatch{
+				recorder: recordingapi.ProfileRecorderBpf,
+				profiles: []profileToCollect{{kind: recordingapi.ProfileRecordingKindSeccompProfile, name: profileName}},
+			},
+			prepare: func(mock *profilerecorderfakes.FakeImpl) {
+				mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+			},
+			assert: func(_ *RecorderReconciler, mock *profilerecorderfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				require.Zero(t, mock.SyscallsForProfileCallCount())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilerecorderfakes.FakeImpl{}
+			sut := &RecorderReconciler{
+				impl:   mock,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+			sut.podsToWatch.Store(testRequest.NamespacedName.String(), tc.value)
+			mock.GetPodReturns(runningPod, nil)
+			tc.prepare(mock)
+
+			res, err := sut.Reconcile(context.Background(), testRequest)
+			tc.assert(sut, mock, res, err)
+		})
+	}
+}
+
+func TestPodsOfFinishedRecording(t *testing.T) {
+	t.Parallel()
+
+	sut := &RecorderReconciler{}
+	profile := func(recording string) []profileToCollect {
+		return []profileToCollect{{
+			kind: recordingapi.ProfileRecordingKindSeccompProfile,
+			name: fmt.Sprintf("%s_nginx_4bbwm_%d", recording, time.Now().Unix()),
+		}}
+	}
+	sut.podsToWatch.Store("ns/recorded", podToWatch{profiles: profile("recording")})
+	sut.podsToWatch.Store("ns/other", podToWatch{profiles: profile("other")})
+	sut.podsToWatch.Store("other-ns/recorded", podToWatch{profiles: profile("recording")})
+
+	recording := &recordingapi.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: "recording", Namespace: "ns"},
+	}
+	require.Empty(t, sut.podsOfFinishedRecording(context.Background(), recording))
+
+	recording.Status.FinishedAt = &metav1.Time{Time: time.Now()}
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Namespace: "ns", Name: "recorded"},
+	}}, sut.podsOfFinishedRecording(context.Background(), recording))
+}
+
 func TestIsPodOnLocalNode(t *testing.T) {
 	t.Parallel()
 
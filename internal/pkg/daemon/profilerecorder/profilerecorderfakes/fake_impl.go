@@ -29,6 +29,7 @@ import (
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	api_bpfrecorder "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
@@ -219,14 +220,15 @@ type FakeImpl struct {
 		result1 client.Client
 		result2 error
 	}
-	NewControllerManagedByStub        func(manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, reconcile.Reconciler) error
+	NewControllerManagedByStub        func(manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, handler.EventHandler, reconcile.Reconciler) error
 	newControllerManagedByMutex       sync.RWMutex
 	newControllerManagedByArgsForCall []struct {
 		arg1 manager.Manager
 		arg2 string
 		arg3 func(obj runtime.Object) bool
 		arg4 func(obj runtime.Object) bool
-		arg5 reconcile.Reconciler
+		arg5 handler.EventHandler
+		arg6 reconcile.Reconciler
 	}
 	newControllerManagedByReturns struct {
 		result1 error
@@ -314,6 +316,19 @@ type FakeImpl struct {
 		result1 *api_bpfrecorder.SyscallsResponse
 		result2 error
 	}
+	UpdateRecordingStatusStub        func(context.Context, client.Client, *v1alpha1.ProfileRecording) error
+	updateRecordingStatusMutex       sync.RWMutex
+	updateRecordingStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1alpha1.ProfileRecording
+	}
+	updateRecordingStatusReturns struct {
+		result1 error
+	}
+	updateRecordingStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1147,7 +1162,7 @@ func (fake *FakeImpl) NewClientReturnsOnCall(i int, result1 client.Client, resul
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) NewControllerManagedBy(arg1 manager.Manager, arg2 string, arg3 func(obj runtime.Object) bool, arg4 func(obj runtime.Object) bool, arg5 reconcile.Reconciler) error {
+func (fake *FakeImpl) NewControllerManagedBy(arg1 manager.Manager, arg2 string, arg3 func(obj runtime.Object) bool, arg4 func(obj runtime.Object) bool, arg5 handler.EventHandler, arg6 reconcile.Reconciler) error {
 	fake.newControllerManagedByMutex.Lock()
 	ret, specificReturn := fake.newControllerManagedByReturnsOnCall[len(fake.newControllerManagedByArgsForCall)]
 	fake.newControllerManagedByArgsForCall = append(fake.newControllerManagedByArgsForCall, struct {
@@ -1155,14 +1170,15 @@ func (fake *FakeImpl) NewControllerManagedBy(arg1 manager.Manager, arg2 string,
 		arg2 string
 		arg3 func(obj runtime.Object) bool
 		arg4 func(obj runtime.Object) bool
-		arg5 reconcile.Reconciler
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg5 handler.EventHandler
+		arg6 reconcile.Reconciler
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.NewControllerManagedByStub
 	fakeReturns := fake.newControllerManagedByReturns
-	fake.recordInvocation("NewControllerManagedBy", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("NewControllerManagedBy", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.newControllerManagedByMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1176,17 +1192,17 @@ func (fake *FakeImpl) NewControllerManagedByCallCount() int {
 	return len(fake.newControllerManagedByArgsForCall)
 }
 
-func (fake *FakeImpl) NewControllerManagedByCalls(stub func(manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, reconcile.Reconciler) error) {
+func (fake *FakeImpl) NewControllerManagedByCalls(stub func(manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, handler.EventHandler, reconcile.Reconciler) error) {
 	fake.newControllerManagedByMutex.Lock()
 	defer fake.newControllerManagedByMutex.Unlock()
 	fake.NewControllerManagedByStub = stub
 }
 
-func (fake *FakeImpl) NewControllerManagedByArgsForCall(i int) (manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, reconcile.Reconciler) {
+func (fake *FakeImpl) NewControllerManagedByArgsForCall(i int) (manager.Manager, string, func(obj runtime.Object) bool, func(obj runtime.Object) bool, handler.EventHandler, reconcile.Reconciler) {
 	fake.newControllerManagedByMutex.RLock()
 	defer fake.newControllerManagedByMutex.RUnlock()
 	argsForCall := fake.newControllerManagedByArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeImpl) NewControllerManagedByReturns(result1 error) {
@@ -1594,6 +1610,69 @@ func (fake *FakeImpl) SyscallsForProfileReturnsOnCall(i int, result1 *api_bpfrec
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) UpdateRecordingStatus(arg1 context.Context, arg2 client.Client, arg3 *v1alpha1.ProfileRecording) error {
+	fake.updateRecordingStatusMutex.Lock()
+	ret, specificReturn := fake.updateRecordingStatusReturnsOnCall[len(fake.updateRecordingStatusArgsForCall)]
+	fake.updateRecordingStatusArgsForCall = append(fake.updateRecordingStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 *v1alpha1.ProfileRecording
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRecordingStatusStub
+	fakeReturns := fake.updateRecordingStatusReturns
+	fake.recordInvocation("UpdateRecordingStatus", []interface{}{arg1, arg2, arg3})
+	fake.updateRecordingStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusCallCount() int {
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
+	return len(fake.updateRecordingStatusArgsForCall)
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusCalls(stub func(context.Context, client.Client, *v1alpha1.ProfileRecording) error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = stub
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusArgsForCall(i int) (context.Context, client.Client, *v1alpha1.ProfileRecording) {
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
+	argsForCall := fake.updateRecordingStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusReturns(result1 error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = nil
+	fake.updateRecordingStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) UpdateRecordingStatusReturnsOnCall(i int, result1 error) {
+	fake.updateRecordingStatusMutex.Lock()
+	defer fake.updateRecordingStatusMutex.Unlock()
+	fake.UpdateRecordingStatusStub = nil
+	if fake.updateRecordingStatusReturnsOnCall == nil {
+		fake.updateRecordingStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRecordingStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1637,6 +1716,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.syscallsMutex.RUnlock()
 	fake.syscallsForProfileMutex.RLock()
 	defer fake.syscallsForProfileMutex.RUnlock()
+	fake.updateRecordingStatusMutex.RLock()
+	defer fake.updateRecordingStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerecorder
+
+import (
+	"context"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// updateRecordingStatus applies the change to the status of every recording
+// of the profiles. Errors are only logged, because the status is informative.
+func (r *RecorderReconciler) updateRecordingStatus(
+	ctx context.Context,
+	namespace string,
+	profiles []profileToCollect,
+	change func(recordingName string, status *profilerecording1alpha1.ProfileRecordingStatus),
+) {
+	for _, recordingName := range recordingNames(profiles) {
+		key := types.NamespacedName{Name: recordingName, Namespace: namespace}
+		if err := util.Retry(func() error {
+			recording, err := r.GetRecording(ctx, r.client, key)
+			if err != nil {
+				return err
+			}
+			if recording == nil {
+				return nil
+			}
+
+			change(recordingName, &recording.Status)
+			return r.UpdateRecordingStatus(ctx, r.client, recording)
+		}, kerrors.IsConflict); util.IgnoreNotFound(err) != nil {
+			r.log.Error(err, "Cannot update profile recording status", "recording", key)
+		}
+	}
+}
+
+// recordedProfiles returns the collected profiles of a pod per recording.
+func (r *RecorderReconciler) recordedProfiles(
+	ctx context.Context,
+	replicaSuffix string,
+	podName types.NamespacedName,
+	profiles []profileToCollect,
+) map[string][]profilerecording1alpha1.RecordedProfile {
+	res := map[string][]profilerecording1alpha1.RecordedProfile{}
+	now := metav1.Now()
+
+	for _, prf := range profiles {
+		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			continue
+		}
+
+		profileNamespacedName := createProfileName(
+			parsedProfileAnnotation.cntName, replicaSuffix,
+			podName.Namespace, parsedProfileAnnotation.profileName)
+
+		recorded := profilerecording1alpha1.RecordedProfile{
+			Name:          profileNamespacedName.Name,
+			NodeName:      r.nodeName,
+			Pod:           podName.Name,
+			Container:     parsedProfileAnnotation.cntName,
+			Replica:       strings.TrimPrefix(replicaSuffix, "-"),
+			LastCollected: now,
+		}
+
+		if err := r.countRecordedRules(ctx, prf.kind, profileNamespacedName, &recorded); err != nil {
+			// Empty recordings do not result in a profile
+			continue
+		}
+
+		res[parsedProfileAnnotation.profileName] = append(res[parsedProfileAnnotation.profileName], recorded)
+	}
+
+	return res
+}
+
+// countRecordedRules sets the number of recorded rules of the profile.
+func (r *RecorderReconciler) countRecordedRules(
+	ctx context.Context,
+	kind profilerecording1alpha1.ProfileRecordingKind,
+	key types.NamespacedName,
+	recorded *profilerecording1alpha1.RecordedProfile,
+) error {
+	var obj client.Object
+	switch kind {
+	case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
+		obj = &seccompprofileapi.SeccompProfile{}
+	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
+		obj = &selxv1alpha2.SelinuxProfile{}
+	case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
+		obj = &apparmorprofileapi.AppArmorProfile{}
+	default:
+		return nil
+	}
+
+	if err := r.ClientGet(ctx, r.client, key, obj); err != nil {
+		return err
+	}
+
+	switch profile := obj.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		for _, syscall := range profile.Spec.Syscalls {
+			recorded.Syscalls += int32(len(syscall.Names)) //nolint:gosec // number of syscalls fits
+		}
+	case *selxv1alpha2.SelinuxProfile:
+		for _, classes := range profile.Spec.Allow {
+			for _, perms := range classes {
+				recorded.Permissions += int32(len(perms)) //nolint:gosec // number of permissions fits
+			}
+		}
+	case *apparmorprofileapi.AppArmorProfile:
+		abstract := profile.Spec.Abstract
+		var paths []*[]string
+		if abstract.Filesystem != nil {
+			paths = append(paths,
+				abstract.Filesystem.ReadOnlyPaths,
+				abstract.Filesystem.WriteOnlyPaths,
+				abstract.Filesystem.ReadWritePaths)
+			recorded.Paths += int32(len(abstract.Filesystem.FileRules)) //nolint:gosec // number of paths fits
+		}
+		if abstract.Executable != nil {
+			paths = append(paths, abstract.Executable.AllowedExecutables, abstract.Executable.AllowedLibraries)
+		}
+		for _, p := range paths {
+			if p != nil {
+				recorded.Paths += int32(len(*p)) //nolint:gosec // number of paths fits
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordingNames returns the distinct names of the recordings of the
+// profiles.
+func recordingNames(profiles []profileToCollect) []string {
+	names := []string{}
+	for _, prf := range profiles {
+		parsedProfileAnnotation, err := parseProfileAnnotation(prf.name)
+		if err != nil {
+			continue
+		}
+		if !util.Contains(names, parsedProfileAnnotation.profileName) {
+			names = append(names, parsedProfileAnnotation.profileName)
+		}
+	}
+	return names
+}
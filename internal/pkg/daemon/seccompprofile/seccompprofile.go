@@ -457,6 +457,13 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{Requeue: false}, fmt.Errorf("validating profile: %w", err)
 	}
 
+	// The derived profile is not validated, because it logs the system
+	// calls which are denied by the validated one.
+	if outputProfile.Spec.ComplainMode {
+		l.Info("Profile is in complain mode, logging denied syscalls")
+		outputProfile = complainProfile(outputProfile)
+	}
+
 	l.Info("Got profile content")
 	profileContent, err := json.Marshal(outputProfile.Spec)
 	if err != nil {
@@ -489,6 +496,15 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, nil
 	}
 
+	allowed, err := r.rolloutAllowed(ctx, nodeStatus)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking profile rollout: %w", err)
+	}
+	if !allowed {
+		l.Info("Waiting for the staged rollout of the changed profile")
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
 	l.Info("Saving profile to disk")
 	updated, err := r.save(profilePath, profileContent)
 	if err != nil {
@@ -598,6 +614,19 @@ func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error
 	return nil
 }
 
+// rolloutAllowed returns true if the node is allowed to install the profile
+// if a staged rollout is configured.
+func (r *Reconciler) rolloutAllowed(ctx context.Context, nodeStatus *nodestatus.StatusClient) (bool, error) {
+	spod, err := r.GetSPOD(ctx, r.client)
+	if err != nil {
+		return false, fmt.Errorf("retrieving the SPOD configuration: %w", err)
+	}
+	if spod.Spec.ProfileRollout == nil {
+		return true, nil
+	}
+	return nodeStatus.InstallAllowed(ctx)
+}
+
 func (r *Reconciler) validateProfile(ctx context.Context, profile *seccompprofileapi.SeccompProfile) error {
 	spod, err := r.GetSPOD(ctx, r.client)
 	if err != nil {
@@ -662,6 +691,36 @@ func allowProfile(
 	return nil
 }
 
+// complainProfile returns a copy of the profile with all denying actions
+// replaced by SCMP_ACT_LOG.
+func complainProfile(sp *seccompprofileapi.SeccompProfile) *seccompprofileapi.SeccompProfile {
+	res := sp.DeepCopy()
+	res.Spec.ComplainMode = false
+	if IsDenyingAction(res.Spec.DefaultAction) {
+		res.Spec.DefaultAction = seccomp.ActLog
+		res.Spec.DefaultErrnoRet = nil
+	}
+	for _, syscall := range res.Spec.Syscalls {
+		if IsDenyingAction(syscall.Action) {
+			syscall.Action = seccomp.ActLog
+			syscall.ErrnoRet = 0
+		}
+	}
+	return res
+}
+
+// IsDenyingAction returns true if the action prevents the system call from
+// being executed. SCMP_ACT_TRACE and SCMP_ACT_NOTIFY are left to the tracer
+// or seccomp agent.
+func IsDenyingAction(action seccomp.Action) bool {
+	switch action {
+	case seccomp.ActKill, seccomp.ActKillProcess, seccomp.ActKillThread, seccomp.ActTrap, seccomp.ActErrno:
+		return true
+	default:
+		return false
+	}
+}
+
 func containsAction(actions []seccomp.Action, action seccomp.Action) bool {
 	for _, act := range actions {
 		if act == action {
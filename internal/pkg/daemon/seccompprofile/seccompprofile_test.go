@@ -768,3 +768,71 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestComplainProfile(t *testing.T) {
+	t.Parallel()
+
+	errnoRet := uint(38)
+	sp := &seccompprofileapi.SeccompProfile{
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			ComplainMode:    true,
+			DefaultAction:   seccomp.ActErrno,
+			DefaultErrnoRet: &errnoRet,
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read"}},
+				{Action: seccomp.ActErrno, Names: []string{"mkdir"}, ErrnoRet: 1},
+				{Action: seccomp.ActKillProcess, Names: []string{"ptrace"}},
+				{Action: seccomp.ActNotify, Names: []string{"mount"}},
+				{Action: seccomp.ActErrno, Names: []string{"socket"}, Args: []*seccompprofileapi.Arg{
+					{Index: 0, Value: 2, Op: seccomp.OpEqualTo},
+				}},
+			},
+		},
+	}
+
+	res := complainProfile(sp)
+	require.False(t, res.Spec.ComplainMode)
+	require.Equal(t, seccomp.ActLog, res.Spec.DefaultAction)
+	require.Nil(t, res.Spec.DefaultErrnoRet)
+	require.Equal(t, []*seccompprofileapi.Syscall{
+		{Action: seccomp.ActAllow, Names: []string{"read"}},
+		{Action: seccomp.ActLog, Names: []string{"mkdir"}},
+		{Action: seccomp.ActLog, Names: []string{"ptrace"}},
+		{Action: seccomp.ActNotify, Names: []string{"mount"}},
+		{Action: seccomp.ActLog, Names: []string{"socket"}, Args: []*seccompprofileapi.Arg{
+			{Index: 0, Value: 2, Op: seccomp.OpEqualTo},
+		}},
+	}, res.Spec.Syscalls)
+
+	// The authored profile stays unchanged
+	require.True(t, sp.Spec.ComplainMode)
+	require.Equal(t, seccomp.ActErrno, sp.Spec.DefaultAction)
+	require.Equal(t, &errnoRet, sp.Spec.DefaultErrnoRet)
+	require.Equal(t, seccomp.ActErrno, sp.Spec.Syscalls[1].Action)
+	require.Equal(t, uint(1), sp.Spec.Syscalls[1].ErrnoRet)
+}
+
+func TestValidateComplainProfile(t *testing.T) {
+	t.Parallel()
+
+	mock := &seccompprofilefakes.FakeImpl{}
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{AllowedSyscalls: []string{"read"}},
+	}, nil)
+	sut := &Reconciler{impl: mock}
+
+	sp := &seccompprofileapi.SeccompProfile{
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			ComplainMode:  true,
+			DefaultAction: seccomp.ActErrno,
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read"}},
+			},
+		},
+	}
+
+	// Only the authored profile gets validated, because the derived one
+	// logs all other syscalls by default.
+	require.NoError(t, sut.validateProfile(context.Background(), sp))
+	require.Error(t, sut.validateProfile(context.Background(), complainProfile(sp)))
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreporter
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	GetSPOD(context.Context, client.Client) (*spodapi.SecurityProfilesOperatorDaemon, error)
+	DialEnricher() (*grpc.ClientConn, context.CancelFunc, error)
+	CloseEnricher(*grpc.ClientConn) error
+	Violations(context.Context, enricherapi.EnricherClient) (*enricherapi.ViolationsResponse, error)
+	AckViolations(context.Context, enricherapi.EnricherClient, *enricherapi.AckViolationsRequest) error
+	CreateReport(context.Context, client.Client, *violationreportapi.ProfileViolationReport) error
+	GetSeccompProfile(context.Context, client.Client, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
+}
+
+func (*defaultImpl) GetSPOD(
+	ctx context.Context, c client.Client,
+) (*spodapi.SecurityProfilesOperatorDaemon, error) {
+	return common.GetSPOD(ctx, c)
+}
+
+func (*defaultImpl) DialEnricher() (*grpc.ClientConn, context.CancelFunc, error) {
+	return enricher.Dial()
+}
+
+func (*defaultImpl) CloseEnricher(conn *grpc.ClientConn) error {
+	return conn.Close()
+}
+
+func (*defaultImpl) Violations(
+	ctx context.Context, c enricherapi.EnricherClient,
+) (*enricherapi.ViolationsResponse, error) {
+	return c.Violations(ctx, &enricherapi.ViolationsRequest{})
+}
+
+func (*defaultImpl) AckViolations(
+	ctx context.Context, c enricherapi.EnricherClient, r *enricherapi.AckViolationsRequest,
+) error {
+	_, err := c.AckViolations(ctx, r)
+	return err
+}
+
+func (*defaultImpl) CreateReport(
+	ctx context.Context, c client.Client, report *violationreportapi.ProfileViolationReport,
+) error {
+	return c.Create(ctx, report)
+}
+
+func (*defaultImpl) GetSeccompProfile(
+	ctx context.Context, c client.Client, key types.NamespacedName,
+) (*seccompprofileapi.SeccompProfile, error) {
+	profile := &seccompprofileapi.SeccompProfile{}
+	if err := c.Get(ctx, key, profile); err != nil {
+		return nil, fmt.Errorf("get seccomp profile: %w", err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// reportInterval is the interval in which the violations observed by the
+	// log enricher get reported.
+	reportInterval = 30 * time.Second
+
+	reportTimeout = time.Minute
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &Reporter{
+		impl: &defaultImpl{},
+	}
+}
+
+// Reporter periodically collects the violations observed by the local log
+// enricher and reports them as partial ProfileViolationReports, which get
+// merged per workload by the operator.
+type Reporter struct {
+	impl
+	client   client.Client
+	log      logr.Logger
+	nodeName string
+}
+
+// Name returns the name of the controller.
+func (r *Reporter) Name() string {
+	return "violation-reporter"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Reporter) SchemeBuilder() *scheme.Builder {
+	return violationreportapi.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Reporter) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to report violations
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profileviolationreports,verbs=create
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+
+// Setup adds the periodic reporter to the manager.
+func (r *Reporter) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.client = mgr.GetClient()
+	r.log = ctrl.Log.WithName(r.Name())
+	r.nodeName = os.Getenv(config.NodeNameEnvKey)
+
+	return mgr.Add(manager.RunnableFunc(r.run))
+}
+
+func (r *Reporter) run(ctx context.Context) error {
+	ticker := time.NewTicker(reportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.report(ctx); err != nil {
+				r.log.Error(err, "unable to report violations")
+			}
+		}
+	}
+}
+
+// report creates the partial reports for the violations observed since the
+// last run.
+func (r *Reporter) report(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+	defer cancel()
+
+	spod, err := r.GetSPOD(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("getting SPOD config: %w", err)
+	}
+
+	enableLogEnricherEnv, err := strconv.ParseBool(os.Getenv(config.EnableLogEnricherEnvKey))
+	if err != nil {
+		enableLogEnricherEnv = false
+	}
+	if !spod.Spec.EnableLogEnricher && !enableLogEnricherEnv {
+		return nil
+	}
+
+	conn, cancelConn, err := r.DialEnricher()
+	if err != nil {
+		return fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer cancelConn()
+	defer func() {
+		if err := r.CloseEnricher(conn); err != nil {
+			r.log.Error(err, "unable to close GRPC connection")
+		}
+	}()
+
+	response, err := r.Violations(ctx, enricherapi.NewEnricherClient(conn))
+	if err != nil {
+		return fmt.Errorf("retrieve violations: %w", err)
+	}
+
+	violations := r.filterLoggedSyscalls(ctx, response.GetViolations())
+	failed := map[string]bool{}
+	for _, report := range partialReports(r.nodeName, violations) {
+		if err := r.CreateReport(ctx, r.client, report); err != nil {
+			r.log.Error(err, "unable to create partial violation report",
+				"namespace", report.Namespace, "workload", report.Workload.Name)
+			failed[reportKey(report.Namespace, report.Labels[violationreportapi.ReportToWorkloadLabel])] = true
+			continue
+		}
+		r.log.V(config.VerboseLevel).Info("Created partial violation report",
+			"namespace", report.Namespace, "name", report.Name, "violations", report.TotalCount)
+	}
+
+	// The violations of failed reports are kept by the enricher and
+	// reported again by the next run.
+	acked := make([]*enricherapi.Violation, 0, len(response.GetViolations()))
+	for _, violation := range response.GetViolations() {
+		if !failed[violationReportKey(violation)] {
+			acked = append(acked, violation)
+		}
+	}
+	if len(acked) == 0 {
+		return nil
+	}
+
+	if err := r.AckViolations(
+		ctx, enricherapi.NewEnricherClient(conn), &enricherapi.AckViolationsRequest{Violations: acked},
+	); err != nil {
+		return fmt.Errorf("acknowledge violations: %w", err)
+	}
+
+	return nil
+}
+
+// filterLoggedSyscalls removes the logged system calls which are not denials
+// of seccomp profiles in complain mode.
+func (r *Reporter) filterLoggedSyscalls(
+	ctx context.Context, violations []*enricherapi.Violation,
+) []*enricherapi.Violation {
+	profiles := map[types.NamespacedName]*seccompprofileapi.SeccompProfile{}
+	res := make([]*enricherapi.Violation, 0, len(violations))
+
+	for _, violation := range violations {
+		if violation.GetType() != enricherapi.Violation_SECCOMP ||
+			seccomp.Action(violation.GetAction()) != seccomp.ActLog {
+			res = append(res, violation)
+			continue
+		}
+
+		key, ok := seccompProfileKey(violation.GetSeccompProfile())
+		if !ok {
+			continue
+		}
+		profile, ok := profiles[key]
+		if !ok {
+			var err error
+			profile, err = r.GetSeccompProfile(ctx, r.client, key)
+			if err != nil {
+				if util.IgnoreNotFound(err) != nil {
+					r.log.Error(err, "unable to get seccomp profile", "profile", key)
+				}
+				profile = nil
+			}
+			profiles[key] = profile
+		}
+
+		if profile != nil && profile.Spec.ComplainMode &&
+			complainLogged(profile, violation.GetSyscall()) {
+			res = append(res, violation)
+		}
+	}
+
+	return res
+}
+
+// seccompProfileKey returns the SeccompProfile of an operator managed
+// localhost profile like "operator/default/profile.json".
+func seccompProfileKey(localhostProfile string) (types.NamespacedName, bool) {
+	parts := strings.Split(localhostProfile, "/")
+	if len(parts) != 3 || parts[0] != config.OperatorProfilesFolder ||
+		path.Ext(parts[2]) != seccompprofileapi.ExtJSON {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{
+		Namespace: parts[1],
+		Name:      strings.TrimSuffix(parts[2], seccompprofileapi.ExtJSON),
+	}, true
+}
+
+// complainLogged returns true if the system call may have been logged
+// because of a denying action of the profile, which got replaced by
+// SCMP_ACT_LOG in complain mode. Rules with argument conditions only match
+// some invocations, which may therefore have been logged by any of them or
+// by the action for the remaining invocations.
+func complainLogged(profile *seccompprofileapi.SeccompProfile, syscall string) bool {
+	candidates := []seccomp.Action{}
+	fallback, unconditional := profile.Spec.DefaultAction, false
+	for _, s := range profile.Spec.Syscalls {
+		if !slices.Contains(s.Names, syscall) {
+			continue
+		}
+		if len(s.Args) > 0 {
+			candidates = append(candidates, s.Action)
+		} else if !unconditional {
+			fallback, unconditional = s.Action, true
+		}
+	}
+	return slices.ContainsFunc(append(candidates, fallback), seccompprofile.IsDenyingAction)
+}
+
+// partialReports groups the violations by workload into partial reports.
+func partialReports(
+	nodeName string, violations []*enricherapi.Violation,
+) []*violationreportapi.ProfileViolationReport {
+	reports := map[string]*violationreportapi.ProfileViolationReport{}
+	keys := []string{}
+
+	for _, violation := range violations {
+		reportName := util.ViolationReportName(violation.GetWorkloadKind(), violation.GetWorkloadName())
+		key := violationReportKey(violation)
+
+		report, ok := reports[key]
+		if !ok {
+			report = &violationreportapi.ProfileViolationReport{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: reportName + "-",
+					Namespace:    violation.GetNamespace(),
+					Labels: map[string]string{
+						profilebase.ProfilePartialLabel:          "true",
+						violationreportapi.ReportToWorkloadLabel: reportName,
+						violationreportapi.ReportToNodeLabel:     nodeName,
+					},
+				},
+				Workload: violationreportapi.WorkloadReference{
+					Kind: violation.GetWorkloadKind(),
+					Name: violation.GetWorkloadName(),
+				},
+				NodeName: nodeName,
+			}
+			reports[key] = report
+			keys = append(keys, key)
+		}
+
+		report.Merge(violationReport(violation))
+	}
+
+	result := make([]*violationreportapi.ProfileViolationReport, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, reports[key])
+	}
+	return result
+}
+
+// reportKey returns the key of the partial report for the workload report
+// name in the namespace.
+func reportKey(namespace, reportName string) string {
+	return namespace + "/" + reportName
+}
+
+// violationReportKey returns the key of the partial report which contains the
+// violation.
+func violationReportKey(violation *enricherapi.Violation) string {
+	return reportKey(
+		violation.GetNamespace(),
+		util.ViolationReportName(violation.GetWorkloadKind(), violation.GetWorkloadName()),
+	)
+}
+
+// violationReport converts a single violation into a report.
+func violationReport(violation *enricherapi.Violation) *violationreportapi.ProfileViolationReport {
+	count := violationreportapi.ViolationCount{
+		Count:     int64(violation.GetCount()),
+		FirstSeen: metav1.Unix(violation.GetFirstSeen(), 0),
+		LastSeen:  metav1.Unix(violation.GetLastSeen(), 0),
+	}
+	container := violationreportapi.ContainerViolations{
+		Name:           violation.GetContainer(),
+		SeccompProfile: violation.GetSeccompProfile(),
+	}
+
+	switch violation.GetType() {
+	case enricherapi.Violation_SECCOMP:
+		container.Syscalls = []violationreportapi.SyscallViolation{{
+			Name:           violation.GetSyscall(),
+			Action:         violation.GetAction(),
+			ViolationCount: count,
+		}}
+	case enricherapi.Violation_SELINUX:
+		container.AVCs = []violationreportapi.AVCViolation{{
+			Perm:           violation.GetPerm(),
+			Scontext:       violation.GetScontext(),
+			Tcontext:       violation.GetTcontext(),
+			Tclass:         violation.GetTclass(),
+			ViolationCount: count,
+		}}
+	case enricherapi.Violation_APPARMOR:
+		container.AppArmor = []violationreportapi.AppArmorViolation{{
+			Profile:        violation.GetApparmorProfile(),
+			Operation:      violation.GetOperation(),
+			Name:           violation.GetName(),
+			DeniedMask:     violation.GetDeniedMask(),
+			ViolationCount: count,
+		}}
+	}
+
+	return &violationreportapi.ProfileViolationReport{
+		Containers: []violationreportapi.ContainerViolations{container},
+	}
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/violationreporter/violationreporterfakes"
+)
+
+var errTest = errors.New("test")
+
+func testViolations() []*enricherapi.Violation {
+	return []*enricherapi.Violation{
+		{
+			Type:         enricherapi.Violation_SECCOMP,
+			Namespace:    "default",
+			WorkloadKind: "Deployment",
+			WorkloadName: "nginx",
+			Container:    "nginx",
+			Syscall:      "mkdir",
+			Action:       "SCMP_ACT_ERRNO",
+			Count:        3,
+			FirstSeen:    100,
+			LastSeen:     200,
+		},
+		{
+			Type:            enricherapi.Violation_APPARMOR,
+			Namespace:       "default",
+			WorkloadKind:    "Deployment",
+			WorkloadName:    "nginx",
+			Container:       "sidecar",
+			ApparmorProfile: "sidecar-profile",
+			Operation:       "open",
+			Name:            "/etc/shadow",
+			Count:           1,
+			FirstSeen:       150,
+			LastSeen:        150,
+		},
+		{
+			Type:         enricherapi.Violation_SELINUX,
+			Namespace:    "other",
+			WorkloadKind: "Pod",
+			WorkloadName: "app",
+			Container:    "app",
+			Perm:         "read",
+			Scontext:     "system_u:system_r:container_t:s0",
+			Tcontext:     "system_u:object_r:var_log_t:s0",
+			Tclass:       "file",
+			Count:        2,
+			FirstSeen:    300,
+			LastSeen:     400,
+		},
+	}
+}
+
+func TestPartialReports(t *testing.T) {
+	t.Parallel()
+
+	reports := partialReports("node", testViolations())
+	require.Len(t, reports, 2)
+
+	nginx := reports[0]
+	require.Equal(t, "deployment-nginx-", nginx.GenerateName)
+	require.Equal(t, "default", nginx.Namespace)
+	require.Equal(t, "true", nginx.Labels[profilebase.ProfilePartialLabel])
+	require.Equal(t, "deployment-nginx", nginx.Labels[violationreportapi.ReportToWorkloadLabel])
+	require.Equal(t, "node", nginx.Labels[violationreportapi.ReportToNodeLabel])
+	require.Equal(t, "node", nginx.NodeName)
+	require.Equal(t, violationreportapi.WorkloadReference{Kind: "Deployment", Name: "nginx"}, nginx.Workload)
+	require.EqualValues(t, 4, nginx.TotalCount)
+	require.EqualValues(t, 200, nginx.LastSeen.Unix())
+	require.Len(t, nginx.Containers, 2)
+	require.Equal(t, "nginx", nginx.Containers[0].Name)
+	require.Len(t, nginx.Containers[0].Syscalls, 1)
+	require.Equal(t, "mkdir", nginx.Containers[0].Syscalls[0].Name)
+	require.Equal(t, "sidecar", nginx.Containers[1].Name)
+	require.Len(t, nginx.Containers[1].AppArmor, 1)
+	require.Equal(t, "/etc/shadow", nginx.Containers[1].AppArmor[0].Name)
+
+	app := reports[1]
+	require.Equal(t, "pod-app-", app.GenerateName)
+	require.Equal(t, "other", app.Namespace)
+	require.EqualValues(t, 2, app.TotalCount)
+	require.Len(t, app.Containers, 1)
+	require.Len(t, app.Containers[0].AVCs, 1)
+	require.Equal(t, "file", app.Containers[0].AVCs[0].Tclass)
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	enabledSPOD := &spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*violationreporterfakes.FakeImpl)
+		assert  func(*violationreporterfakes.FakeImpl, error)
+	}{
+		{
+			name: "success",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Violations: testViolations(),
+				}, nil)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.CloseEnricherCallCount())
+				require.Equal(t, 2, mock.CreateReportCallCount())
+				require.Equal(t, 1, mock.AckViolationsCallCount())
+				_, _, req := mock.AckViolationsArgsForCall(0)
+				require.Len(t, req.GetViolations(), 3)
+			},
+		},
+		{
+			name: "success create report fails",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Violations: testViolations(),
+				}, nil)
+				mock.CreateReportReturnsOnCall(0, errTest)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.CreateReportCallCount())
+				require.Equal(t, 1, mock.AckViolationsCallCount())
+				_, _, req := mock.AckViolationsArgsForCall(0)
+				require.Len(t, req.GetViolations(), 1)
+				require.Equal(t, enricherapi.Violation_SELINUX, req.GetViolations()[0].GetType())
+			},
+		},
+		{
+			name: "success all reports fail",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Violations: testViolations(),
+				}, nil)
+				mock.CreateReportReturns(errTest)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, mock.CreateReportCallCount())
+				require.Zero(t, mock.AckViolationsCallCount())
+			},
+		},
+		{
+			name: "success log enricher disabled",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				require.Zero(t, mock.DialEnricherCallCount())
+			},
+		},
+		{
+			name: "failure on GetSPOD",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(nil, errTest)
+			},
+			assert: func(_ *violationreporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on DialEnricher",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, nil, errTest)
+			},
+			assert: func(_ *violationreporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on Violations",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ViolationsReturns(nil, errTest)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.CreateReportCallCount())
+			},
+		},
+		{
+			name: "failure on AckViolations",
+			prepare: func(mock *violationreporterfakes.FakeImpl) {
+				mock.GetSPODReturns(enabledSPOD, nil)
+				mock.DialEnricherReturns(nil, func() {}, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Violations: testViolations(),
+				}, nil)
+				mock.AckViolationsReturns(errTest)
+			},
+			assert: func(mock *violationreporterfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Equal(t, 2, mock.CreateReportCallCount())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &violationreporterfakes.FakeImpl{}
+			tc.prepare(mock)
+			sut := &Reporter{impl: mock, log: logr.Discard(), nodeName: "node"}
+
+			err := sut.report(context.Background())
+			tc.assert(mock, err)
+		})
+	}
+}
+
+func TestFilterLoggedSyscalls(t *testing.T) {
+	t.Parallel()
+
+	logged := func(syscall, profile string) *enricherapi.Violation {
+		return &enricherapi.Violation{
+			Type:           enricherapi.Violation_SECCOMP,
+			Syscall:        syscall,
+			Action:         string(seccomp.ActLog),
+			SeccompProfile: profile,
+		}
+	}
+	denied := testViolations()[0]
+
+	mock := &violationreporterfakes.FakeImpl{}
+	mock.GetSeccompProfileCalls(func(
+		_ context.Context, _ client.Client, key types.NamespacedName,
+	) (*seccompprofileapi.SeccompProfile, error) {
+		switch key.Name {
+		case "complain":
+			return &seccompprofileapi.SeccompProfile{Spec: seccompprofileapi.SeccompProfileSpec{
+				ComplainMode:  true,
+				DefaultAction: seccomp.ActErrno,
+				Syscalls: []*seccompprofileapi.Syscall{
+					{Action: seccomp.ActLog, Names: []string{"prctl"}},
+					{Action: seccomp.ActLog, Names: []string{"ioctl"}, Args: []*seccompprofileapi.Arg{
+						{Index: 1, Value: 0x5401, Op: seccomp.OpEqualTo},
+					}},
+					{Action: seccomp.ActAllow, Names: []string{"socket"}, Args: []*seccompprofileapi.Arg{
+						{Index: 0, Value: 1, Op: seccomp.OpEqualTo},
+					}},
+					{Action: seccomp.ActLog, Names: []string{"socket"}},
+					{Action: seccomp.ActErrno, Names: []string{"clone"}, Args: []*seccompprofileapi.Arg{
+						{Index: 0, Value: 0x10000000, Op: seccomp.OpMaskedEqual},
+					}},
+					{Action: seccomp.ActLog, Names: []string{"clone"}},
+				},
+			}}, nil
+		case "enforce":
+			return &seccompprofileapi.SeccompProfile{Spec: seccompprofileapi.SeccompProfileSpec{
+				DefaultAction: seccomp.ActErrno,
+			}}, nil
+		default:
+			return nil, kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+	})
+	sut := &Reporter{impl: mock, log: logr.Discard()}
+
+	res := sut.filterLoggedSyscalls(context.Background(), []*enricherapi.Violation{
+		denied,
+		logged("mkdir", "operator/default/complain.json"),
+		logged("prctl", "operator/default/complain.json"),
+		logged("ioctl", "operator/default/complain.json"),
+		logged("socket", "operator/default/complain.json"),
+		logged("clone", "operator/default/complain.json"),
+		logged("mkdir", "operator/default/enforce.json"),
+		logged("mkdir", "operator/default/missing.json"),
+		logged("mkdir", "custom.json"),
+	})
+	// Invocations of syscalls with conditional rules may have been logged
+	// by a denying action as well
+	require.Equal(t, []*enricherapi.Violation{
+		denied,
+		logged("mkdir", "operator/default/complain.json"),
+		logged("ioctl", "operator/default/complain.json"),
+		logged("clone", "operator/default/complain.json"),
+	}, res)
+	require.Equal(t, 3, mock.GetSeccompProfileCallCount())
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodelabeler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const reconcileTimeout = 1 * time.Minute
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &NodeLabelReconciler{}
+}
+
+// A NodeLabelReconciler labels the nodes with the profiles installed on them.
+type NodeLabelReconciler struct {
+	client    client.Client
+	log       logr.Logger
+	namespace string
+}
+
+// Name returns the name of the controller.
+func (r *NodeLabelReconciler) Name() string {
+	return "nodelabeler"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *NodeLabelReconciler) SchemeBuilder() *scheme.Builder {
+	return statusv1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *NodeLabelReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to label the nodes
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+
+// Reconcile reconciles the profile labels of a Node.
+func (r *NodeLabelReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("node", req.Name)
+
+	node := &corev1.Node{}
+	if err := r.client.Get(ctx, req.NamespacedName, node); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+
+	enabled, err := r.nodeAffinityEnabled(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	installed := map[string]bool{}
+	if enabled {
+		installed, err = r.installedProfileLabels(ctx, node.Name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	patched := node.DeepCopy()
+	if patched.Labels == nil {
+		patched.Labels = map[string]string{}
+	}
+	for label := range patched.Labels {
+		if strings.HasPrefix(label, statusv1alpha1.ProfileInstalledNodeLabelPrefix) && !installed[label] {
+			delete(patched.Labels, label)
+		}
+	}
+	for label := range installed {
+		patched.Labels[label] = "true"
+	}
+
+	patch := client.MergeFrom(node)
+	data, err := patch.Data(patched)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("create node patch: %w", err)
+	}
+	if string(data) == "{}" {
+		return reconcile.Result{}, nil
+	}
+
+	logger.Info("Updating profile labels of node", "profiles", len(installed))
+	if err := r.client.Patch(ctx, patched, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("patch node labels: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// nodeAffinityEnabled returns true if the nodes should be labeled.
+func (r *NodeLabelReconciler) nodeAffinityEnabled(ctx context.Context) (bool, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: r.namespace}
+	if err := r.client.Get(ctx, key, spod); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("get spod: %w", err)
+	}
+	return spod.Spec.EnableNodeAffinity, nil
+}
+
+// installedProfileLabels returns the labels of the profiles installed on the
+// node.
+func (r *NodeLabelReconciler) installedProfileLabels(ctx context.Context, nodeName string) (map[string]bool, error) {
+	nodeStatuses := &statusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := r.client.List(ctx, nodeStatuses, client.MatchingLabels{
+		statusv1alpha1.StatusToNodeLabel: nodeName,
+		statusv1alpha1.StatusStateLabel:  string(statusv1alpha1.ProfileStateInstalled),
+	}); err != nil {
+		return nil, fmt.Errorf("list node statuses: %w", err)
+	}
+
+	labels := map[string]bool{}
+	for i := range nodeStatuses.Items {
+		status := &nodeStatuses.Items[i]
+		owner := metav1.GetControllerOf(status)
+		if owner == nil || status.DeletionTimestamp != nil {
+			continue
+		}
+		labels[util.ProfileInstalledNodeLabel(owner.Kind, status.Namespace, owner.Name)] = true
+	}
+	return labels, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodelabeler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	nodeName       = "node"
+	installedNginx = "installed.spo.x-k8s.io/seccompprofile-team-a-nginx"
+	installedStale = "installed.spo.x-k8s.io/seccompprofile-team-a-stale"
+)
+
+func nodeStatus(name, profile string, state statusv1alpha1.ProfileState) *statusv1alpha1.SecurityProfileNodeStatus {
+	return &statusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "team-a",
+			Labels: map[string]string{
+				statusv1alpha1.StatusToNodeLabel: nodeName,
+				statusv1alpha1.StatusStateLabel:  string(state),
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "SeccompProfile",
+				Name:       profile,
+				Controller: ptr.To(true),
+			}},
+		},
+		NodeName: nodeName,
+		Status:   state,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, statusv1alpha1.AddToScheme(s))
+	require.NoError(t, spodv1alpha1.AddToScheme(s))
+
+	for _, tc := range []struct {
+		name           string
+		enabled        bool
+		expectedLabels map[string]string
+	}{
+		{
+			name:    "labels installed profiles",
+			enabled: true,
+			expectedLabels: map[string]string{
+				"kubernetes.io/os": "linux",
+				installedNginx:     "true",
+			},
+		},
+		{
+			name:    "removes labels if disabled",
+			enabled: false,
+			expectedLabels: map[string]string{
+				"kubernetes.io/os": "linux",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName,
+				Labels: map[string]string{
+					"kubernetes.io/os": "linux",
+					installedStale:     "true",
+				},
+			}}
+			spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+				ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: config.OperatorName},
+				Spec:       spodv1alpha1.SPODSpec{EnableNodeAffinity: tc.enabled},
+			}
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(
+				node, spod,
+				nodeStatus("nginx-node", "nginx", statusv1alpha1.ProfileStateInstalled),
+				nodeStatus("pending-node", "pending", statusv1alpha1.ProfileStatePending),
+			).Build()
+			sut := &NodeLabelReconciler{client: cli, log: logr.Discard(), namespace: config.OperatorName}
+			ctx := context.Background()
+
+			key := types.NamespacedName{Name: nodeName}
+			_, err := sut.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			res := &corev1.Node{}
+			require.NoError(t, cli.Get(ctx, key, res))
+			require.Equal(t, tc.expectedLabels, res.Labels)
+
+			// A second reconcile does not change anything
+			_, err = sut.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+			unchanged := &corev1.Node{}
+			require.NoError(t, cli.Get(ctx, key, unchanged))
+			require.Equal(t, res.ResourceVersion, unchanged.ResourceVersion)
+		})
+	}
+}
+
+func TestNodeForStatus(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		[]reconcile.Request{{NamespacedName: types.NamespacedName{Name: nodeName}}},
+		nodeForStatus(context.Background(), nodeStatus("status", "nginx", statusv1alpha1.ProfileStateInstalled)),
+	)
+	require.Empty(t, nodeForStatus(context.Background(), &statusv1alpha1.SecurityProfileNodeStatus{}))
+	require.Empty(t, nodeForStatus(context.Background(), client.Object(&corev1.Node{})))
+}
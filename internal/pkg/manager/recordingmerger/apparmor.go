@@ -19,7 +19,9 @@ package recordingmerger
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -84,9 +86,53 @@ func (sp *mergeableAppArmorProfile) merge(other mergeableProfile) error {
 		a1.Capability = a2.Capability
 	}
 
+	a1.Signal = mergeRules(a1.Signal, a2.Signal)
+	a1.Ptrace = mergeRules(a1.Ptrace, a2.Ptrace)
+	a1.Unix = mergeRules(a1.Unix, a2.Unix)
+	a1.DBus = mergeRules(a1.DBus, a2.DBus)
+	a1.ChangeProfile = mergeRules(a1.ChangeProfile, a2.ChangeProfile)
+
+	if a1.Mount != nil && a2.Mount != nil {
+		a1.Mount.Mounts = mergeRules(a1.Mount.Mounts, a2.Mount.Mounts)
+		a1.Mount.Umounts = mergeRules(a1.Mount.Umounts, a2.Mount.Umounts)
+		a1.Mount.PivotRoots = mergeRules(a1.Mount.PivotRoots, a2.Mount.PivotRoots)
+	} else if a2.Mount != nil {
+		a1.Mount = a2.Mount
+	}
+
 	return nil
 }
 
+// mergeRules appends the rules of b which are not part of a.
+func mergeRules[T any](a, b []T) []T {
+	for _, rule := range b {
+		if !slices.ContainsFunc(a, func(r T) bool { return reflect.DeepEqual(r, rule) }) {
+			a = append(a, rule)
+		}
+	}
+	return a
+}
+
+// mergeFileRules merges the permissions of file rules for the same path and
+// owner qualifier.
+func mergeFileRules(a, b []apparmorprofileapi.AppArmorFileRule) []apparmorprofileapi.AppArmorFileRule {
+	for _, rule := range b {
+		i := slices.IndexFunc(a, func(r apparmorprofileapi.AppArmorFileRule) bool {
+			return r.Path == rule.Path && r.Owner == rule.Owner
+		})
+		if i < 0 {
+			a = append(a, rule)
+			continue
+		}
+		for _, permission := range rule.Permissions {
+			if !slices.Contains(a[i].Permissions, permission) {
+				a[i].Permissions = append(a[i].Permissions, permission)
+			}
+		}
+	}
+	return a
+}
+
 func mergePaths(a, b *[]string) *[]string {
 	if a == nil {
 		return b
@@ -155,6 +201,7 @@ func mergeFilesystem(base, additions *apparmorprofileapi.AppArmorAbstract) {
 			ReadOnlyPaths:  r.Patterns(),
 			WriteOnlyPaths: w.Patterns(),
 			ReadWritePaths: rw.Patterns(),
+			FileRules:      mergeFileRules(base.Filesystem.FileRules, additions.Filesystem.FileRules),
 		}
 	} else if additions.Filesystem != nil {
 		base.Filesystem = additions.Filesystem
@@ -178,6 +178,40 @@ func TestMergeFilesystem(t *testing.T) {
 	}
 }
 
+func TestMergeFileRules(t *testing.T) {
+	t.Parallel()
+
+	read := apparmorprofileapi.AppArmorFilePermissionRead
+	write := apparmorprofileapi.AppArmorFilePermissionWrite
+	mmap := apparmorprofileapi.AppArmorFilePermissionMmap
+
+	merged := mergeFileRules(
+		[]apparmorprofileapi.AppArmorFileRule{
+			{Path: "/tmp/cache", Permissions: []apparmorprofileapi.AppArmorFilePermission{read, mmap}},
+		},
+		[]apparmorprofileapi.AppArmorFileRule{
+			{Path: "/tmp/cache", Permissions: []apparmorprofileapi.AppArmorFilePermission{read, write}},
+			{Path: "/tmp/cache", Permissions: []apparmorprofileapi.AppArmorFilePermission{read}, Owner: true},
+		},
+	)
+	require.Equal(t, []apparmorprofileapi.AppArmorFileRule{
+		{Path: "/tmp/cache", Permissions: []apparmorprofileapi.AppArmorFilePermission{read, mmap, write}},
+		{Path: "/tmp/cache", Permissions: []apparmorprofileapi.AppArmorFilePermission{read}, Owner: true},
+	}, merged)
+}
+
+func TestMergeRules(t *testing.T) {
+	t.Parallel()
+
+	merged := mergeRules(
+		[]apparmorprofileapi.AppArmorSignalRule{{Signals: []string{"term"}}},
+		[]apparmorprofileapi.AppArmorSignalRule{{Signals: []string{"term"}}, {Signals: []string{"hup"}}},
+	)
+	require.Equal(t, []apparmorprofileapi.AppArmorSignalRule{
+		{Signals: []string{"term"}}, {Signals: []string{"hup"}},
+	}, merged)
+}
+
 func TestMergeBools(t *testing.T) {
 	t.Parallel()
 
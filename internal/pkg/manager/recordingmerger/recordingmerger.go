@@ -21,9 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -46,11 +49,13 @@ const (
 	errCannotMergeKind    = "cannot merge profiles of kind"
 	errNoPartialProfiles  = "no partial profiles to merge"
 	errEmptyMergedProfile = "merged profile is empty"
+	errStopRecording      = "cannot check recording stop conditions"
 
 	reasonCannotMergeKind    string = "KindNotSupportedForMerge"
 	reasonCannotCreateUpdate string = "CannotCreateUpdateMergedProfile"
 	reasonMergedEmptyProfile string = "MergedEmptyProfile"
 	reasonNoPartialProfiles  string = "NoPartialProfiles"
+	reasonRecordingFinished  string = "RecordingFinished"
 )
 
 // NewController returns a new empty controller instance.
@@ -63,6 +68,9 @@ type PolicyMergeReconciler struct {
 	client client.Client
 	log    logr.Logger
 	record record.EventRecorder
+	// fingerprints contains the last observed state of the recorded profiles
+	// per recording UID to detect when the recording converged.
+	fingerprints sync.Map
 }
 
 // Name returns the name of the controller.
@@ -84,8 +92,10 @@ func (r *PolicyMergeReconciler) Healthz(*http.Request) error {
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/finalizers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 
 // Reconcile reconciles a NodeStatus.
 func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -106,39 +116,93 @@ func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Req
 	if !profileRecording.GetDeletionTimestamp().IsZero() { // object is being deleted
 		logger.Info("Is being deleted, will check if there are policies to be merged")
 
-		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
+		if err := r.mergeAndReport(ctx, profileRecording, true); err != nil {
 			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
 		}
 		return reconcile.Result{}, nil
 	}
 
-	// We don't really care until the recording is being deleted
-	return reconcile.Result{}, nil
+	if (profileRecording.IsContinuous() || profileRecording.IsFinished()) &&
+		profileRecording.Spec.MergeStrategy == profilerecording1alpha1.ProfileMergeContainers {
+		// Keep the merged profiles current with the snapshots of the
+		// running containers, but keep the partial profiles to be updated.
+		if err := r.mergeAndReport(ctx, profileRecording, false); err != nil {
+			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
+		}
+	}
+
+	if profileRecording.IsFinished() {
+		return reconcile.Result{}, nil
+	}
+
+	res, err := r.checkStopConditions(ctx, profileRecording)
+	if kerrors.IsConflict(err) {
+		logger.Info("Profile recording changed, retrying stop conditions")
+		return reconcile.Result{Requeue: true}, nil
+	} else if err != nil {
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errStopRecording, err)
+	}
+	return res, nil
 }
 
-func (r *PolicyMergeReconciler) mergeProfiles(
+// mergeAndReport merges the partial profiles of the recording and reports
+// the result in its status.
+func (r *PolicyMergeReconciler) mergeAndReport(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
+	final bool,
 ) error {
-	var err error
+	merged, mergeErr := r.mergeProfiles(ctx, profileRecording, final)
+
+	status := profileRecording.Status.DeepCopy()
+	if mergeErr != nil {
+		profileRecording.Status.LastError = mergeErr.Error()
+		profileRecording.Status.SetConditions(
+			profilerecording1alpha1.FailedCondition(profilerecording1alpha1.ReasonMergeFailed, mergeErr),
+		)
+	} else if len(merged) > 0 {
+		profileRecording.Status.SetConditions(
+			profilerecording1alpha1.MergedCondition(merged),
+			profilerecording1alpha1.SucceededCondition(profilerecording1alpha1.ReasonMerged),
+		)
+	}
+
+	if !equality.Semantic.DeepEqual(status, &profileRecording.Status) {
+		if err := r.client.Status().Update(ctx, profileRecording); util.IgnoreNotFound(err) != nil {
+			r.log.Error(err, "Cannot update profile recording status")
+		}
+	}
+
+	return mergeErr
+}
+
+func (r *PolicyMergeReconciler) mergeProfiles(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	final bool,
+) ([]string, error) {
+	var (
+		merged []string
+		err    error
+	)
 
 	switch profileRecording.Spec.Kind {
 	case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-		err = r.mergeSeccompProfiles(ctx, profileRecording)
+		merged, err = r.mergeSeccompProfiles(ctx, profileRecording, final)
 	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
-		err = r.mergeSelinuxProfiles(ctx, profileRecording)
+		merged, err = r.mergeSelinuxProfiles(ctx, profileRecording, final)
 	case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
-		err = r.mergeAppArmorProfiles(ctx, profileRecording)
+		merged, err = r.mergeAppArmorProfiles(ctx, profileRecording, final)
 	default:
 		err = fmt.Errorf("%s: %s", errCannotMergeKind, profileRecording.Spec.Kind)
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotMergeKind, err.Error())
 	}
 
 	if err != nil {
-		return fmt.Errorf("cannot merge profiles: %w", err)
+		return nil, fmt.Errorf("cannot merge profiles: %w", err)
 	}
 
-	return err
+	return merged, nil
 }
 
 func (r *PolicyMergeReconciler) mergeTypedProfiles(
@@ -147,42 +211,54 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 	createUpdateMergedProfile createUpdateFn,
 	profileItem client.Object,
 	listItem client.ObjectList,
-) error {
+	final bool,
+) ([]string, error) {
 	partialProfiles, err := listPartialProfiles(ctx, r.client, listItem, profileRecording)
 	if err != nil {
-		return fmt.Errorf("cannot list partial profiles: %w", err)
+		return nil, fmt.Errorf("cannot list partial profiles: %w", err)
 	}
 
 	if len(partialProfiles) == 0 {
+		if !final {
+			// No snapshot has been taken yet
+			return nil, nil
+		}
 		r.record.Event(profileRecording, util.EventTypeWarning, reasonNoPartialProfiles, errNoPartialProfiles)
 		r.log.Info(errNoPartialProfiles)
-		return nil
+		return nil, nil
 	}
 
+	merged := make([]string, 0, len(partialProfiles))
+
 	for cntName, cntPartialProfiles := range partialProfiles {
 		r.log.Info("Merging profiles for container", "container", cntName)
 
 		mergedProfile, err := mergeMergeableProfiles(cntPartialProfiles)
 		if err != nil {
-			return fmt.Errorf("cannot merge partial profiles: %w", err)
+			return nil, fmt.Errorf("cannot merge partial profiles: %w", err)
 		}
 
 		if mergedProfile == nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonMergedEmptyProfile, errEmptyMergedProfile)
 			r.log.Info(errEmptyMergedProfile)
-			return nil
+			return merged, nil
 		}
 
 		mergedRecordingName := mergedProfileName(profileRecording.Name, cntPartialProfiles[0])
 		res, err := createUpdateMergedProfile(ctx, r.client, profileRecording, mergedRecordingName, mergedProfile)
 		if err != nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotCreateUpdate, err.Error())
-			return fmt.Errorf("cannot create or update merged profile: action:  %w", err)
+			return nil, fmt.Errorf("cannot create or update merged profile: action:  %w", err)
 		}
 		r.log.Info("Created/updated profile", "action", res, "name", mergedRecordingName)
+		merged = append(merged, mergedRecordingName)
+	}
+
+	if !final {
+		return merged, nil
 	}
 
-	return deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
+	return merged, deletePartialProfiles(ctx, r.client, profileItem, profileRecording)
 }
 
 type createUpdateFn func(
@@ -196,37 +272,43 @@ type createUpdateFn func(
 func (r *PolicyMergeReconciler) mergeSeccompProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
-) error {
+	final bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateSeccompProfile,
 		&seccompprofile.SeccompProfile{},
-		&seccompprofile.SeccompProfileList{})
+		&seccompprofile.SeccompProfileList{},
+		final)
 }
 
 func (r *PolicyMergeReconciler) mergeSelinuxProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
-) error {
+	final bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateSelinuxProfile,
 		&selinuxprofileapi.SelinuxProfile{},
-		&selinuxprofileapi.SelinuxProfileList{})
+		&selinuxprofileapi.SelinuxProfileList{},
+		final)
 }
 
 func (r *PolicyMergeReconciler) mergeAppArmorProfiles(
 	ctx context.Context,
 	profileRecording *profilerecording1alpha1.ProfileRecording,
-) error {
+	final bool,
+) ([]string, error) {
 	return r.mergeTypedProfiles(
 		ctx,
 		profileRecording,
 		createUpdateApparmorProfile,
 		&apparmorprofileapi.AppArmorProfile{},
 		&apparmorprofileapi.AppArmorProfileList{},
+		final,
 	)
 }
 
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func partialSeccompProfile(name string, syscalls ...string) *seccompprofile.SeccompProfile {
+	return &seccompprofile.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				profilebase.ProfilePartialLabel:                 "true",
+				profilerecording1alpha1.ProfileToRecordingLabel: "recording",
+				profilerecording1alpha1.ProfileToContainerLabel: "nginx",
+			},
+		},
+		Spec: seccompprofile.SeccompProfileSpec{
+			DefaultAction: seccomp.ActErrno,
+			Syscalls: []*seccompprofile.Syscall{{
+				Action: seccomp.ActAllow,
+				Names:  syscalls,
+			}},
+		},
+	}
+}
+
+func TestReconcileMerge(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, profilerecording1alpha1.AddToScheme(s))
+	require.NoError(t, seccompprofile.AddToScheme(s))
+
+	now := metav1.Now()
+	for _, tc := range []struct {
+		name             string
+		recording        *profilerecording1alpha1.ProfileRecording
+		expectMerged     bool
+		expectedPartials int
+	}{
+		{
+			name: "one shot recording waits for deletion",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+				},
+			},
+			expectedPartials: 2,
+		},
+		{
+			name: "continuous recording keeps partial profiles",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					Mode:          profilerecording1alpha1.ProfileRecordingModeContinuous,
+					MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+				},
+			},
+			expectMerged:     true,
+			expectedPartials: 2,
+		},
+		{
+			name: "deleted recording removes partial profiles",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: &now,
+					Finalizers:        []string{"test"},
+				},
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					Mode:          profilerecording1alpha1.ProfileRecordingModeContinuous,
+					MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+				},
+			},
+			expectMerged: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.recording.Name = "recording"
+			tc.recording.Namespace = "default"
+			tc.recording.Spec.Kind = profilerecording1alpha1.ProfileRecordingKindSeccompProfile
+			cli := fake.NewClientBuilder().WithScheme(s).
+				WithStatusSubresource(&profilerecording1alpha1.ProfileRecording{}).
+				WithObjects(
+					tc.recording,
+					partialSeccompProfile("recording-nginx-a", "read"),
+					partialSeccompProfile("recording-nginx-b", "write"),
+				).Build()
+			sut := &PolicyMergeReconciler{client: cli, log: logr.Discard(), record: record.NewFakeRecorder(10)}
+			ctx := context.Background()
+
+			key := types.NamespacedName{Namespace: "default", Name: "recording"}
+			_, err := sut.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			merged := &seccompprofile.SeccompProfile{}
+			err = cli.Get(ctx, types.NamespacedName{Namespace: "default", Name: "recording-nginx"}, merged)
+			if tc.expectMerged {
+				require.NoError(t, err)
+				require.NotContains(t, merged.Labels, profilebase.ProfilePartialLabel)
+				require.Len(t, merged.Spec.Syscalls, 2)
+			} else {
+				require.Error(t, err)
+			}
+
+			partials := &seccompprofile.SeccompProfileList{}
+			require.NoError(t, cli.List(ctx, partials, client.MatchingLabels{
+				profilebase.ProfilePartialLabel: "true",
+			}))
+			require.Len(t, partials.Items, tc.expectedPartials)
+
+			recording := &profilerecording1alpha1.ProfileRecording{}
+			require.NoError(t, cli.Get(ctx, key, recording))
+			cond, ok := recording.Status.GetCondition(profilerecording1alpha1.ConditionTypeMerged)
+			require.Equal(t, tc.expectMerged, ok)
+			if tc.expectMerged {
+				require.Equal(t, corev1.ConditionTrue, cond.Status)
+				require.Equal(t, "recording-nginx", cond.Message)
+			}
+		})
+	}
+}
@@ -19,9 +19,19 @@ package recordingmerger
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
@@ -35,9 +45,31 @@ func (r *PolicyMergeReconciler) Setup(
 	r.log = ctrl.Log.WithName(r.Name())
 	r.record = mgr.GetEventRecorderFor(r.Name())
 
+	// Recorded profiles get updated by the snapshots of continuous recordings
+	// and deleted after the final merge, which requires no further action.
+	recordedProfile := builder.WithPredicates(
+		predicate.NewPredicateFuncs(isRecordedProfile),
+		predicate.Funcs{DeleteFunc: func(event.DeleteEvent) bool { return false }},
+	)
+	toRecording := handler.EnqueueRequestsFromMapFunc(recordingForProfile)
+
 	// Register a special reconciler for status events
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(r.Name()).
 		For(&profilerecording1alpha1.ProfileRecording{}).
+		Watches(&seccompprofile.SeccompProfile{}, toRecording, recordedProfile).
+		Watches(&selinuxprofileapi.SelinuxProfile{}, toRecording, recordedProfile).
+		Watches(&apparmorprofileapi.AppArmorProfile{}, toRecording, recordedProfile).
 		Complete(r)
 }
+
+func isRecordedProfile(obj client.Object) bool {
+	return obj.GetLabels()[profilerecording1alpha1.ProfileToRecordingLabel] != ""
+}
+
+func recordingForProfile(_ context.Context, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetLabels()[profilerecording1alpha1.ProfileToRecordingLabel],
+	}}}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// checkStopConditions finishes the recording if one of its stop conditions
+// is met, and requeues it for the next point in time a condition may be met.
+func (r *PolicyMergeReconciler) checkStopConditions(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (reconcile.Result, error) {
+	now := time.Now()
+	statusChanged := false
+	var (
+		reason  profilerecording1alpha1.ProfileRecordingStopReason
+		requeue time.Duration
+	)
+
+	nextCheck := func(remaining time.Duration) {
+		if requeue == 0 || remaining < requeue {
+			requeue = remaining
+		}
+	}
+
+	if _, ok := profileRecording.GetAnnotations()[profilerecording1alpha1.StopRecordingAnnotation]; ok {
+		reason = profilerecording1alpha1.ProfileRecordingStopReasonStopped
+	}
+
+	if reason == "" && profileRecording.Spec.MaxDuration != nil {
+		remaining := profileRecording.CreationTimestamp.Add(profileRecording.Spec.MaxDuration.Duration).Sub(now)
+		if remaining <= 0 {
+			reason = profilerecording1alpha1.ProfileRecordingStopReasonMaxDuration
+		} else {
+			nextCheck(remaining)
+		}
+	}
+
+	if reason == "" && profileRecording.Spec.ConvergenceTimeout != nil && profileRecording.IsContinuous() {
+		changed, err := r.profilesChanged(ctx, profileRecording)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if changed {
+			profileRecording.Status.LastProfileChange = &metav1.Time{Time: now}
+			statusChanged = true
+		}
+
+		// Convergence starts with the first recorded profile
+		if lastChange := profileRecording.Status.LastProfileChange; lastChange != nil {
+			remaining := lastChange.Add(profileRecording.Spec.ConvergenceTimeout.Duration).Sub(now)
+			if remaining <= 0 {
+				reason = profilerecording1alpha1.ProfileRecordingStopReasonConverged
+			} else {
+				nextCheck(remaining)
+			}
+		}
+	}
+
+	if reason != "" {
+		r.log.Info("Finishing profile recording", "reason", reason)
+		profileRecording.Status.FinishedAt = &metav1.Time{Time: now}
+		profileRecording.Status.StopReason = reason
+		profileRecording.Status.SetConditions(profilerecording1alpha1.FinishedCondition(reason))
+		statusChanged = true
+	}
+
+	if statusChanged {
+		if err := r.client.Status().Update(ctx, profileRecording); err != nil {
+			return reconcile.Result{}, fmt.Errorf("update profile recording status: %w", err)
+		}
+	}
+
+	if reason != "" {
+		r.fingerprints.Delete(profileRecording.GetUID())
+		r.record.Event(profileRecording, util.EventTypeNormal, reasonRecordingFinished,
+			fmt.Sprintf("profile recording finished: %s", reason))
+	}
+
+	return reconcile.Result{RequeueAfter: requeue}, nil
+}
+
+// profilesChanged returns true if the profiles produced by the recording
+// changed since the last check. Any spec change of a profile increments its
+// generation, which allows to ignore status only updates.
+func (r *PolicyMergeReconciler) profilesChanged(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (bool, error) {
+	var list client.ObjectList
+	switch profileRecording.Spec.Kind {
+	case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
+		list = &seccompprofile.SeccompProfileList{}
+	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
+		list = &selinuxprofileapi.SelinuxProfileList{}
+	case profilerecording1alpha1.ProfileRecordingKindAppArmorProfile:
+		list = &apparmorprofileapi.AppArmorProfileList{}
+	default:
+		return false, fmt.Errorf("%s: %s", errCannotMergeKind, profileRecording.Spec.Kind)
+	}
+
+	if err := r.client.List(ctx, list,
+		client.InNamespace(profileRecording.Namespace),
+		client.MatchingLabels{profilerecording1alpha1.ProfileToRecordingLabel: profileRecording.Name},
+	); err != nil {
+		return false, fmt.Errorf("list recorded profiles: %w", err)
+	}
+
+	mergeContainers := profileRecording.Spec.MergeStrategy == profilerecording1alpha1.ProfileMergeContainers
+	generations := []string{}
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		prf, err := meta.Accessor(obj)
+		if err != nil {
+			return fmt.Errorf("access profile metadata: %w", err)
+		}
+		if _, partial := prf.GetLabels()[profilebase.ProfilePartialLabel]; mergeContainers && !partial {
+			// Merged profiles only change with their partial profiles
+			return nil
+		}
+		generations = append(generations, fmt.Sprintf("%s/%d", prf.GetUID(), prf.GetGeneration()))
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("iterate recorded profiles: %w", err)
+	}
+
+	if len(generations) == 0 {
+		return false, nil
+	}
+
+	slices.Sort(generations)
+	fingerprint := strings.Join(generations, ",")
+	previous, ok := r.fingerprints.Swap(profileRecording.GetUID(), fingerprint)
+
+	// The fingerprints get lost on restarts, so only the first observed
+	// profile of a recording counts as change in this case.
+	if !ok {
+		return profileRecording.Status.LastProfileChange == nil, nil
+	}
+	return previous != fingerprint, nil
+}
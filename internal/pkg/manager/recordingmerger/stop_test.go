@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestReconcileStopConditions(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, profilerecording1alpha1.AddToScheme(s))
+	require.NoError(t, seccompprofile.AddToScheme(s))
+
+	created := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	for _, tc := range []struct {
+		name           string
+		recording      *profilerecording1alpha1.ProfileRecording
+		expectedReason profilerecording1alpha1.ProfileRecordingStopReason
+		expectRequeue  bool
+	}{
+		{
+			name: "no stop conditions",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{},
+			},
+		},
+		{
+			name: "stopped by annotation",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{profilerecording1alpha1.StopRecordingAnnotation: "true"},
+				},
+			},
+			expectedReason: profilerecording1alpha1.ProfileRecordingStopReasonStopped,
+		},
+		{
+			name: "max duration reached",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					MaxDuration: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expectedReason: profilerecording1alpha1.ProfileRecordingStopReasonMaxDuration,
+		},
+		{
+			name: "max duration not reached",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					MaxDuration: &metav1.Duration{Duration: 3 * time.Hour},
+				},
+			},
+			expectRequeue: true,
+		},
+		{
+			name: "converged",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					Mode:               profilerecording1alpha1.ProfileRecordingModeContinuous,
+					ConvergenceTimeout: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: profilerecording1alpha1.ProfileRecordingStatus{
+					LastProfileChange: &created,
+				},
+			},
+			expectedReason: profilerecording1alpha1.ProfileRecordingStopReasonConverged,
+		},
+		{
+			name: "convergence starts with first profile",
+			recording: &profilerecording1alpha1.ProfileRecording{
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					Mode:               profilerecording1alpha1.ProfileRecordingModeContinuous,
+					ConvergenceTimeout: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			expectRequeue: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.recording.Name = "recording"
+			tc.recording.Namespace = "default"
+			tc.recording.CreationTimestamp = created
+			tc.recording.Spec.Kind = profilerecording1alpha1.ProfileRecordingKindSeccompProfile
+			cli := fake.NewClientBuilder().WithScheme(s).
+				WithStatusSubresource(&profilerecording1alpha1.ProfileRecording{}).
+				WithObjects(tc.recording, partialSeccompProfile("recording-nginx-a", "read")).
+				Build()
+			sut := &PolicyMergeReconciler{client: cli, log: logr.Discard(), record: record.NewFakeRecorder(10)}
+			ctx := context.Background()
+
+			key := types.NamespacedName{Namespace: "default", Name: "recording"}
+			res, err := sut.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectRequeue, res.RequeueAfter > 0)
+
+			recording := &profilerecording1alpha1.ProfileRecording{}
+			require.NoError(t, cli.Get(ctx, key, recording))
+			require.Equal(t, tc.expectedReason, recording.Status.StopReason)
+			require.Equal(t, tc.expectedReason != "", recording.IsFinished())
+			if tc.expectedReason != "" {
+				cond, ok := recording.Status.GetCondition(profilerecording1alpha1.ConditionTypeRecording)
+				require.True(t, ok)
+				require.Equal(t, profilerecording1alpha1.ReasonFinished, cond.Reason)
+			}
+		})
+	}
+}
+
+func TestProfilesChanged(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, seccompprofile.AddToScheme(s))
+
+	recording := &profilerecording1alpha1.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{Name: "recording", Namespace: "default", UID: "uid"},
+		Spec: profilerecording1alpha1.ProfileRecordingSpec{
+			Kind:          profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+			MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).Build()
+	sut := &PolicyMergeReconciler{client: cli, log: logr.Discard()}
+	ctx := context.Background()
+
+	// No profiles recorded yet
+	changed, err := sut.profilesChanged(ctx, recording)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	partial := partialSeccompProfile("recording-nginx-a", "read")
+	require.NoError(t, cli.Create(ctx, partial))
+	changed, err = sut.profilesChanged(ctx, recording)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// The merged profile does not count as change
+	require.NoError(t, cli.Create(ctx, &seccompprofile.SeccompProfile{
+		ObjectMeta: *mergedObjectMeta("recording-nginx", "recording", "default"),
+	}))
+	changed, err = sut.profilesChanged(ctx, recording)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	require.NoError(t, cli.Get(ctx, client.ObjectKeyFromObject(partial), partial))
+	partial.SetGeneration(partial.GetGeneration() + 1)
+	require.NoError(t, cli.Update(ctx, partial))
+	changed, err = sut.profilesChanged(ctx, recording)
+	require.NoError(t, err)
+	require.True(t, changed)
+}
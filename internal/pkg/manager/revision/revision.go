@@ -0,0 +1,360 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	// DefaultHistoryLimit is the number of kept revisions per profile if
+	// not configured otherwise.
+	DefaultHistoryLimit = 10
+
+	reasonRolledBack     = "ProfileRolledBack"
+	reasonRollbackFailed = "ProfileRollbackFailed"
+)
+
+// NewSeccompController returns a new empty controller instance for the
+// revisions of SeccompProfiles.
+func NewSeccompController() controller.Controller {
+	return &Reconciler{
+		kind: "SeccompProfile",
+		newProfile: func() profilebasev1alpha1.SecurityProfileBase {
+			return &seccompprofileapi.SeccompProfile{}
+		},
+		schemeBuilder: seccompprofileapi.SchemeBuilder,
+	}
+}
+
+// NewAppArmorController returns a new empty controller instance for the
+// revisions of AppArmorProfiles.
+func NewAppArmorController() controller.Controller {
+	return &Reconciler{
+		kind: "AppArmorProfile",
+		newProfile: func() profilebasev1alpha1.SecurityProfileBase {
+			return &apparmorprofileapi.AppArmorProfile{}
+		},
+		schemeBuilder: apparmorprofileapi.SchemeBuilder,
+	}
+}
+
+// A Reconciler keeps the revision history of profiles and rolls them back.
+type Reconciler struct {
+	client    client.Client
+	reader    client.Reader
+	log       logr.Logger
+	record    record.EventRecorder
+	namespace string
+
+	kind          string
+	newProfile    func() profilebasev1alpha1.SecurityProfileBase
+	schemeBuilder *scheme.Builder
+}
+
+// Name returns the name of the controller.
+func (r *Reconciler) Name() string {
+	return "revision-" + strings.ToLower(r.kind)
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Reconciler) SchemeBuilder() *scheme.Builder {
+	return r.schemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Reconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to keep the profile revisions
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles;apparmorprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status;apparmorprofiles/status,verbs=get;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;create;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+
+// Reconcile stores the current revision of a profile and performs requested
+// rollbacks.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	prof := r.newProfile()
+	if err := r.client.Get(ctx, req.NamespacedName, prof); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+	if prof.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if revision, ok := prof.GetAnnotations()[profilebasev1alpha1.RollbackToRevisionAnnotation]; ok {
+		return reconcile.Result{}, r.rollback(ctx, logger, prof, revision)
+	}
+
+	limit, err := r.historyLimit(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if limit > 0 {
+		if err := r.storeRevision(ctx, prof); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	if err := r.pruneRevisions(ctx, prof, limit); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.setStatusRevision(ctx, prof); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// historyLimit returns the configured number of kept revisions.
+func (r *Reconciler) historyLimit(ctx context.Context) (int, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: r.namespace}
+	if err := r.client.Get(ctx, key, spod); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return DefaultHistoryLimit, nil
+		}
+		return 0, fmt.Errorf("get spod: %w", err)
+	}
+	if spod.Spec.ProfileRevisionHistoryLimit == nil {
+		return DefaultHistoryLimit, nil
+	}
+	return int(*spod.Spec.ProfileRevisionHistoryLimit), nil
+}
+
+// storeRevision stores the spec of the current profile generation.
+func (r *Reconciler) storeRevision(ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase) error {
+	spec, err := specOf(prof)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("marshal profile spec: %w", err)
+	}
+
+	revision := prof.GetGeneration()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      util.ProfileRevisionName(r.kind, prof.GetName(), revision),
+			Namespace: prof.GetNamespace(),
+			Labels: map[string]string{
+				profilebasev1alpha1.ProfileRevisionOfLabel: util.ProfileRevisionOf(r.kind, prof.GetName()),
+				profilebasev1alpha1.ProfileRevisionLabel:   strconv.FormatInt(revision, 10),
+			},
+		},
+		Data: map[string]string{profilebasev1alpha1.ProfileRevisionSpecKey: string(data)},
+	}
+	if err := controllerutil.SetOwnerReference(prof, cm, r.client.Scheme()); err != nil {
+		return fmt.Errorf("set revision owner: %w", err)
+	}
+
+	if err := r.client.Create(ctx, cm); err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("create revision: %w", err)
+	}
+	return nil
+}
+
+// pruneRevisions removes the oldest revisions exceeding the limit.
+func (r *Reconciler) pruneRevisions(
+	ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase, limit int,
+) error {
+	revisions, err := ListRevisions(ctx, r.reader, r.kind, prof.GetNamespace(), prof.GetName())
+	if err != nil {
+		return err
+	}
+	if len(revisions) <= limit {
+		return nil
+	}
+
+	for i := range revisions[:len(revisions)-limit] {
+		if err := r.client.Delete(ctx, &revisions[i]); util.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete revision %s: %w", revisions[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) setStatusRevision(ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase) error {
+	patched, ok := prof.DeepCopyObject().(profilebasev1alpha1.SecurityProfileBase)
+	if !ok {
+		return fmt.Errorf("copy profile %s", prof.GetName())
+	}
+	revision, err := statusRevisionOf(patched)
+	if err != nil {
+		return err
+	}
+	if *revision == prof.GetGeneration() {
+		return nil
+	}
+	*revision = prof.GetGeneration()
+
+	if err := r.client.Status().Patch(ctx, patched, client.MergeFrom(prof)); err != nil {
+		return fmt.Errorf("patch profile revision: %w", err)
+	}
+	return nil
+}
+
+// rollback restores the spec of the profile from the requested revision.
+func (r *Reconciler) rollback(
+	ctx context.Context, logger logr.Logger, prof profilebasev1alpha1.SecurityProfileBase, value string,
+) error {
+	patched, ok := prof.DeepCopyObject().(profilebasev1alpha1.SecurityProfileBase)
+	if !ok {
+		return fmt.Errorf("copy profile %s", prof.GetName())
+	}
+	annotations := patched.GetAnnotations()
+	delete(annotations, profilebasev1alpha1.RollbackToRevisionAnnotation)
+	patched.SetAnnotations(annotations)
+
+	if err := r.restoreSpec(ctx, patched, value); err != nil {
+		// Drop the annotation to not retry an impossible rollback
+		logger.Error(err, "cannot roll back profile", "revision", value)
+		r.record.Eventf(prof, corev1.EventTypeWarning, reasonRollbackFailed,
+			"Cannot roll back to revision %s: %v", value, err)
+	} else {
+		logger.Info("Rolling back profile", "revision", value)
+		r.record.Eventf(prof, corev1.EventTypeNormal, reasonRolledBack, "Rolled back to revision %s", value)
+	}
+
+	if err := r.client.Update(ctx, patched); err != nil {
+		return fmt.Errorf("update profile: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) restoreSpec(ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase, value string) error {
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{
+		Namespace: prof.GetNamespace(),
+		Name:      util.ProfileRevisionName(r.kind, prof.GetName(), revision),
+	}
+	if err := r.reader.Get(ctx, key, cm); err != nil {
+		return fmt.Errorf("get revision: %w", err)
+	}
+
+	return resetSpec(prof, []byte(cm.Data[profilebasev1alpha1.ProfileRevisionSpecKey]))
+}
+
+// ListRevisions returns the revision ConfigMaps of a profile, sorted from the
+// oldest to the newest revision.
+func ListRevisions(
+	ctx context.Context, reader client.Reader, kind, namespace, name string,
+) ([]corev1.ConfigMap, error) {
+	list := &corev1.ConfigMapList{}
+	if err := reader.List(ctx, list,
+		client.InNamespace(namespace),
+		client.MatchingLabels{profilebasev1alpha1.ProfileRevisionOfLabel: util.ProfileRevisionOf(kind, name)},
+	); err != nil {
+		return nil, fmt.Errorf("list revisions: %w", err)
+	}
+
+	slices.SortFunc(list.Items, func(a, b corev1.ConfigMap) int {
+		return int(Revision(&a) - Revision(&b))
+	})
+	return list.Items, nil
+}
+
+// Revision returns the revision stored in a revision ConfigMap.
+func Revision(cm *corev1.ConfigMap) int64 {
+	//nolint:errcheck // invalid revisions sort first
+	revision, _ := strconv.ParseInt(cm.Labels[profilebasev1alpha1.ProfileRevisionLabel], 10, 64)
+	return revision
+}
+
+func specOf(prof profilebasev1alpha1.SecurityProfileBase) (any, error) {
+	switch obj := prof.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return &obj.Spec, nil
+	case *apparmorprofileapi.AppArmorProfile:
+		return &obj.Spec, nil
+	default:
+		return nil, fmt.Errorf("unsupported profile %T", prof)
+	}
+}
+
+func resetSpec(prof profilebasev1alpha1.SecurityProfileBase, data []byte) error {
+	switch obj := prof.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		spec := seccompprofileapi.SeccompProfileSpec{}
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return fmt.Errorf("unmarshal profile spec: %w", err)
+		}
+		obj.Spec = spec
+	case *apparmorprofileapi.AppArmorProfile:
+		spec := apparmorprofileapi.AppArmorProfileSpec{}
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return fmt.Errorf("unmarshal profile spec: %w", err)
+		}
+		obj.Spec = spec
+	default:
+		return fmt.Errorf("unsupported profile %T", prof)
+	}
+	return nil
+}
+
+func statusRevisionOf(prof profilebasev1alpha1.SecurityProfileBase) (*int64, error) {
+	switch obj := prof.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return &obj.Status.Revision, nil
+	case *apparmorprofileapi.AppArmorProfile:
+		return &obj.Status.Revision, nil
+	default:
+		return nil, fmt.Errorf("unsupported profile %T", prof)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revision
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	profileName      = "nginx"
+	profileNamespace = "team-a"
+)
+
+func revisionConfigMap(revision int64, action seccomp.Action) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "seccompprofile-nginx-rev-" + strconv.FormatInt(revision, 10),
+			Namespace: profileNamespace,
+			Labels: map[string]string{
+				profilebasev1alpha1.ProfileRevisionOfLabel: "seccompprofile-nginx",
+				profilebasev1alpha1.ProfileRevisionLabel:   strconv.FormatInt(revision, 10),
+			},
+		},
+		Data: map[string]string{
+			profilebasev1alpha1.ProfileRevisionSpecKey: `{"defaultAction":"` + string(action) + `"}`,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, spodv1alpha1.AddToScheme(s))
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+
+	for _, tc := range []struct {
+		name              string
+		limit             *int32
+		rollback          string
+		revisions         []*corev1.ConfigMap
+		expectedRevisions []int64
+		expectedAction    seccomp.Action
+		expectedStatus    int64
+	}{
+		{
+			name:              "stores revision",
+			expectedRevisions: []int64{3},
+			expectedAction:    seccomp.ActErrno,
+			expectedStatus:    3,
+		},
+		{
+			name:  "prunes old revisions",
+			limit: ptr.To[int32](2),
+			revisions: []*corev1.ConfigMap{
+				revisionConfigMap(1, seccomp.ActLog),
+				revisionConfigMap(2, seccomp.ActAllow),
+			},
+			expectedRevisions: []int64{2, 3},
+			expectedAction:    seccomp.ActErrno,
+			expectedStatus:    3,
+		},
+		{
+			name:  "history disabled",
+			limit: ptr.To[int32](0),
+			revisions: []*corev1.ConfigMap{
+				revisionConfigMap(2, seccomp.ActAllow),
+			},
+			expectedRevisions: []int64{},
+			expectedAction:    seccomp.ActErrno,
+			expectedStatus:    3,
+		},
+		{
+			name:     "rolls back",
+			rollback: "1",
+			revisions: []*corev1.ConfigMap{
+				revisionConfigMap(1, seccomp.ActLog),
+				revisionConfigMap(2, seccomp.ActAllow),
+			},
+			expectedRevisions: []int64{1, 2},
+			expectedAction:    seccomp.ActLog,
+		},
+		{
+			name:     "rollback to missing revision",
+			rollback: "5",
+			revisions: []*corev1.ConfigMap{
+				revisionConfigMap(2, seccomp.ActAllow),
+			},
+			expectedRevisions: []int64{2},
+			expectedAction:    seccomp.ActErrno,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       profileName,
+					Namespace:  profileNamespace,
+					Generation: 3,
+				},
+				Spec: seccompprofileapi.SeccompProfileSpec{DefaultAction: seccomp.ActErrno},
+			}
+			if tc.rollback != "" {
+				profile.Annotations = map[string]string{
+					profilebasev1alpha1.RollbackToRevisionAnnotation: tc.rollback,
+				}
+			}
+			spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+				ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: config.OperatorName},
+				Spec:       spodv1alpha1.SPODSpec{ProfileRevisionHistoryLimit: tc.limit},
+			}
+			objs := []client.Object{profile, spod}
+			for _, cm := range tc.revisions {
+				objs = append(objs, cm)
+			}
+
+			cli := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(objs...).
+				WithStatusSubresource(profile).
+				Build()
+			sut, ok := NewSeccompController().(*Reconciler)
+			require.True(t, ok)
+			sut.client = cli
+			sut.reader = cli
+			sut.log = logr.Discard()
+			sut.record = record.NewFakeRecorder(10)
+			sut.namespace = config.OperatorName
+			ctx := context.Background()
+
+			key := types.NamespacedName{Name: profileName, Namespace: profileNamespace}
+			_, err := sut.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			res := &seccompprofileapi.SeccompProfile{}
+			require.NoError(t, cli.Get(ctx, key, res))
+			require.Equal(t, tc.expectedAction, res.Spec.DefaultAction)
+			require.Equal(t, tc.expectedStatus, res.Status.Revision)
+			require.NotContains(t, res.Annotations, profilebasev1alpha1.RollbackToRevisionAnnotation)
+
+			revisions, err := ListRevisions(ctx, cli, "SeccompProfile", profileNamespace, profileName)
+			require.NoError(t, err)
+			found := []int64{}
+			for i := range revisions {
+				found = append(found, Revision(&revisions[i]))
+			}
+			require.Equal(t, tc.expectedRevisions, found)
+		})
+	}
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	// haltedRequeueInterval is the interval to check if a halted rollout
+	// can continue.
+	haltedRequeueInterval = 1 * time.Minute
+
+	defaultMaxUnavailable    = 1
+	defaultObservationPeriod = 5 * time.Minute
+
+	reasonRolloutBatch  = "ProfileRolloutBatch"
+	reasonRolloutHalted = "ProfileRolloutHalted"
+)
+
+// NewSeccompController returns a new empty controller instance for the
+// rollout of SeccompProfiles.
+func NewSeccompController() controller.Controller {
+	return &Reconciler{
+		kind: "SeccompProfile",
+		newProfile: func() profilebasev1alpha1.SecurityProfileBase {
+			return &seccompprofileapi.SeccompProfile{}
+		},
+		violates:      seccompViolation,
+		schemeBuilder: seccompprofileapi.SchemeBuilder,
+	}
+}
+
+// NewAppArmorController returns a new empty controller instance for the
+// rollout of AppArmorProfiles.
+func NewAppArmorController() controller.Controller {
+	return &Reconciler{
+		kind: "AppArmorProfile",
+		newProfile: func() profilebasev1alpha1.SecurityProfileBase {
+			return &apparmorprofileapi.AppArmorProfile{}
+		},
+		violates:      appArmorViolation,
+		schemeBuilder: apparmorprofileapi.SchemeBuilder,
+	}
+}
+
+// NewRawAppArmorController returns a new empty controller instance for the
+// rollout of RawAppArmorProfiles.
+func NewRawAppArmorController() controller.Controller {
+	return &Reconciler{
+		kind: "RawAppArmorProfile",
+		newProfile: func() profilebasev1alpha1.SecurityProfileBase {
+			return &apparmorprofileapi.RawAppArmorProfile{}
+		},
+		violates:      appArmorViolation,
+		schemeBuilder: apparmorprofileapi.SchemeBuilder,
+	}
+}
+
+// A Reconciler allows the nodes to install changed profiles in batches.
+type Reconciler struct {
+	client    client.Client
+	log       logr.Logger
+	record    record.EventRecorder
+	namespace string
+
+	kind          string
+	newProfile    func() profilebasev1alpha1.SecurityProfileBase
+	violates      func(*violationreportapi.ContainerViolations, profilebasev1alpha1.SecurityProfileBase, time.Time) bool
+	schemeBuilder *scheme.Builder
+}
+
+// Name returns the name of the controller.
+func (r *Reconciler) Name() string {
+	return "rollout-" + strings.ToLower(r.kind)
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *Reconciler) SchemeBuilder() *scheme.Builder {
+	return r.schemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *Reconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to roll out changed profiles
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles;apparmorprofiles;rawapparmorprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profileviolationreports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create
+
+// Reconcile allows the next batch of nodes to install a changed profile.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	prof := r.newProfile()
+	if err := r.client.Get(ctx, req.NamespacedName, prof); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+	if prof.GetDeletionTimestamp() != nil || !prof.IsReconcilable() {
+		return reconcile.Result{}, nil
+	}
+
+	strategy, err := r.rolloutStrategy(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if strategy == nil {
+		return reconcile.Result{}, nil
+	}
+
+	statuses, err := r.listStatuses(ctx, prof)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	generation := prof.GetGeneration()
+	pending := []*statusv1alpha1.SecurityProfileNodeStatus{}
+	var lastRollout time.Time
+	for i := range statuses.Items {
+		status := &statuses.Items[i]
+		if status.Status == statusv1alpha1.ProfileStateError {
+			logger.Info("Halting rollout because of a failed installation", "node", status.NodeName)
+			r.record.Eventf(prof, corev1.EventTypeWarning, reasonRolloutHalted,
+				"Halting rollout of generation %d: installation failed on node %s", generation, status.NodeName)
+			return reconcile.Result{RequeueAfter: haltedRequeueInterval}, nil
+		}
+
+		// Nodes without an installed generation either did not install the
+		// profile yet or have a legacy status, which is considered current.
+		if status.InstalledGeneration == 0 || status.InstalledGeneration >= generation {
+			continue
+		}
+		if status.Spec.RolloutGeneration >= generation {
+			logger.V(config.VerboseLevel).Info("Waiting for node to install the profile", "node", status.NodeName)
+			return reconcile.Result{}, nil
+		}
+		pending = append(pending, status)
+	}
+	if len(pending) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	for i := range statuses.Items {
+		status := &statuses.Items[i]
+		if status.Spec.RolloutGeneration >= generation && status.Spec.RolloutTime != nil &&
+			status.Spec.RolloutTime.After(lastRollout) {
+			lastRollout = status.Spec.RolloutTime.Time
+		}
+	}
+
+	if !lastRollout.IsZero() {
+		observationPeriod := defaultObservationPeriod
+		if strategy.ObservationPeriod != nil {
+			observationPeriod = strategy.ObservationPeriod.Duration
+		}
+		if remaining := time.Until(lastRollout.Add(observationPeriod)); remaining > 0 {
+			logger.V(config.VerboseLevel).Info("Observing the previous batch", "remaining", remaining)
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+
+		violated, err := r.violatedSince(ctx, prof, lastRollout)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if violated {
+			logger.Info("Halting rollout because of new violations")
+			r.record.Eventf(prof, corev1.EventTypeWarning, reasonRolloutHalted,
+				"Halting rollout of generation %d: new violations since the last batch", generation)
+			return reconcile.Result{RequeueAfter: haltedRequeueInterval}, nil
+		}
+	}
+
+	batch, err := r.nextBatch(ctx, strategy, pending)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := metav1.Now()
+	nodes := make([]string, 0, len(batch))
+	for _, status := range batch {
+		patched := status.DeepCopy()
+		patched.Spec.RolloutGeneration = generation
+		patched.Spec.RolloutTime = &now
+		if err := r.client.Patch(ctx, patched, client.MergeFrom(status)); err != nil {
+			return reconcile.Result{}, fmt.Errorf("patch node status: %w", err)
+		}
+		nodes = append(nodes, status.NodeName)
+	}
+
+	logger.Info("Allowed nodes to install the changed profile", "generation", generation, "nodes", nodes)
+	r.record.Eventf(prof, corev1.EventTypeNormal, reasonRolloutBatch,
+		"Rolling out generation %d to nodes %s", generation, strings.Join(nodes, ", "))
+	return reconcile.Result{}, nil
+}
+
+// rolloutStrategy returns the configured rollout strategy or nil if the
+// staged rollout is disabled.
+func (r *Reconciler) rolloutStrategy(ctx context.Context) (*spodv1alpha1.ProfileRolloutStrategy, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: r.namespace}
+	if err := r.client.Get(ctx, key, spod); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod.Spec.ProfileRollout, nil
+}
+
+func (r *Reconciler) listStatuses(
+	ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase,
+) (*statusv1alpha1.SecurityProfileNodeStatusList, error) {
+	gvk, err := apiutil.GVKForObject(prof, r.client.Scheme())
+	if err != nil {
+		return nil, fmt.Errorf("get profile kind: %w", err)
+	}
+	prof.GetObjectKind().SetGroupVersionKind(gvk)
+
+	statuses := &statusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := r.client.List(ctx, statuses,
+		client.InNamespace(prof.GetNamespace()),
+		client.MatchingLabels{statusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(prof)},
+	); err != nil {
+		return nil, fmt.Errorf("list node statuses: %w", err)
+	}
+	return statuses, nil
+}
+
+// violatedSince returns true if the profile got violated after the given time.
+func (r *Reconciler) violatedSince(
+	ctx context.Context, prof profilebasev1alpha1.SecurityProfileBase, since time.Time,
+) (bool, error) {
+	reports := &violationreportapi.ProfileViolationReportList{}
+	if err := r.client.List(ctx, reports, client.InNamespace(prof.GetNamespace())); err != nil {
+		return false, fmt.Errorf("list violation reports: %w", err)
+	}
+
+	for i := range reports.Items {
+		report := &reports.Items[i]
+		if report.LastSeen == nil || !report.LastSeen.After(since) {
+			continue
+		}
+		for j := range report.Containers {
+			if r.violates(&report.Containers[j], prof, since) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// nextBatch selects the nodes which are allowed to install the profile next.
+// Canary nodes are selected first.
+func (r *Reconciler) nextBatch(
+	ctx context.Context,
+	strategy *spodv1alpha1.ProfileRolloutStrategy,
+	pending []*statusv1alpha1.SecurityProfileNodeStatus,
+) ([]*statusv1alpha1.SecurityProfileNodeStatus, error) {
+	canaries := map[string]bool{}
+	if strategy.CanaryNodeSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(strategy.CanaryNodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("parse canary node selector: %w", err)
+		}
+		nodes := &corev1.NodeList{}
+		if err := r.client.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("list canary nodes: %w", err)
+		}
+		for i := range nodes.Items {
+			canaries[nodes.Items[i].Name] = true
+		}
+	}
+
+	slices.SortFunc(pending, func(a, b *statusv1alpha1.SecurityProfileNodeStatus) int {
+		if canaries[a.NodeName] != canaries[b.NodeName] {
+			if canaries[a.NodeName] {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.NodeName, b.NodeName)
+	})
+
+	maxUnavailable := int(strategy.MaxUnavailable)
+	if maxUnavailable < 1 {
+		maxUnavailable = defaultMaxUnavailable
+	}
+	if canaries[pending[0].NodeName] {
+		// Finish the canary nodes before rolling out to the other nodes
+		canaryCount := 0
+		for _, status := range pending {
+			if canaries[status.NodeName] {
+				canaryCount++
+			}
+		}
+		maxUnavailable = min(maxUnavailable, canaryCount)
+	}
+	return pending[:min(maxUnavailable, len(pending))], nil
+}
+
+func seccompViolation(
+	container *violationreportapi.ContainerViolations, prof profilebasev1alpha1.SecurityProfileBase, since time.Time,
+) bool {
+	sp, ok := prof.(*seccompprofileapi.SeccompProfile)
+	if !ok || container.SeccompProfile == "" || container.SeccompProfile != sp.Status.LocalhostProfile {
+		return false
+	}
+	return slices.ContainsFunc(container.Syscalls, func(v violationreportapi.SyscallViolation) bool {
+		return v.LastSeen.After(since)
+	})
+}
+
+func appArmorViolation(
+	container *violationreportapi.ContainerViolations, prof profilebasev1alpha1.SecurityProfileBase, since time.Time,
+) bool {
+	ap, ok := prof.(interface{ GetProfileName() string })
+	if !ok {
+		return false
+	}
+	return slices.ContainsFunc(container.AppArmor, func(v violationreportapi.AppArmorViolation) bool {
+		return v.Profile == ap.GetProfileName() && v.LastSeen.After(since)
+	})
+}
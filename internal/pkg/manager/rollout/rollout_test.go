@@ -0,0 +1,321 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	profileName      = "nginx"
+	profileNamespace = "team-a"
+	profileLabel     = "SeccompProfile-nginx"
+	localhostProfile = "operator/team-a/nginx.json"
+)
+
+type nodeState struct {
+	installed int64
+	rollout   int64
+	since     time.Duration
+	failed    bool
+}
+
+func nodeStatus(node string, state nodeState) *statusv1alpha1.SecurityProfileNodeStatus {
+	status := &statusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      profileName + "-" + node,
+			Namespace: profileNamespace,
+			Labels: map[string]string{
+				statusv1alpha1.StatusToProfLabel: profileLabel,
+				statusv1alpha1.StatusToNodeLabel: node,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				Kind:       "SeccompProfile",
+				Name:       profileName,
+				Controller: ptr.To(true),
+			}},
+		},
+		NodeName:            node,
+		Status:              statusv1alpha1.ProfileStateInstalled,
+		InstalledGeneration: state.installed,
+	}
+	if state.failed {
+		status.Status = statusv1alpha1.ProfileStateError
+	}
+	if state.rollout != 0 {
+		status.Spec.RolloutGeneration = state.rollout
+		status.Spec.RolloutTime = &metav1.Time{Time: time.Now().Add(-state.since)}
+	}
+	return status
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, statusv1alpha1.AddToScheme(s))
+	require.NoError(t, spodv1alpha1.AddToScheme(s))
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+	require.NoError(t, violationreportapi.AddToScheme(s))
+
+	for _, tc := range []struct {
+		name            string
+		strategy        *spodv1alpha1.ProfileRolloutStrategy
+		nodes           map[string]nodeState
+		violation       time.Duration
+		expectedRollout []string
+		expectRequeue   bool
+	}{
+		{
+			name: "disabled",
+			nodes: map[string]nodeState{
+				"node-a": {installed: 1},
+			},
+		},
+		{
+			name:     "first batch",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 1},
+				"node-b": {installed: 1},
+			},
+			expectedRollout: []string{"node-a"},
+		},
+		{
+			name: "canary nodes first",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{
+				MaxUnavailable:     2,
+				CanaryNodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+			},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 1},
+				"node-b": {installed: 1},
+				"node-c": {installed: 1},
+			},
+			expectedRollout: []string{"node-c"},
+		},
+		{
+			name:     "wait for batch to install",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 1, rollout: 2, since: time.Hour},
+				"node-b": {installed: 1},
+			},
+		},
+		{
+			name: "observe installed batch",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{
+				MaxUnavailable:    1,
+				ObservationPeriod: &metav1.Duration{Duration: time.Hour},
+			},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 2, rollout: 2, since: time.Minute},
+				"node-b": {installed: 1},
+			},
+			expectRequeue: true,
+		},
+		{
+			name:     "next batch",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 2, rollout: 2, since: time.Hour},
+				"node-b": {installed: 1},
+				"node-c": {installed: 1},
+			},
+			violation:       2 * time.Hour,
+			expectedRollout: []string{"node-b"},
+		},
+		{
+			name:     "halt on violations",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 2, rollout: 2, since: time.Hour},
+				"node-b": {installed: 1},
+			},
+			violation:     time.Minute,
+			expectRequeue: true,
+		},
+		{
+			name:     "halt on failed installation",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 1, rollout: 2, since: time.Hour, failed: true},
+				"node-b": {installed: 1},
+			},
+			expectRequeue: true,
+		},
+		{
+			name:     "new nodes do not wait",
+			strategy: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+			nodes: map[string]nodeState{
+				"node-a": {installed: 2},
+				"node-b": {},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			profile := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       profileName,
+					Namespace:  profileNamespace,
+					Generation: 2,
+				},
+				Status: seccompprofileapi.SeccompProfileStatus{LocalhostProfile: localhostProfile},
+			}
+			spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+				ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: config.OperatorName},
+				Spec:       spodv1alpha1.SPODSpec{ProfileRollout: tc.strategy},
+			}
+			objs := []client.Object{profile, spod}
+			for node, state := range tc.nodes {
+				labels := map[string]string{}
+				if node == "node-c" {
+					labels["canary"] = "true"
+				}
+				objs = append(objs,
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: node, Labels: labels}},
+					nodeStatus(node, state),
+				)
+			}
+			if tc.violation != 0 {
+				lastSeen := metav1.Time{Time: time.Now().Add(-tc.violation)}
+				objs = append(objs, &violationreportapi.ProfileViolationReport{
+					ObjectMeta: metav1.ObjectMeta{Name: "report", Namespace: profileNamespace},
+					LastSeen:   &lastSeen,
+					Containers: []violationreportapi.ContainerViolations{{
+						Name:           "nginx",
+						SeccompProfile: localhostProfile,
+						Syscalls: []violationreportapi.SyscallViolation{{
+							Name:           "mkdir",
+							ViolationCount: violationreportapi.ViolationCount{LastSeen: lastSeen},
+						}},
+					}},
+				})
+			}
+
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+			sut, ok := NewSeccompController().(*Reconciler)
+			require.True(t, ok)
+			sut.client = cli
+			sut.log = logr.Discard()
+			sut.record = record.NewFakeRecorder(10)
+			sut.namespace = config.OperatorName
+			ctx := context.Background()
+
+			res, err := sut.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: profileName, Namespace: profileNamespace},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectRequeue, res.RequeueAfter > 0)
+
+			rolledOut := []string{}
+			for node, state := range tc.nodes {
+				status := &statusv1alpha1.SecurityProfileNodeStatus{}
+				require.NoError(t, cli.Get(ctx, types.NamespacedName{
+					Name: profileName + "-" + node, Namespace: profileNamespace,
+				}, status))
+				if state.rollout == 0 && status.Spec.RolloutGeneration == 2 {
+					rolledOut = append(rolledOut, node)
+				}
+			}
+			require.ElementsMatch(t, tc.expectedRollout, rolledOut)
+		})
+	}
+}
+
+func TestReconcileRawAppArmorProfile(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	require.NoError(t, statusv1alpha1.AddToScheme(s))
+	require.NoError(t, spodv1alpha1.AddToScheme(s))
+	require.NoError(t, apparmorprofileapi.AddToScheme(s))
+	require.NoError(t, violationreportapi.AddToScheme(s))
+
+	profile := &apparmorprofileapi.RawAppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       profileName,
+			Namespace:  profileNamespace,
+			Generation: 2,
+		},
+		Spec: apparmorprofileapi.RawAppArmorProfileSpec{Policy: "profile nginx {}"},
+	}
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{
+		ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: config.OperatorName},
+		Spec: spodv1alpha1.SPODSpec{
+			ProfileRollout: &spodv1alpha1.ProfileRolloutStrategy{MaxUnavailable: 1},
+		},
+	}
+	objs := []client.Object{profile, spod}
+	for _, node := range []string{"node-a", "node-b"} {
+		status := nodeStatus(node, nodeState{installed: 1})
+		status.Labels[statusv1alpha1.StatusToProfLabel] = "RawAppArmorProfile-" + profileName
+		status.OwnerReferences[0].Kind = "RawAppArmorProfile"
+		objs = append(objs, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: node}}, status)
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+	sut, ok := NewRawAppArmorController().(*Reconciler)
+	require.True(t, ok)
+	sut.client = cli
+	sut.log = logr.Discard()
+	sut.record = record.NewFakeRecorder(10)
+	sut.namespace = config.OperatorName
+	ctx := context.Background()
+
+	_, err := sut.Reconcile(ctx, reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: profileName, Namespace: profileNamespace},
+	})
+	require.NoError(t, err)
+
+	for node, expected := range map[string]int64{"node-a": 2, "node-b": 0} {
+		status := &statusv1alpha1.SecurityProfileNodeStatus{}
+		require.NoError(t, cli.Get(ctx, types.NamespacedName{
+			Name: profileName + "-" + node, Namespace: profileNamespace,
+		}, status))
+		require.Equal(t, expected, status.Spec.RolloutGeneration, node)
+	}
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: profileName, Namespace: profileNamespace},
+	}}, sut.profileForStatus(ctx, objs[3]))
+}
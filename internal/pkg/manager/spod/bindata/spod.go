@@ -872,3 +872,24 @@ func CustomHostKubeletVolume(path string) (corev1.Volume, corev1.VolumeMount) {
 			ReadOnly:  false,
 		}
 }
+
+// LogEnricherExportVolume returns a new host path volume for the directory of
+// the log enricher export file as well as corresponding mount. The directory
+// gets mounted to config.LogEnricherExportDir, which does not overlap with
+// the other log enricher mounts like /var/log.
+func LogEnricherExportVolume(filePath string) (corev1.Volume, corev1.VolumeMount) {
+	const volumeName = "log-enricher-export-volume"
+	return corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: filepath.Dir(filePath),
+					Type: &hostPathDirectoryOrCreate,
+				},
+			},
+		}, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: config.LogEnricherExportDir,
+			ReadOnly:  false,
+		}
+}
@@ -43,6 +43,8 @@ var (
 	caBundle                      = []byte("Cg==")
 	bindingPath                   = "/mutate-v1-pod-binding"
 	recordingPath                 = "/mutate-v1-pod-recording"
+	validationPath                = "/validate-v1-pod-profiles"
+	appArmorValidationPath        = "/validate-v1alpha1-apparmorprofile"
 	sideEffects                   = admissionregv1.SideEffectClassNone
 	admissionReviewVersions       = []string{"v1beta1"}
 	rules                         = []admissionregv1.RuleWithOperations{
@@ -57,6 +59,26 @@ var (
 			},
 		},
 	}
+	validationRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{"CREATE"},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{""},
+				APIVersions: []string{"v1"},
+				Resources:   []string{"pods"},
+			},
+		},
+	}
+	appArmorValidationRules = []admissionregv1.RuleWithOperations{
+		{
+			Operations: []admissionregv1.OperationType{"CREATE", "UPDATE"},
+			Rule: admissionregv1.Rule{
+				APIGroups:   []string{"security-profiles-operator.x-k8s.io"},
+				APIVersions: []string{"v1alpha1"},
+				Resources:   []string{"apparmorprofiles", "rawapparmorprofiles"},
+			},
+		},
+	}
 	objectSelector = metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{
 			{
@@ -75,22 +97,27 @@ const (
 	// EnableBindingLabel this label can be applied to a namespace in order to
 	// enable profile binding.
 	EnableBindingLabel = "spo.x-k8s.io/enable-binding"
+	// EnableProfileValidationLabel this label can be applied to a namespace
+	// in order to enable the validation of profiles referenced by pods.
+	EnableProfileValidationLabel = "spo.x-k8s.io/enable-profile-validation"
 )
 
 const (
-	webhookName        = config.OperatorName + "-webhook"
-	webhookConfigName  = "spo-mutating-webhook-configuration"
-	serviceAccountName = "spo-webhook"
-	certsMountPath     = "/tmp/k8s-webhook-server/serving-certs"
-	serviceName        = "webhook-service"
-	webhookServerCert  = "webhook-server-cert"
+	webhookName          = config.OperatorName + "-webhook"
+	webhookConfigName    = "spo-mutating-webhook-configuration"
+	validatingConfigName = "spo-validating-webhook-configuration"
+	serviceAccountName   = "spo-webhook"
+	certsMountPath       = "/tmp/k8s-webhook-server/serving-certs"
+	serviceName          = "webhook-service"
+	webhookServerCert    = "webhook-server-cert"
 )
 
 type Webhook struct {
-	log        logr.Logger
-	deployment *appsv1.Deployment
-	config     *admissionregv1.MutatingWebhookConfiguration
-	service    *corev1.Service
+	log              logr.Logger
+	deployment       *appsv1.Deployment
+	config           *admissionregv1.MutatingWebhookConfiguration
+	validatingConfig *admissionregv1.ValidatingWebhookConfiguration
+	service          *corev1.Service
 }
 
 func GetWebhook(
@@ -122,6 +149,11 @@ func GetWebhook(
 	cfg.Webhooks[0].ClientConfig.Service.Namespace = namespace
 	cfg.Webhooks[1].ClientConfig.Service.Namespace = namespace
 
+	validatingCfg := validatingWebhookConfig.DeepCopy()
+	for i := range validatingCfg.Webhooks {
+		validatingCfg.Webhooks[i].ClientConfig.Service.Namespace = namespace
+	}
+
 	service := webhookService.DeepCopy()
 	service.Namespace = namespace
 
@@ -130,10 +162,12 @@ func GetWebhook(
 		cfg.Annotations = map[string]string{
 			"cert-manager.io/inject-ca-from": config.OperatorName + "/webhook-cert",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 	case CAInjectTypeOpenShift:
 		cfg.Annotations = map[string]string{
 			"service.beta.openshift.io/inject-cabundle": "true",
 		}
+		validatingCfg.Annotations = cfg.Annotations
 		service.Annotations = map[string]string{
 			openshiftCertAnnotation: webhookServerCert,
 		}
@@ -143,12 +177,14 @@ func GetWebhook(
 
 	// then apply the user-specified opts
 	applyWebhookOptions(cfg, webhookOpts)
+	applyValidatingWebhookOptions(validatingCfg, webhookOpts)
 
 	return &Webhook{
-		log:        log,
-		deployment: deployment,
-		config:     cfg,
-		service:    service,
+		log:              log,
+		deployment:       deployment,
+		config:           cfg,
+		validatingConfig: validatingCfg,
+		service:          service,
 	}
 }
 
@@ -156,7 +192,7 @@ func (w *Webhook) Create(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Create(ctx, o); err != nil {
 			if errors.IsAlreadyExists(err) {
-				if k == "config" {
+				if k == "config" || k == "validatingConfig" {
 					// The config already exists because it's a global resource we have to remove later on
 					if err := c.Patch(ctx, o, client.Merge); err != nil {
 						return fmt.Errorf("updating %s: %w", k, err)
@@ -173,27 +209,44 @@ func (w *Webhook) Create(ctx context.Context, c client.Client) error {
 
 func applyWebhookOptions(cfg *admissionregv1.MutatingWebhookConfiguration, opts []spodv1alpha1.WebhookOptions) {
 	for i := range cfg.Webhooks {
-		var userOpt *spodv1alpha1.WebhookOptions
+		hook := &cfg.Webhooks[i]
+		applyWebhookOption(hook.Name, &hook.FailurePolicy, &hook.NamespaceSelector, &hook.ObjectSelector, opts)
+	}
+}
 
+func applyValidatingWebhookOptions(
+	cfg *admissionregv1.ValidatingWebhookConfiguration, opts []spodv1alpha1.WebhookOptions,
+) {
+	for i := range cfg.Webhooks {
 		hook := &cfg.Webhooks[i]
-		for j := range opts {
-			userOpt = &opts[j]
+		applyWebhookOption(hook.Name, &hook.FailurePolicy, &hook.NamespaceSelector, &hook.ObjectSelector, opts)
+	}
+}
 
-			if userOpt == nil || userOpt.Name != hook.Name {
-				continue
-			}
+// applyWebhookOption applies the user-specified options of the named webhook.
+func applyWebhookOption(
+	name string,
+	failurePolicy **admissionregv1.FailurePolicyType,
+	namespaceSelector, objectSelector **metav1.LabelSelector,
+	opts []spodv1alpha1.WebhookOptions,
+) {
+	for j := range opts {
+		userOpt := &opts[j]
+
+		if userOpt.Name != name {
+			continue
+		}
 
-			if userOpt.FailurePolicy != nil {
-				hook.FailurePolicy = userOpt.FailurePolicy
-			}
+		if userOpt.FailurePolicy != nil {
+			*failurePolicy = userOpt.FailurePolicy
+		}
 
-			if userOpt.NamespaceSelector != nil {
-				hook.NamespaceSelector = userOpt.NamespaceSelector
-			}
+		if userOpt.NamespaceSelector != nil {
+			*namespaceSelector = userOpt.NamespaceSelector
+		}
 
-			if userOpt.ObjectSelector != nil {
-				hook.ObjectSelector = userOpt.ObjectSelector
-			}
+		if userOpt.ObjectSelector != nil {
+			*objectSelector = userOpt.ObjectSelector
 		}
 	}
 }
@@ -220,7 +273,48 @@ func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error
 				continue
 			}
 
-			if webhookNeedsUpdate(&ew, &cw) {
+			if webhookNeedsUpdate(
+				&webhookSettings{ew.FailurePolicy, ew.NamespaceSelector, ew.ObjectSelector},
+				&webhookSettings{cw.FailurePolicy, cw.NamespaceSelector, cw.ObjectSelector},
+			) {
+				return true, nil
+			}
+		}
+	}
+
+	return w.validatingConfigNeedsUpdate(ctx, c)
+}
+
+func (w *Webhook) validatingConfigNeedsUpdate(ctx context.Context, c client.Client) (bool, error) {
+	existingWebHook := admissionregv1.ValidatingWebhookConfiguration{}
+
+	if err := c.Get(ctx,
+		types.NamespacedName{Name: w.validatingConfig.Name},
+		&existingWebHook); err != nil {
+		if errors.IsNotFound(err) {
+			// Created by an operator version without profile validation
+			return true, nil
+		}
+		return false, err
+	}
+
+	if len(existingWebHook.Webhooks) != len(w.validatingConfig.Webhooks) {
+		return true, nil
+	}
+
+	for i := range existingWebHook.Webhooks {
+		ew := existingWebHook.Webhooks[i]
+		for j := range w.validatingConfig.Webhooks {
+			cw := w.validatingConfig.Webhooks[j]
+
+			if ew.Name != cw.Name {
+				continue
+			}
+
+			if webhookNeedsUpdate(
+				&webhookSettings{ew.FailurePolicy, ew.NamespaceSelector, ew.ObjectSelector},
+				&webhookSettings{cw.FailurePolicy, cw.NamespaceSelector, cw.ObjectSelector},
+			) {
 				return true, nil
 			}
 		}
@@ -229,8 +323,15 @@ func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error
 	return false, nil
 }
 
+// webhookSettings are the settings of a webhook which are tunable in spod.
+type webhookSettings struct {
+	FailurePolicy     *admissionregv1.FailurePolicyType
+	NamespaceSelector *metav1.LabelSelector
+	ObjectSelector    *metav1.LabelSelector
+}
+
 // only compare the settings that are tunable in spod now.
-func webhookNeedsUpdate(existing, configured *admissionregv1.MutatingWebhook) bool {
+func webhookNeedsUpdate(existing, configured *webhookSettings) bool {
 	if existing.FailurePolicy == nil && configured.FailurePolicy != nil ||
 		existing.FailurePolicy != nil && configured.FailurePolicy == nil {
 		// comparing pointers, not values
@@ -252,7 +353,7 @@ func webhookNeedsUpdate(existing, configured *admissionregv1.MutatingWebhook) bo
 
 	if existing.NamespaceSelector != nil && configured.NamespaceSelector != nil {
 		// Only compare managed labels, all others are out of scope
-		for _, label := range []string{EnableBindingLabel, EnableRecordingLabel} {
+		for _, label := range []string{EnableBindingLabel, EnableRecordingLabel, EnableProfileValidationLabel} {
 			if namespaceSelectorUnequalForLabel(label, existing.NamespaceSelector, configured.NamespaceSelector) {
 				return true
 			}
@@ -312,6 +413,12 @@ func namespaceSelectorUnequalForLabel(label string, existing, configured *metav1
 func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 	for k, o := range w.objectMap() {
 		if err := c.Patch(ctx, o, client.Merge); err != nil {
+			if errors.IsNotFound(err) {
+				if err := c.Create(ctx, o); err != nil {
+					return fmt.Errorf("creating %s: %w", k, err)
+				}
+				continue
+			}
 			return fmt.Errorf("updating %s: %w", k, err)
 		}
 	}
@@ -320,9 +427,10 @@ func (w *Webhook) Update(ctx context.Context, c client.Client) error {
 
 func (w *Webhook) objectMap() map[string]client.Object {
 	return map[string]client.Object{
-		"deployment": w.deployment,
-		"config":     w.config,
-		"service":    w.service,
+		"deployment":       w.deployment,
+		"config":           w.config,
+		"validatingConfig": w.validatingConfig,
+		"service":          w.service,
 	}
 }
 
@@ -480,6 +588,53 @@ var webhookConfig = &admissionregv1.MutatingWebhookConfiguration{
 	},
 }
 
+var validatingWebhookConfig = &admissionregv1.ValidatingWebhookConfiguration{
+	ObjectMeta: metav1.ObjectMeta{
+		Name: validatingConfigName,
+	},
+	Webhooks: []admissionregv1.ValidatingWebhook{
+		{
+			Name:           "profile-validation.spo.io",
+			FailurePolicy:  &failurePolicy,
+			SideEffects:    &sideEffects,
+			Rules:          validationRules,
+			ObjectSelector: &objectSelector,
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      EnableProfileValidationLabel,
+						Operator: metav1.LabelSelectorOpExists,
+					},
+				},
+			},
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &validationPath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+		{
+			Name:              "apparmorprofile-validation.spo.io",
+			FailurePolicy:     &failurePolicy,
+			SideEffects:       &sideEffects,
+			Rules:             appArmorValidationRules,
+			ObjectSelector:    &metav1.LabelSelector{},
+			NamespaceSelector: &metav1.LabelSelector{},
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				CABundle: caBundle,
+				Service: &admissionregv1.ServiceReference{
+					Name: serviceName,
+					Path: &appArmorValidationPath,
+				},
+			},
+			AdmissionReviewVersions: admissionReviewVersions,
+		},
+	},
+}
+
 var webhookService = &corev1.Service{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:   serviceName,
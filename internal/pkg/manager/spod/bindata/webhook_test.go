@@ -19,8 +19,13 @@ package bindata
 import (
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 const testLabel = "test"
@@ -110,3 +115,31 @@ func TestNamespaceSelectorUnequalForLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWebhookValidatingOptions(t *testing.T) {
+	t.Parallel()
+
+	ignore := admissionregv1.Ignore
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"validate": "true"}}
+	hook := GetWebhook(logr.Discard(), "ns", []spodv1alpha1.WebhookOptions{
+		{Name: "binding.spo.io", FailurePolicy: &ignore},
+		{Name: "profile-validation.spo.io", NamespaceSelector: selector},
+	}, "image", corev1.PullAlways, CAInjectTypeCertManager, nil, nil)
+
+	validating := hook.validatingConfig.Webhooks[0]
+	assert.Equal(t, "ns", validating.ClientConfig.Service.Namespace)
+	assert.Equal(t, selector, validating.NamespaceSelector)
+	assert.Equal(t, admissionregv1.Fail, *validating.FailurePolicy)
+	assert.Equal(t, hook.config.Annotations, hook.validatingConfig.Annotations)
+	assert.Equal(t, admissionregv1.Ignore, *hook.config.Webhooks[0].FailurePolicy)
+
+	appArmorValidating := hook.validatingConfig.Webhooks[1]
+	assert.Equal(t, "apparmorprofile-validation.spo.io", appArmorValidating.Name)
+	assert.Equal(t, "ns", appArmorValidating.ClientConfig.Service.Namespace)
+	assert.Equal(t, admissionregv1.Fail, *appArmorValidating.FailurePolicy)
+	assert.Equal(t, []string{"apparmorprofiles", "rawapparmorprofiles"}, appArmorValidating.Rules[0].Resources)
+
+	// The default configuration must not be changed
+	assert.Equal(t, EnableProfileValidationLabel,
+		validatingWebhookConfig.Webhooks[0].NamespaceSelector.MatchExpressions[0].Key)
+}
@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -106,6 +108,7 @@ func (r *ReconcileSPOd) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers;certificates,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons/status,verbs=get;update;patch
@@ -553,6 +556,23 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		if cfg.Spec.LogEnricherSource != "" {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.LogEnricherSourceEnvKey,
+				Value: string(cfg.Spec.LogEnricherSource),
+			})
+		}
+
+		if export := cfg.Spec.LogEnricherExport; export != nil {
+			ctr.Env = append(ctr.Env, logEnricherExportEnv(export)...)
+
+			if export.File != nil {
+				exportVolume, exportMount := bindata.LogEnricherExportVolume(export.File.Path)
+				templateSpec.Volumes = append(templateSpec.Volumes, exportVolume)
+				ctr.VolumeMounts = append(ctr.VolumeMounts, exportMount)
+			}
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the log enricher env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableLogEnricherEnvKey)
@@ -567,6 +587,13 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		if len(cfg.Spec.BpfRecorderSyscallArgs) > 0 {
+			ctr.Env = append(ctr.Env, corev1.EnvVar{
+				Name:  config.BpfRecorderSyscallArgsEnvKey,
+				Value: strings.Join(cfg.Spec.BpfRecorderSyscallArgs, ","),
+			})
+		}
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the bpf recorder env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableBpfRecorderEnvKey)
@@ -670,6 +697,48 @@ func isBpfRecorderEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool
 	return cfg.Spec.EnableBpfRecorder || enableBpfRecorderEnv
 }
 
+// logEnricherExportEnv returns the environment variables which configure the
+// event export of the log enricher.
+func logEnricherExportEnv(export *spodv1alpha1.LogEnricherExportOptions) (env []corev1.EnvVar) {
+	add := func(key, value string) {
+		env = append(env, corev1.EnvVar{Name: key, Value: value})
+	}
+
+	if file := export.File; file != nil {
+		// The directory of the file is mounted to the dedicated export directory
+		add(config.LogEnricherExportFileEnvKey, filepath.Join(config.LogEnricherExportDir, filepath.Base(file.Path)))
+		if file.MaxSizeMB > 0 {
+			add(config.LogEnricherExportFileMaxSizeEnvKey, strconv.Itoa(int(file.MaxSizeMB)))
+		}
+		if file.MaxBackups != nil {
+			add(config.LogEnricherExportFileMaxBackupsEnvKey, strconv.Itoa(int(*file.MaxBackups)))
+		}
+	}
+
+	if webhook := export.Webhook; webhook != nil {
+		add(config.LogEnricherExportWebhookURLEnvKey, webhook.URL)
+		if webhook.AuthorizationSecretRef != nil {
+			env = append(env, corev1.EnvVar{
+				Name: config.LogEnricherExportWebhookAuthorizationEnvKey,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: webhook.AuthorizationSecretRef,
+				},
+			})
+		}
+		if webhook.BatchSize > 0 {
+			add(config.LogEnricherExportWebhookBatchSizeEnvKey, strconv.Itoa(int(webhook.BatchSize)))
+		}
+		if webhook.FlushInterval != nil {
+			add(config.LogEnricherExportWebhookFlushIntervalEnvKey, webhook.FlushInterval.Duration.String())
+		}
+		if webhook.MaxRetries != nil {
+			add(config.LogEnricherExportWebhookMaxRetriesEnvKey, strconv.Itoa(int(*webhook.MaxRetries)))
+		}
+	}
+
+	return env
+}
+
 func addEnvVar(templateSpec *corev1.PodSpec, envVarKey string) {
 	envValue, err := strconv.ParseBool(os.Getenv(envVarKey))
 	if err != nil {
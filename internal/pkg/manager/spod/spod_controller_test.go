@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spod
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+func Test_logEnricherExportEnv(t *testing.T) {
+	t.Parallel()
+
+	var (
+		maxBackups int32 = 0
+		maxRetries int32 = 2
+	)
+	secretRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "siem"},
+		Key:                  "token",
+	}
+
+	for _, tc := range []struct {
+		name   string
+		export *spodv1alpha1.LogEnricherExportOptions
+		want   []corev1.EnvVar
+	}{
+		{
+			name:   "no sinks",
+			export: &spodv1alpha1.LogEnricherExportOptions{},
+		},
+		{
+			name: "file with defaults",
+			export: &spodv1alpha1.LogEnricherExportOptions{
+				File: &spodv1alpha1.LogEnricherFileExport{Path: "/var/log/spo/events.jsonl"},
+			},
+			want: []corev1.EnvVar{
+				{Name: config.LogEnricherExportFileEnvKey, Value: "/var/run/spo-export/events.jsonl"},
+			},
+		},
+		{
+			name: "file and webhook",
+			export: &spodv1alpha1.LogEnricherExportOptions{
+				File: &spodv1alpha1.LogEnricherFileExport{
+					Path:       "/var/log/spo/events.jsonl",
+					MaxSizeMB:  10,
+					MaxBackups: &maxBackups,
+				},
+				Webhook: &spodv1alpha1.LogEnricherWebhookExport{
+					URL:                    "https://siem.example.com",
+					AuthorizationSecretRef: secretRef,
+					BatchSize:              50,
+					FlushInterval:          &metav1.Duration{Duration: 10 * time.Second},
+					MaxRetries:             &maxRetries,
+				},
+			},
+			want: []corev1.EnvVar{
+				{Name: config.LogEnricherExportFileEnvKey, Value: "/var/run/spo-export/events.jsonl"},
+				{Name: config.LogEnricherExportFileMaxSizeEnvKey, Value: "10"},
+				{Name: config.LogEnricherExportFileMaxBackupsEnvKey, Value: "0"},
+				{Name: config.LogEnricherExportWebhookURLEnvKey, Value: "https://siem.example.com"},
+				{
+					Name:      config.LogEnricherExportWebhookAuthorizationEnvKey,
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef},
+				},
+				{Name: config.LogEnricherExportWebhookBatchSizeEnvKey, Value: "50"},
+				{Name: config.LogEnricherExportWebhookFlushIntervalEnvKey, Value: "10s"},
+				{Name: config.LogEnricherExportWebhookMaxRetriesEnvKey, Value: "2"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, logEnricherExportEnv(tc.export))
+		})
+	}
+}
+
+func TestLogEnricherExportVolume(t *testing.T) {
+	t.Parallel()
+
+	volume, mount := bindata.LogEnricherExportVolume("/var/log/spo/events.jsonl")
+	require.Equal(t, "/var/log/spo", volume.HostPath.Path)
+	require.Equal(t, volume.Name, mount.Name)
+	require.Equal(t, config.LogEnricherExportDir, mount.MountPath)
+
+	// The mount must not shadow the other log enricher mounts
+	for _, ctr := range bindata.Manifest.Spec.Template.Spec.Containers {
+		if ctr.Name != bindata.LogEnricherContainerName {
+			continue
+		}
+		for _, m := range ctr.VolumeMounts {
+			require.NotEqual(t, mount.MountPath, m.MountPath)
+			require.False(t, strings.HasPrefix(m.MountPath, mount.MountPath+"/"), m.MountPath)
+			require.False(t, strings.HasPrefix(mount.MountPath, m.MountPath+"/"), m.MountPath)
+		}
+	}
+}
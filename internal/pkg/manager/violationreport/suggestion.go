@@ -0,0 +1,495 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreport
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// suggestionSuffix is appended to the profile name to get the name of the
+// partial profile which contains the suggested changes.
+const suggestionSuffix = "-suggestion"
+
+// suggestionName returns the name of the partial profile which contains the
+// suggested changes to the profile.
+func suggestionName(profileName string) string {
+	return profileName + suggestionSuffix
+}
+
+// Security Profiles Operator RBAC permissions to suggest profile changes
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;create;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;create;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;create;update
+
+// suggest adds the changes which would allow the violations of the partial
+// report to the suggestions of the affected profiles. Only profiles managed
+// by the operator can be changed, all other violations get ignored.
+func (r *ReportMergeReconciler) suggest(
+	ctx context.Context,
+	partial *violationreportapi.ProfileViolationReport,
+) {
+	for _, change := range suggestions(partial) {
+		if err := r.applySuggestion(ctx, change); err != nil {
+			r.log.Error(err, "cannot suggest profile changes",
+				"namespace", change.GetNamespace(), "profile", change.GetName())
+		}
+	}
+}
+
+// applySuggestion merges the change into the suggestion of the profile with
+// the same key, which gets created if it does not exist yet.
+func (r *ReportMergeReconciler) applySuggestion(ctx context.Context, change client.Object) error {
+	profile, err := r.findProfile(ctx, change)
+	if err != nil {
+		return err
+	}
+	if profile == nil || profilebase.IsPartial(profile) {
+		return nil
+	}
+
+	suggestion := newProfileLike(change)
+	key := client.ObjectKey{Namespace: profile.GetNamespace(), Name: suggestionName(profile.GetName())}
+	err = r.reader.Get(ctx, key, suggestion)
+	if util.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("get suggestion: %w", err)
+	}
+	exists := !kerrors.IsNotFound(err)
+	if !exists {
+		suggestion = nil
+	}
+
+	if !completeChange(change, profile, suggestion) {
+		return nil
+	}
+	change.SetName(key.Name)
+	change.SetLabels(map[string]string{
+		profilebase.ProfilePartialLabel:              "true",
+		violationreportapi.SuggestionForProfileLabel: profile.GetName(),
+	})
+
+	if !exists {
+		r.log.Info("Suggesting profile changes", "namespace", key.Namespace, "profile", profile.GetName())
+		if err := r.client.Create(ctx, change); err != nil {
+			return fmt.Errorf("create suggestion: %w", err)
+		}
+		return nil
+	}
+
+	base, ok := suggestion.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("cannot copy suggestion %T", suggestion)
+	}
+	merged, err := recordingmerger.MergeProfiles([]client.Object{base, change})
+	if err != nil {
+		return fmt.Errorf("merge suggestion: %w", err)
+	}
+	if equality.Semantic.DeepEqual(merged, suggestion) {
+		return nil
+	}
+
+	r.log.Info("Updating suggested profile changes", "namespace", key.Namespace, "profile", profile.GetName())
+	if err := r.client.Update(ctx, merged); err != nil {
+		return fmt.Errorf("update suggestion: %w", err)
+	}
+	return nil
+}
+
+// findProfile returns the profile which has the same key as the change or nil
+// if there is none.
+func (r *ReportMergeReconciler) findProfile(ctx context.Context, change client.Object) (client.Object, error) {
+	list := newProfileListLike(change)
+	if err := r.reader.List(ctx, list, client.InNamespace(change.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("list profiles: %w", err)
+	}
+
+	var res client.Object
+	if err := meta.EachListItem(list, func(item runtime.Object) error {
+		if profile, ok := item.(client.Object); ok && res == nil && profileKey(profile) == profileKey(change) {
+			res = profile
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("iterate profiles: %w", err)
+	}
+	return res, nil
+}
+
+// profileKey returns the key under which the changes to the profile are
+// collected. Seccomp profiles are referenced by their file, which is the same
+// for the names "profile" and "profile.json".
+func profileKey(profile client.Object) client.ObjectKey {
+	key := client.ObjectKeyFromObject(profile)
+	if _, ok := profile.(*seccompprofileapi.SeccompProfile); ok {
+		key.Name = strings.TrimSuffix(key.Name, seccompprofileapi.ExtJSON)
+	}
+	return key
+}
+
+// suggestions returns the profile changes which would allow the violations
+// of the report. Every change is named after the key of the profile it
+// applies to.
+func suggestions(report *violationreportapi.ProfileViolationReport) []client.Object {
+	seccompChanges := map[client.ObjectKey]*seccompprofileapi.SeccompProfile{}
+	selinuxChanges := map[client.ObjectKey]*selinuxprofileapi.SelinuxProfile{}
+	apparmorChanges := map[client.ObjectKey]*apparmorprofileapi.AppArmorProfile{}
+	res := []client.Object{}
+
+	for i := range report.Containers {
+		container := &report.Containers[i]
+
+		if key, ok := seccompProfileKey(container.SeccompProfile); ok {
+			for _, syscall := range container.Syscalls {
+				change, ok := seccompChanges[key]
+				if !ok {
+					change = &seccompprofileapi.SeccompProfile{}
+					change.Name, change.Namespace = key.Name, key.Namespace
+					change.Spec.Syscalls = []*seccompprofileapi.Syscall{{Action: seccomp.ActAllow}}
+					seccompChanges[key] = change
+					res = append(res, change)
+				}
+				change.Spec.Syscalls[0].Names = appendUnique(change.Spec.Syscalls[0].Names, syscall.Name)
+			}
+		}
+
+		for _, avc := range container.AVCs {
+			key, ok := selinuxProfileKey(avc.Scontext)
+			if !ok {
+				continue
+			}
+			change, ok := selinuxChanges[key]
+			if !ok {
+				change = &selinuxprofileapi.SelinuxProfile{}
+				change.Name, change.Namespace = key.Name, key.Namespace
+				change.Spec.Allow = selinuxprofileapi.Allow{}
+				selinuxChanges[key] = change
+				res = append(res, change)
+			}
+
+			label := selinuxprofileapi.LabelKey(contextType(avc.Tcontext))
+			if contextType(avc.Tcontext) == contextType(avc.Scontext) {
+				label = selinuxprofileapi.AllowSelf
+			}
+			if change.Spec.Allow[label] == nil {
+				change.Spec.Allow[label] = map[selinuxprofileapi.ObjectClassKey]selinuxprofileapi.PermissionSet{}
+			}
+			class := selinuxprofileapi.ObjectClassKey(avc.Tclass)
+			change.Spec.Allow[label][class] = appendUnique(change.Spec.Allow[label][class], avc.Perm)
+		}
+
+		for _, violation := range container.AppArmor {
+			key := client.ObjectKey{Namespace: report.Namespace, Name: violation.Profile}
+			change, ok := apparmorChanges[key]
+			if !ok {
+				change = &apparmorprofileapi.AppArmorProfile{}
+				change.Name, change.Namespace = key.Name, key.Namespace
+				apparmorChanges[key] = change
+				res = append(res, change)
+			}
+			addAppArmorViolation(&change.Spec.Abstract, &violation)
+		}
+	}
+
+	return res
+}
+
+// seccompProfileKey returns the SeccompProfile of an operator managed
+// localhost profile like "operator/default/profile.json".
+func seccompProfileKey(localhostProfile string) (client.ObjectKey, bool) {
+	parts := strings.Split(localhostProfile, "/")
+	if len(parts) != 3 || parts[0] != config.OperatorProfilesFolder ||
+		path.Ext(parts[2]) != seccompprofileapi.ExtJSON {
+		return client.ObjectKey{}, false
+	}
+	return profileKey(&seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Namespace: parts[1], Name: parts[2]},
+	}), true
+}
+
+// selinuxProfileKey returns the SelinuxProfile of a source context with a
+// policy usage type like "profile_default.process".
+func selinuxProfileKey(scontext string) (client.ObjectKey, bool) {
+	policy, ok := strings.CutSuffix(contextType(scontext), ".process")
+	if !ok {
+		return client.ObjectKey{}, false
+	}
+	name, namespace, ok := strings.Cut(policy, "_")
+	if !ok || name == "" || namespace == "" {
+		return client.ObjectKey{}, false
+	}
+	return client.ObjectKey{Namespace: namespace, Name: name}, true
+}
+
+// contextType returns the type of a SELinux context like
+// "system_u:system_r:container_t:s0".
+func contextType(context string) string {
+	parts := strings.Split(context, ":")
+	if len(parts) < 3 {
+		return context
+	}
+	return parts[2]
+}
+
+// addAppArmorViolation adds the rule which allows the AppArmor violation.
+func addAppArmorViolation(
+	abstract *apparmorprofileapi.AppArmorAbstract,
+	violation *violationreportapi.AppArmorViolation,
+) {
+	if !strings.HasPrefix(violation.Name, "/") {
+		return
+	}
+
+	mask := violation.DeniedMask
+	switch {
+	case violation.Operation == "exec" || strings.Contains(mask, "x"):
+		if abstract.Executable == nil {
+			abstract.Executable = &apparmorprofileapi.AppArmorExecutablesRules{}
+		}
+		abstract.Executable.AllowedExecutables = appendUniquePtr(
+			abstract.Executable.AllowedExecutables, violation.Name)
+		return
+	case violation.Operation == "file_mmap" || strings.Contains(mask, "m"):
+		if abstract.Executable == nil {
+			abstract.Executable = &apparmorprofileapi.AppArmorExecutablesRules{}
+		}
+		abstract.Executable.AllowedLibraries = appendUniquePtr(
+			abstract.Executable.AllowedLibraries, violation.Name)
+		return
+	}
+
+	read := strings.ContainsAny(mask, "rk")
+	write := strings.ContainsAny(mask, "wacdl")
+	if !read && !write {
+		return
+	}
+
+	if abstract.Filesystem == nil {
+		abstract.Filesystem = &apparmorprofileapi.AppArmorFsRules{}
+	}
+	fs := abstract.Filesystem
+	switch {
+	case read && write:
+		fs.ReadWritePaths = appendUniquePtr(fs.ReadWritePaths, violation.Name)
+	case read:
+		fs.ReadOnlyPaths = appendUniquePtr(fs.ReadOnlyPaths, violation.Name)
+	default:
+		fs.WriteOnlyPaths = appendUniquePtr(fs.WriteOnlyPaths, violation.Name)
+	}
+}
+
+// completeChange takes the fields which are required for a valid profile
+// from the profile and removes the rules which are already allowed or
+// suggested. It returns false if nothing is left to suggest.
+func completeChange(change, profile, suggestion client.Object) bool {
+	switch change := change.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		target, ok := profile.(*seccompprofileapi.SeccompProfile)
+		if !ok {
+			return false
+		}
+		change.Spec.DefaultAction = target.Spec.DefaultAction
+		change.Spec.Architectures = slices.Clone(target.Spec.Architectures)
+
+		known := allowedSyscalls(target)
+		if suggested, ok := suggestion.(*seccompprofileapi.SeccompProfile); ok {
+			known = append(known, allowedSyscalls(suggested)...)
+		}
+		names := slices.DeleteFunc(change.Spec.Syscalls[0].Names, func(name string) bool {
+			return slices.Contains(known, name)
+		})
+		change.Spec.Syscalls[0].Names = names
+		return len(names) > 0
+
+	case *selinuxprofileapi.SelinuxProfile:
+		target, ok := profile.(*selinuxprofileapi.SelinuxProfile)
+		if !ok {
+			return false
+		}
+		change.Spec.Inherit = slices.Clone(target.Spec.Inherit)
+
+		removeAllowedPermissions(change.Spec.Allow, target.Spec.Allow)
+		if suggested, ok := suggestion.(*selinuxprofileapi.SelinuxProfile); ok {
+			removeAllowedPermissions(change.Spec.Allow, suggested.Spec.Allow)
+		}
+		return len(change.Spec.Allow) > 0
+
+	case *apparmorprofileapi.AppArmorProfile:
+		target, ok := profile.(*apparmorprofileapi.AppArmorProfile)
+		if !ok {
+			return false
+		}
+
+		known := []*apparmorprofileapi.AppArmorAbstract{&target.Spec.Abstract}
+		if suggested, ok := suggestion.(*apparmorprofileapi.AppArmorProfile); ok {
+			known = append(known, &suggested.Spec.Abstract)
+		}
+		removeAllowedPaths(&change.Spec.Abstract, known...)
+		return change.Spec.Abstract.Executable != nil || change.Spec.Abstract.Filesystem != nil
+	}
+
+	return false
+}
+
+// allowedSyscalls returns the system calls which are explicitly allowed by
+// the profile.
+func allowedSyscalls(profile *seccompprofileapi.SeccompProfile) []string {
+	res := []string{}
+	for _, syscall := range profile.Spec.Syscalls {
+		if syscall.Action == seccomp.ActAllow {
+			res = append(res, syscall.Names...)
+		}
+	}
+	return res
+}
+
+// removeAllowedPermissions removes the permissions which are already part of
+// the known rules and drops the labels and classes which become empty.
+func removeAllowedPermissions(allow, known selinuxprofileapi.Allow) {
+	for label, classes := range allow {
+		for class, perms := range classes {
+			perms = slices.DeleteFunc(perms, func(perm string) bool {
+				return slices.Contains(known[label][class], perm)
+			})
+			if len(perms) == 0 {
+				delete(classes, class)
+				continue
+			}
+			classes[class] = perms
+		}
+		if len(classes) == 0 {
+			delete(allow, label)
+		}
+	}
+}
+
+// removeAllowedPaths removes the paths which are already allowed by one of
+// the known rules and drops the rules which become empty. The globs of the
+// known rules are compared literally.
+func removeAllowedPaths(
+	abstract *apparmorprofileapi.AppArmorAbstract,
+	known ...*apparmorprofileapi.AppArmorAbstract,
+) {
+	var executables, libraries, readable, writable, readWritable []string
+	for _, k := range known {
+		if k.Executable != nil {
+			executables = append(executables, ptr.Deref(k.Executable.AllowedExecutables, nil)...)
+			libraries = append(libraries, ptr.Deref(k.Executable.AllowedLibraries, nil)...)
+		}
+		if k.Filesystem != nil {
+			readWrite := ptr.Deref(k.Filesystem.ReadWritePaths, nil)
+			readable = slices.Concat(readable, ptr.Deref(k.Filesystem.ReadOnlyPaths, nil), readWrite)
+			writable = slices.Concat(writable, ptr.Deref(k.Filesystem.WriteOnlyPaths, nil), readWrite)
+			readWritable = append(readWritable, readWrite...)
+		}
+	}
+
+	if exe := abstract.Executable; exe != nil {
+		exe.AllowedExecutables = removeKnown(exe.AllowedExecutables, executables)
+		exe.AllowedLibraries = removeKnown(exe.AllowedLibraries, libraries)
+		if exe.AllowedExecutables == nil && exe.AllowedLibraries == nil {
+			abstract.Executable = nil
+		}
+	}
+
+	if fs := abstract.Filesystem; fs != nil {
+		fs.ReadOnlyPaths = removeKnown(fs.ReadOnlyPaths, readable)
+		fs.WriteOnlyPaths = removeKnown(fs.WriteOnlyPaths, writable)
+		fs.ReadWritePaths = removeKnown(fs.ReadWritePaths, readWritable)
+		if fs.ReadOnlyPaths == nil && fs.WriteOnlyPaths == nil && fs.ReadWritePaths == nil {
+			abstract.Filesystem = nil
+		}
+	}
+}
+
+// removeKnown removes the known values and returns nil if nothing is left.
+func removeKnown(values *[]string, known []string) *[]string {
+	if values == nil {
+		return nil
+	}
+	res := slices.DeleteFunc(*values, func(value string) bool {
+		return slices.Contains(known, value)
+	})
+	if len(res) == 0 {
+		return nil
+	}
+	return &res
+}
+
+// newProfileLike returns an empty profile of the same kind.
+func newProfileLike(obj client.Object) client.Object {
+	switch obj.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return &seccompprofileapi.SeccompProfile{}
+	case *selinuxprofileapi.SelinuxProfile:
+		return &selinuxprofileapi.SelinuxProfile{}
+	default:
+		return &apparmorprofileapi.AppArmorProfile{}
+	}
+}
+
+// newProfileListLike returns an empty profile list of the same kind.
+func newProfileListLike(obj client.Object) client.ObjectList {
+	switch obj.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return &seccompprofileapi.SeccompProfileList{}
+	case *selinuxprofileapi.SelinuxProfile:
+		return &selinuxprofileapi.SelinuxProfileList{}
+	default:
+		return &apparmorprofileapi.AppArmorProfileList{}
+	}
+}
+
+// appendUnique appends the value if it is not already part of the sorted
+// slice and keeps it sorted.
+func appendUnique(values []string, value string) []string {
+	idx, found := slices.BinarySearch(values, value)
+	if found {
+		return values
+	}
+	return slices.Insert(values, idx, value)
+}
+
+func appendUniquePtr(values *[]string, value string) *[]string {
+	var res []string
+	if values != nil {
+		res = *values
+	}
+	res = appendUnique(res, value)
+	return &res
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreport
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func seccompReport(syscalls ...string) *violationreportapi.ProfileViolationReport {
+	report := &violationreportapi.ProfileViolationReport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Containers: []violationreportapi.ContainerViolations{{
+			Name:           "nginx",
+			SeccompProfile: "operator/default/nginx.json",
+		}},
+	}
+	for _, syscall := range syscalls {
+		report.Containers[0].Syscalls = append(report.Containers[0].Syscalls,
+			violationreportapi.SyscallViolation{Name: syscall, Action: "SCMP_ACT_ERRNO"})
+	}
+	return report
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(s))
+
+	profile := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "default"},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction: seccomp.ActErrno,
+			Architectures: []seccompprofileapi.Arch{"SCMP_ARCH_X86_64", "SCMP_ARCH_AARCH64"},
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Action: seccomp.ActAllow, Names: []string{"read", "write"}},
+			},
+		},
+	}
+	profileWithExt := &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpd.json", Namespace: "default"},
+		Spec:       seccompprofileapi.SeccompProfileSpec{DefaultAction: seccomp.ActErrno},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(profile, profileWithExt).Build()
+	sut := &ReportMergeReconciler{client: cli, reader: cli, log: logr.Discard()}
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "nginx-suggestion"}
+
+	// Already allowed system calls do not result in a suggestion
+	sut.suggest(ctx, seccompReport("read"))
+	suggestion := &seccompprofileapi.SeccompProfile{}
+	require.Error(t, cli.Get(ctx, key, suggestion))
+
+	sut.suggest(ctx, seccompReport("mkdir", "read"))
+	require.NoError(t, cli.Get(ctx, key, suggestion))
+	require.Equal(t, "true", suggestion.Labels[profilebase.ProfilePartialLabel])
+	require.Equal(t, "nginx", suggestion.Labels[violationreportapi.SuggestionForProfileLabel])
+	require.Equal(t, seccomp.ActErrno, suggestion.Spec.DefaultAction)
+	require.Equal(t, profile.Spec.Architectures, suggestion.Spec.Architectures)
+	require.Len(t, suggestion.Spec.Syscalls, 1)
+	require.Equal(t, []string{"mkdir"}, suggestion.Spec.Syscalls[0].Names)
+
+	sut.suggest(ctx, seccompReport("mkdir", "rmdir"))
+	require.NoError(t, cli.Get(ctx, key, suggestion))
+	require.Len(t, suggestion.Spec.Syscalls, 2)
+	names := slices.Concat(suggestion.Spec.Syscalls[0].Names, suggestion.Spec.Syscalls[1].Names)
+	require.ElementsMatch(t, []string{"mkdir", "rmdir"}, names)
+
+	// Violations of profiles not managed by the operator get ignored
+	report := seccompReport("mkdir")
+	report.Containers[0].SeccompProfile = "operator/default/other.json"
+	sut.suggest(ctx, report)
+	require.Error(t, cli.Get(ctx, types.NamespacedName{Namespace: "default", Name: "other-suggestion"}, suggestion))
+
+	// Profiles named with the extension share the file of the reference
+	report.Containers[0].SeccompProfile = "operator/default/httpd.json"
+	sut.suggest(ctx, report)
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "default", Name: "httpd.json-suggestion"}, suggestion))
+	require.Equal(t, "httpd.json", suggestion.Labels[violationreportapi.SuggestionForProfileLabel])
+	require.Equal(t, []string{"mkdir"}, suggestion.Spec.Syscalls[0].Names)
+}
+
+func TestCompleteChange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("selinux", func(t *testing.T) {
+		t.Parallel()
+
+		change := &selinuxprofileapi.SelinuxProfile{}
+		change.Spec.Allow = selinuxprofileapi.Allow{
+			"var_log_t":                 {"file": {"open", "read", "write"}},
+			selinuxprofileapi.AllowSelf: {"tcp_socket": {"listen"}},
+			"var_run_t":                 {"sock_file": {"write"}},
+		}
+		profile := &selinuxprofileapi.SelinuxProfile{}
+		profile.Spec.Inherit = []selinuxprofileapi.PolicyRef{{Name: "container"}}
+		profile.Spec.Allow = selinuxprofileapi.Allow{
+			"var_log_t":                 {"file": {"open", "read"}},
+			selinuxprofileapi.AllowSelf: {"tcp_socket": {"listen"}},
+		}
+		suggestion := &selinuxprofileapi.SelinuxProfile{}
+		suggestion.Spec.Allow = selinuxprofileapi.Allow{"var_run_t": {"sock_file": {"write"}}}
+
+		require.True(t, completeChange(change, profile, suggestion))
+		require.Equal(t, profile.Spec.Inherit, change.Spec.Inherit)
+		require.Equal(t, selinuxprofileapi.Allow{"var_log_t": {"file": {"write"}}}, change.Spec.Allow)
+
+		change.Spec.Allow = selinuxprofileapi.Allow{"var_log_t": {"file": {"read"}}}
+		require.False(t, completeChange(change, profile, nil))
+	})
+
+	t.Run("apparmor", func(t *testing.T) {
+		t.Parallel()
+
+		change := &apparmorprofileapi.AppArmorProfile{}
+		change.Spec.Abstract = apparmorprofileapi.AppArmorAbstract{
+			Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+				AllowedExecutables: &[]string{"/bin/sh"},
+				AllowedLibraries:   &[]string{"/lib/libc.so.6"},
+			},
+			Filesystem: &apparmorprofileapi.AppArmorFsRules{
+				ReadOnlyPaths:  &[]string{"/etc/passwd", "/tmp/app"},
+				WriteOnlyPaths: &[]string{"/var/log/app.log"},
+				ReadWritePaths: &[]string{"/etc/shadow", "/tmp/app"},
+			},
+		}
+		profile := &apparmorprofileapi.AppArmorProfile{}
+		profile.Spec.Abstract = apparmorprofileapi.AppArmorAbstract{
+			Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+				AllowedExecutables: &[]string{"/bin/sh"},
+			},
+			Filesystem: &apparmorprofileapi.AppArmorFsRules{
+				ReadOnlyPaths:  &[]string{"/etc/shadow"},
+				ReadWritePaths: &[]string{"/tmp/app"},
+			},
+		}
+		suggestion := &apparmorprofileapi.AppArmorProfile{}
+		suggestion.Spec.Abstract = apparmorprofileapi.AppArmorAbstract{
+			Executable: &apparmorprofileapi.AppArmorExecutablesRules{
+				AllowedLibraries: &[]string{"/lib/libc.so.6"},
+			},
+		}
+
+		require.True(t, completeChange(change, profile, suggestion))
+		require.Nil(t, change.Spec.Abstract.Executable)
+		fs := change.Spec.Abstract.Filesystem
+		require.Equal(t, []string{"/etc/passwd"}, *fs.ReadOnlyPaths)
+		require.Equal(t, []string{"/var/log/app.log"}, *fs.WriteOnlyPaths)
+		require.Equal(t, []string{"/etc/shadow"}, *fs.ReadWritePaths)
+
+		change.Spec.Abstract = apparmorprofileapi.AppArmorAbstract{
+			Filesystem: &apparmorprofileapi.AppArmorFsRules{ReadOnlyPaths: &[]string{"/tmp/app"}},
+		}
+		require.False(t, completeChange(change, profile, nil))
+	})
+}
+
+func TestSuggestions(t *testing.T) {
+	t.Parallel()
+
+	report := &violationreportapi.ProfileViolationReport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Containers: []violationreportapi.ContainerViolations{
+			{
+				Name:           "app",
+				SeccompProfile: "RuntimeDefault",
+				Syscalls:       []violationreportapi.SyscallViolation{{Name: "mkdir"}},
+				AVCs: []violationreportapi.AVCViolation{
+					{
+						Perm:     "read",
+						Scontext: "system_u:system_r:app_default.process:s0:c1,c2",
+						Tcontext: "system_u:object_r:var_log_t:s0",
+						Tclass:   "file",
+					},
+					{
+						Perm:     "open",
+						Scontext: "system_u:system_r:app_default.process:s0:c1,c2",
+						Tcontext: "system_u:object_r:var_log_t:s0",
+						Tclass:   "file",
+					},
+					{
+						Perm:     "listen",
+						Scontext: "system_u:system_r:app_default.process:s0:c1,c2",
+						Tcontext: "system_u:system_r:app_default.process:s0:c1,c2",
+						Tclass:   "tcp_socket",
+					},
+					{
+						Perm:     "read",
+						Scontext: "system_u:system_r:container_t:s0:c1,c2",
+						Tcontext: "system_u:object_r:var_log_t:s0",
+						Tclass:   "file",
+					},
+				},
+				AppArmor: []violationreportapi.AppArmorViolation{
+					{Profile: "app", Operation: "open", Name: "/etc/shadow", DeniedMask: "r"},
+					{Profile: "app", Operation: "open", Name: "/var/log/app.log", DeniedMask: "wc"},
+					{Profile: "app", Operation: "open", Name: "/tmp/app", DeniedMask: "rw"},
+					{Profile: "app", Operation: "exec", Name: "/bin/sh", DeniedMask: "x"},
+					{Profile: "app", Operation: "file_mmap", Name: "/lib/libc.so.6", DeniedMask: "m"},
+					{Profile: "app", Operation: "create", Name: "", DeniedMask: ""},
+				},
+			},
+		},
+	}
+
+	res := suggestions(report)
+	require.Len(t, res, 2)
+
+	selinux, ok := res[0].(*selinuxprofileapi.SelinuxProfile)
+	require.True(t, ok)
+	require.Equal(t, client.ObjectKey{Namespace: "default", Name: "app"}, client.ObjectKeyFromObject(selinux))
+	require.Equal(t, selinuxprofileapi.Allow{
+		"var_log_t": {"file": {"open", "read"}},
+		selinuxprofileapi.AllowSelf: {"tcp_socket": {"listen"}},
+	}, selinux.Spec.Allow)
+
+	apparmor, ok := res[1].(*apparmorprofileapi.AppArmorProfile)
+	require.True(t, ok)
+	require.Equal(t, client.ObjectKey{Namespace: "default", Name: "app"}, client.ObjectKeyFromObject(apparmor))
+	abstract := apparmor.Spec.Abstract
+	require.Equal(t, []string{"/etc/shadow"}, *abstract.Filesystem.ReadOnlyPaths)
+	require.Equal(t, []string{"/var/log/app.log"}, *abstract.Filesystem.WriteOnlyPaths)
+	require.Equal(t, []string{"/tmp/app"}, *abstract.Filesystem.ReadWritePaths)
+	require.Equal(t, []string{"/bin/sh"}, *abstract.Executable.AllowedExecutables)
+	require.Equal(t, []string{"/lib/libc.so.6"}, *abstract.Executable.AllowedLibraries)
+}
+
+func TestSeccompProfileKey(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		profile  string
+		expected client.ObjectKey
+		ok       bool
+	}{
+		{"operator/default/nginx.json", client.ObjectKey{Namespace: "default", Name: "nginx"}, true},
+		{"operator/default/nginx", client.ObjectKey{}, false},
+		{"localhost/profile.json", client.ObjectKey{}, false},
+		{"RuntimeDefault", client.ObjectKey{}, false},
+		{"", client.ObjectKey{}, false},
+	} {
+		key, ok := seccompProfileKey(tc.profile)
+		require.Equal(t, tc.ok, ok, tc.profile)
+		require.Equal(t, tc.expected, key, tc.profile)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reconcileTimeout = 1 * time.Minute
+
+	errGetPartialReport = "cannot get partial violation report"
+	errMergeReport      = "cannot merge violation report"
+	errDeletePartial    = "cannot delete partial violation report"
+)
+
+// NewController returns a new empty controller instance.
+func NewController() controller.Controller {
+	return &ReportMergeReconciler{}
+}
+
+// A ReportMergeReconciler merges the partial per-node violation reports into
+// a single report per workload.
+type ReportMergeReconciler struct {
+	client client.Client
+	// reader reads directly from the API server, because a stale cache
+	// could result in merging a partial report twice.
+	reader client.Reader
+	log    logr.Logger
+}
+
+// Name returns the name of the controller.
+func (r *ReportMergeReconciler) Name() string {
+	return "violationreport"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *ReportMergeReconciler) SchemeBuilder() *scheme.Builder {
+	return violationreportapi.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *ReportMergeReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Security Profiles Operator RBAC permissions to manage ProfileViolationReports
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profileviolationreports,verbs=get;list;watch;create;update;delete
+
+// Reconcile merges a partial violation report into the report of its
+// workload, suggests the profile changes to allow its violations and deletes
+// it afterwards.
+func (r *ReportMergeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	logger := r.log.WithValues("report", req.Name, "namespace", req.Namespace)
+
+	partial := &violationreportapi.ProfileViolationReport{}
+	if err := r.reader.Get(ctx, req.NamespacedName, partial); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errGetPartialReport, err)
+	}
+
+	if !isPartial(partial) || !partial.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	reportName := partial.Labels[violationreportapi.ReportToWorkloadLabel]
+	if reportName == "" {
+		reportName = util.ViolationReportName(partial.Workload.Kind, partial.Workload.Name)
+	}
+
+	logger.Info("Merging partial violation report", "into", reportName, "node", partial.NodeName)
+	if err := r.merge(ctx, reportName, partial); err != nil {
+		if kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err) {
+			logger.Info("Violation report changed, retrying merge")
+			return reconcile.Result{Requeue: true}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errMergeReport, err)
+	}
+	r.suggest(ctx, partial)
+
+	uid := partial.GetUID()
+	if err := r.client.Delete(ctx, partial, &client.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	}); util.IgnoreNotFound(err) != nil {
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errDeletePartial, err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// merge adds the partial report to the workload report, which gets created
+// if it does not exist yet.
+func (r *ReportMergeReconciler) merge(
+	ctx context.Context,
+	reportName string,
+	partial *violationreportapi.ProfileViolationReport,
+) error {
+	report := &violationreportapi.ProfileViolationReport{}
+	err := r.reader.Get(ctx, client.ObjectKey{Namespace: partial.Namespace, Name: reportName}, report)
+	if util.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("get violation report: %w", err)
+	}
+
+	if kerrors.IsNotFound(err) {
+		report = &violationreportapi.ProfileViolationReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      reportName,
+				Namespace: partial.Namespace,
+			},
+			Workload:       partial.Workload,
+			MergedPartials: []types.UID{partial.GetUID()},
+		}
+		report.Merge(partial)
+
+		if err := r.client.Create(ctx, report); err != nil {
+			return fmt.Errorf("create violation report: %w", err)
+		}
+		return nil
+	}
+
+	if isPartial(report) {
+		return errors.New("violation report name conflicts with a partial report")
+	}
+
+	// The partial report may have been merged by a previous reconcile
+	// which failed to delete it.
+	if slices.Contains(report.MergedPartials, partial.GetUID()) {
+		return nil
+	}
+
+	merged, err := r.existingPartials(ctx, reportName, partial.Namespace, report.MergedPartials)
+	if err != nil {
+		return err
+	}
+
+	report.Merge(partial)
+	report.MergedPartials = append(merged, partial.GetUID())
+	if err := r.client.Update(ctx, report); err != nil {
+		return fmt.Errorf("update violation report: %w", err)
+	}
+	return nil
+}
+
+// existingPartials returns the UIDs of the merged partial reports which have
+// not been deleted yet.
+func (r *ReportMergeReconciler) existingPartials(
+	ctx context.Context, reportName, namespace string, merged []types.UID,
+) ([]types.UID, error) {
+	if len(merged) == 0 {
+		return []types.UID{}, nil
+	}
+
+	partials := &violationreportapi.ProfileViolationReportList{}
+	if err := r.reader.List(ctx, partials,
+		client.InNamespace(namespace),
+		client.MatchingLabels{violationreportapi.ReportToWorkloadLabel: reportName},
+	); err != nil {
+		return nil, fmt.Errorf("list partial violation reports: %w", err)
+	}
+
+	existing := []types.UID{}
+	for i := range partials.Items {
+		if uid := partials.Items[i].GetUID(); slices.Contains(merged, uid) {
+			existing = append(existing, uid)
+		}
+	}
+	return existing, nil
+}
+
+func isPartial(report *violationreportapi.ProfileViolationReport) bool {
+	_, ok := report.GetLabels()[profilebase.ProfilePartialLabel]
+	return ok
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package violationreport
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	violationreportapi "sigs.k8s.io/security-profiles-operator/api/profileviolationreport/v1alpha1"
+)
+
+func partialReport(name, node string, count int64) *violationreportapi.ProfileViolationReport {
+	return &violationreportapi.ProfileViolationReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name),
+			Labels: map[string]string{
+				profilebase.ProfilePartialLabel:          "true",
+				violationreportapi.ReportToWorkloadLabel: "deployment-nginx",
+				violationreportapi.ReportToNodeLabel:     node,
+			},
+		},
+		Workload: violationreportapi.WorkloadReference{Kind: "Deployment", Name: "nginx"},
+		NodeName: node,
+		Containers: []violationreportapi.ContainerViolations{{
+			Name: "nginx",
+			Syscalls: []violationreportapi.SyscallViolation{{
+				Name:   "mkdir",
+				Action: "SCMP_ACT_ERRNO",
+				ViolationCount: violationreportapi.ViolationCount{
+					Count:     count,
+					FirstSeen: metav1.Unix(100, 0),
+					LastSeen:  metav1.Unix(200, 0),
+				},
+			}},
+		}},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, violationreportapi.AddToScheme(s))
+
+	for _, tc := range []struct {
+		name           string
+		existing       []client.Object
+		request        string
+		expectedCount  int64
+		expectedMerged []types.UID
+	}{
+		{
+			name:           "create workload report",
+			existing:       []client.Object{partialReport("deployment-nginx-a", "node-a", 3)},
+			request:        "deployment-nginx-a",
+			expectedCount:  3,
+			expectedMerged: []types.UID{"deployment-nginx-a"},
+		},
+		{
+			name: "update workload report",
+			existing: []client.Object{
+				partialReport("deployment-nginx-a", "node-a", 3),
+				&violationreportapi.ProfileViolationReport{
+					ObjectMeta: metav1.ObjectMeta{Name: "deployment-nginx", Namespace: "default"},
+					Workload:   violationreportapi.WorkloadReference{Kind: "Deployment", Name: "nginx"},
+					Containers: partialReport("", "", 2).Containers,
+					TotalCount: 2,
+				},
+			},
+			request:        "deployment-nginx-a",
+			expectedCount:  5,
+			expectedMerged: []types.UID{"deployment-nginx-a"},
+		},
+		{
+			name: "partial report already merged",
+			existing: []client.Object{
+				partialReport("deployment-nginx-a", "node-a", 3),
+				&violationreportapi.ProfileViolationReport{
+					ObjectMeta:     metav1.ObjectMeta{Name: "deployment-nginx", Namespace: "default"},
+					Workload:       violationreportapi.WorkloadReference{Kind: "Deployment", Name: "nginx"},
+					Containers:     partialReport("", "", 3).Containers,
+					TotalCount:     3,
+					MergedPartials: []types.UID{"deployment-nginx-a"},
+				},
+			},
+			request:        "deployment-nginx-a",
+			expectedCount:  3,
+			expectedMerged: []types.UID{"deployment-nginx-a"},
+		},
+		{
+			name: "deleted partial reports get pruned",
+			existing: []client.Object{
+				partialReport("deployment-nginx-a", "node-a", 3),
+				partialReport("deployment-nginx-b", "node-b", 1),
+				&violationreportapi.ProfileViolationReport{
+					ObjectMeta:     metav1.ObjectMeta{Name: "deployment-nginx", Namespace: "default"},
+					Workload:       violationreportapi.WorkloadReference{Kind: "Deployment", Name: "nginx"},
+					Containers:     partialReport("", "", 2).Containers,
+					TotalCount:     2,
+					MergedPartials: []types.UID{"deployment-nginx-b", "deployment-nginx-c"},
+				},
+			},
+			request:        "deployment-nginx-a",
+			expectedCount:  5,
+			expectedMerged: []types.UID{"deployment-nginx-b", "deployment-nginx-a"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.existing...).Build()
+			sut := &ReportMergeReconciler{client: cli, reader: cli, log: logr.Discard()}
+
+			key := types.NamespacedName{Namespace: "default", Name: tc.request}
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			report := &violationreportapi.ProfileViolationReport{}
+			require.NoError(t, cli.Get(context.Background(),
+				types.NamespacedName{Namespace: "default", Name: "deployment-nginx"}, report))
+			require.Equal(t, tc.expectedCount, report.TotalCount)
+			require.Equal(t, tc.expectedMerged, report.MergedPartials)
+			require.Empty(t, report.NodeName)
+			require.NotContains(t, report.Labels, profilebase.ProfilePartialLabel)
+			require.Len(t, report.Containers, 1)
+			require.Len(t, report.Containers[0].Syscalls, 1)
+
+			err = cli.Get(context.Background(), key, &violationreportapi.ProfileViolationReport{})
+			require.True(t, kerrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestReconcileNotPartial(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, violationreportapi.AddToScheme(s))
+
+	report := &violationreportapi.ProfileViolationReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment-nginx", Namespace: "default"},
+		TotalCount: 1,
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(report).Build()
+	sut := &ReportMergeReconciler{client: cli, reader: cli, log: logr.Discard()}
+
+	key := types.NamespacedName{Namespace: "default", Name: "deployment-nginx"}
+	_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.NoError(t, cli.Get(context.Background(), key, report))
+	require.EqualValues(t, 1, report.TotalCount)
+}
+
+func TestMergeLimit(t *testing.T) {
+	t.Parallel()
+
+	syscalls := func(names []string, count, lastSeen int64) *violationreportapi.ProfileViolationReport {
+		report := &violationreportapi.ProfileViolationReport{}
+		container := violationreportapi.ContainerViolations{Name: "nginx"}
+		for i, name := range names {
+			container.Syscalls = append(container.Syscalls, violationreportapi.SyscallViolation{
+				Name:   name,
+				Action: "SCMP_ACT_ERRNO",
+				ViolationCount: violationreportapi.ViolationCount{
+					Count:     count,
+					FirstSeen: metav1.Unix(lastSeen+int64(i), 0),
+					LastSeen:  metav1.Unix(lastSeen+int64(i), 0),
+				},
+			})
+		}
+		report.Containers = []violationreportapi.ContainerViolations{container}
+		return report
+	}
+
+	names := make([]string, violationreportapi.MaxViolationsPerContainer)
+	for i := range names {
+		names[i] = fmt.Sprintf("syscall-%03d", i)
+	}
+	report := syscalls(names, 2, 100)
+
+	// A new violation with the lowest count gets evicted
+	report.Merge(syscalls([]string{"low"}, 1, 1000))
+	// A new violation with a higher count evicts the least recently seen one
+	report.Merge(syscalls([]string{"high"}, 5, 1000))
+	// Merging an existing violation does not evict anything
+	report.Merge(syscalls([]string{"syscall-100"}, 1, 1000))
+
+	result := report.Containers[0].Syscalls
+	require.Len(t, result, violationreportapi.MaxViolationsPerContainer)
+	contains := func(name string) bool {
+		return slices.ContainsFunc(result, func(v violationreportapi.SyscallViolation) bool {
+			return v.Name == name
+		})
+	}
+	require.True(t, contains("high"))
+	require.True(t, contains("syscall-001"))
+	require.False(t, contains("low"))
+	require.False(t, contains("syscall-000"))
+	require.True(t, slices.IsSortedFunc(result, func(a, b violationreportapi.SyscallViolation) int {
+		return strings.Compare(a.Name, b.Name)
+	}))
+	require.Equal(t, int64(2*(violationreportapi.MaxViolationsPerContainer-1)+5+1), report.TotalCount)
+}
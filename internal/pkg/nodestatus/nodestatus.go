@@ -226,6 +226,9 @@ func (nsf *StatusClient) SetNodeStatus(
 
 	status.Status = polState
 	status.Labels[secprofnodestatusv1alpha1.StatusStateLabel] = string(polState)
+	if polState == secprofnodestatusv1alpha1.ProfileStateInstalled {
+		status.InstalledGeneration = nsf.pol.GetGeneration()
+	}
 	if err := nsf.client.Update(ctx, &status); err != nil {
 		return fmt.Errorf("updating node status: %w", err)
 	}
@@ -244,9 +247,47 @@ func (nsf *StatusClient) Matches(
 		}
 		return false, fmt.Errorf("getting node status for matching: %w", err)
 	}
+	if polState == secprofnodestatusv1alpha1.ProfileStateInstalled && isLegacyInstalled(&status) {
+		// the status got written before the installed generation was tracked
+		status.InstalledGeneration = nsf.pol.GetGeneration()
+		if err := nsf.client.Update(ctx, &status); err != nil {
+			return false, fmt.Errorf("backfilling installed generation: %w", err)
+		}
+		return true, nil
+	}
+	if polState == secprofnodestatusv1alpha1.ProfileStateInstalled &&
+		status.InstalledGeneration != nsf.pol.GetGeneration() {
+		// a different generation of the profile is installed
+		return false, nil
+	}
 	return status.Status == polState, nil
 }
 
+// isLegacyInstalled returns true if the status reports an installed profile
+// without the installed generation, which is the case for statuses written
+// before the generation got tracked. They are considered to have the current
+// generation installed.
+func isLegacyInstalled(status *secprofnodestatusv1alpha1.SecurityProfileNodeStatus) bool {
+	return status.Status == secprofnodestatusv1alpha1.ProfileStateInstalled && status.InstalledGeneration == 0
+}
+
+// InstallAllowed returns true if the node is allowed to install the current
+// generation of the profile during a staged rollout. Nodes which did not
+// install any generation yet or have a legacy status without the installed
+// generation are always allowed to.
+func (nsf *StatusClient) InstallAllowed(ctx context.Context) (bool, error) {
+	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status); err != nil {
+		return false, fmt.Errorf("getting node status for rollout: %w", err)
+	}
+
+	generation := nsf.pol.GetGeneration()
+	if status.InstalledGeneration == 0 || status.InstalledGeneration >= generation {
+		return true, nil
+	}
+	return status.Spec.RolloutGeneration >= generation, nil
+}
+
 func getFinalizerString(pol profilebase.SecurityProfileBase, nodeName string) string {
 	if pol.IsPartial() {
 		return partialProfileFinalizer
@@ -17,13 +17,19 @@ limitations under the License.
 package nodestatus
 
 import (
+	"cmp"
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -66,6 +72,71 @@ func TestShortenNodeName(t *testing.T) {
 	}
 }
 
+func TestInstallAllowed(t *testing.T) {
+	for _, tc := range []struct {
+		name                    string
+		state                   secprofnodestatusv1alpha1.ProfileState
+		installedGeneration     int64
+		rolloutGeneration       int64
+		wantAllowed             bool
+		wantInstalledMatches    bool
+		wantInstalledGeneration int64
+	}{
+		{name: "NotInstalledYet", state: secprofnodestatusv1alpha1.ProfileStatePending, wantAllowed: true},
+		{
+			name: "LegacyStatusBackfilled", wantAllowed: true, wantInstalledMatches: true,
+			wantInstalledGeneration: 2,
+		},
+		{
+			name: "CurrentGenerationInstalled", installedGeneration: 2, wantAllowed: true, wantInstalledMatches: true,
+			wantInstalledGeneration: 2,
+		},
+		{name: "PreviousGenerationInstalled", installedGeneration: 1, wantInstalledGeneration: 1},
+		{
+			name: "PreviousGenerationApproved", installedGeneration: 1, rolloutGeneration: 2, wantAllowed: true,
+			wantInstalledGeneration: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.NodeNameEnvKey, "node")
+
+			s := runtime.NewScheme()
+			require.NoError(t, seccompprofile.AddToScheme(s))
+			require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(s))
+
+			profile := regularSeccompProfile()
+			profile.Generation = 2
+			status := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      profile.Name + "-node",
+					Namespace: profile.Namespace,
+				},
+				Spec: secprofnodestatusv1alpha1.SecurityProfileNodeStatusSpec{
+					RolloutGeneration: tc.rolloutGeneration,
+				},
+				NodeName:            "node",
+				Status:              cmp.Or(tc.state, secprofnodestatusv1alpha1.ProfileStateInstalled),
+				InstalledGeneration: tc.installedGeneration,
+			}
+			c := fake.NewClientBuilder().WithScheme(s).WithObjects(status).Build()
+
+			sc, err := NewForProfile(profile, c)
+			require.NoError(t, err)
+
+			allowed, err := sc.InstallAllowed(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.wantAllowed, allowed)
+
+			matches, err := sc.Matches(context.Background(), secprofnodestatusv1alpha1.ProfileStateInstalled)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantInstalledMatches, matches)
+
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(status), status))
+			require.Equal(t, tc.wantInstalledGeneration, status.InstalledGeneration)
+		})
+	}
+}
+
 func regularSeccompProfile() *seccompprofile.SeccompProfile {
 	return &seccompprofile.SeccompProfile{
 		ObjectMeta: metav1.ObjectMeta{
@@ -22,10 +22,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 )
 
 func NamespacedName(name, namespace string) types.NamespacedName {
@@ -83,3 +86,32 @@ func KindBasedDNSLengthName(obj client.Object) string {
 	kind := obj.GetObjectKind().GroupVersionKind().Kind
 	return dnsLengthName(kind, "%s-%s", kind, obj.GetName())
 }
+
+// ProfileInstalledNodeLabel returns the key of the node label which marks the
+// profile as installed on the node.
+func ProfileInstalledNodeLabel(kind, namespace, name string) string {
+	kind = strings.ToLower(kind)
+	return secprofnodestatusv1alpha1.ProfileInstalledNodeLabelPrefix +
+		dnsLengthName(kind, "%s-%s-%s", kind, namespace, name)
+}
+
+// ProfileRevisionOf returns the label value which identifies the revisions of
+// a profile.
+func ProfileRevisionOf(kind, name string) string {
+	kind = strings.ToLower(kind)
+	return dnsLengthName(kind, "%s-%s", kind, name)
+}
+
+// ProfileRevisionName returns the name of the ConfigMap which stores a
+// revision of a profile.
+func ProfileRevisionName(kind, name string, revision int64) string {
+	kind = strings.ToLower(kind)
+	return dnsLengthName(kind, "%s-%s-rev-%d", kind, name, revision)
+}
+
+// ViolationReportName returns the name of the violation report of a workload,
+// which is also usable as label value.
+func ViolationReportName(workloadKind, workloadName string) string {
+	kind := strings.ToLower(workloadKind)
+	return dnsLengthName(kind, "%s-%s", kind, workloadName)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package util
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -76,3 +77,37 @@ func TestNameHashing(t *testing.T) {
 		})
 	}
 }
+
+func TestViolationReportName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "deployment-nginx", ViolationReportName("Deployment", "nginx"))
+
+	name := ViolationReportName("StatefulSet", "this-is-a-very-long-name-surely-over-64-characters-omg")
+	require.Len(t, name, 63)
+	require.Regexp(t, "^statefulset-[0-9a-f]+$", name)
+}
+
+func TestProfileInstalledNodeLabel(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "installed.spo.x-k8s.io/seccompprofile-team-a-nginx",
+		ProfileInstalledNodeLabel("SeccompProfile", "team-a", "nginx"))
+
+	label := ProfileInstalledNodeLabel("SeccompProfile", "team-a", "this-is-a-very-long-name-surely-over-64-characters")
+	name := strings.TrimPrefix(label, "installed.spo.x-k8s.io/")
+	require.Len(t, name, 63)
+	require.Regexp(t, "^seccompprofile-[0-9a-f]+$", name)
+}
+
+func TestProfileRevisionName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "apparmorprofile-nginx", ProfileRevisionOf("AppArmorProfile", "nginx"))
+	require.Equal(t, "seccompprofile-nginx-rev-3", ProfileRevisionName("SeccompProfile", "nginx", 3))
+
+	long := "this-is-a-very-long-name-surely-over-64-characters"
+	name := ProfileRevisionName("SeccompProfile", long, 3)
+	require.Len(t, name, 63)
+	require.NotEqual(t, name, ProfileRevisionName("SeccompProfile", long, 4))
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// nodeAffinityEnabled returns true if pods should only be scheduled on nodes
+// which have their bound profiles installed.
+func (p *podBinder) nodeAffinityEnabled(ctx context.Context) bool {
+	spod, err := p.GetSPOd(ctx)
+	if err != nil {
+		p.log.Error(err, "cannot get spod, skipping node affinity")
+		return false
+	}
+	return spod.Spec.EnableNodeAffinity
+}
+
+// addNodeAffinity requires the pod to be scheduled on nodes labeled with all
+// bound profiles. It returns true if the pod got changed.
+func addNodeAffinity(pod *corev1.Pod, bound map[profileKey]bool) bool {
+	if len(bound) == 0 {
+		return false
+	}
+
+	labels := make([]string, 0, len(bound))
+	for key := range bound {
+		labels = append(labels, util.ProfileInstalledNodeLabel(string(key.kind), key.Namespace, key.Name))
+	}
+	slices.Sort(labels)
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	selector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+
+	// The terms are ORed, so every term has to require the profiles
+	changed := false
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		for _, label := range labels {
+			if slices.ContainsFunc(term.MatchExpressions, func(r corev1.NodeSelectorRequirement) bool {
+				return r.Key == label && r.Operator == corev1.NodeSelectorOpExists
+			}) {
+				continue
+			}
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      label,
+				Operator: corev1.NodeSelectorOpExists,
+			})
+			changed = true
+		}
+	}
+	return changed
+}
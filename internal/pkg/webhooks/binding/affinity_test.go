@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+)
+
+const (
+	seccompLabel = "installed.spo.x-k8s.io/seccompprofile-team-a-profile"
+	selinuxLabel = "installed.spo.x-k8s.io/selinuxprofile-team-a-profile"
+)
+
+func TestAddNodeAffinity(t *testing.T) {
+	t.Parallel()
+
+	bound := map[profileKey]bool{
+		{
+			kind:           v1alpha1.ProfileBindingKindSeccompProfile,
+			NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "profile"},
+		}: true,
+		{
+			kind:           v1alpha1.ProfileBindingKindSelinuxProfile,
+			NamespacedName: types.NamespacedName{Namespace: "team-a", Name: "profile"},
+		}: true,
+	}
+	exists := func(key string) corev1.NodeSelectorRequirement {
+		return corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpExists}
+	}
+	zone := corev1.NodeSelectorRequirement{
+		Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		affinity        *corev1.Affinity
+		bound           map[profileKey]bool
+		expectedChanged bool
+		expectedTerms   []corev1.NodeSelectorTerm
+	}{
+		{
+			name:            "no affinity",
+			bound:           bound,
+			expectedChanged: true,
+			expectedTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{exists(seccompLabel), exists(selinuxLabel)},
+			}},
+		},
+		{
+			name:          "no bound profiles",
+			bound:         map[profileKey]bool{},
+			expectedTerms: nil,
+		},
+		{
+			name: "existing terms",
+			affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{MatchExpressions: []corev1.NodeSelectorRequirement{zone}},
+						{MatchExpressions: []corev1.NodeSelectorRequirement{exists(seccompLabel)}},
+					},
+				},
+			}},
+			bound:           bound,
+			expectedChanged: true,
+			expectedTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					zone, exists(seccompLabel), exists(selinuxLabel),
+				}},
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					exists(seccompLabel), exists(selinuxLabel),
+				}},
+			},
+		},
+		{
+			name: "already required",
+			affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{exists(seccompLabel), exists(selinuxLabel)},
+					}},
+				},
+			}},
+			bound: bound,
+			expectedTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{exists(seccompLabel), exists(selinuxLabel)},
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			pod := testPod.DeepCopy()
+			pod.Spec.Affinity = tc.affinity
+			require.Equal(t, tc.expectedChanged, addNodeAffinity(pod, tc.bound))
+
+			if tc.expectedTerms == nil {
+				require.Nil(t, pod.Spec.Affinity)
+				return
+			}
+			require.Equal(t, tc.expectedTerms,
+				pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+		})
+	}
+}
+
+func TestUpdatePodNodeAffinity(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		operation admissionv1.Operation
+		enabled   bool
+		spodErr   error
+		expected  bool
+	}{
+		{name: "enabled", operation: admissionv1.Create, enabled: true, expected: true},
+		{name: "disabled", operation: admissionv1.Create},
+		{name: "spod not available", operation: admissionv1.Create, spodErr: errTest},
+		{name: "update", operation: admissionv1.Update, enabled: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			mock.GetSeccompProfileReturns(&seccompprofileapi.SeccompProfile{
+				Status: seccompprofileapi.SeccompProfileStatus{
+					StatusBase: profilebasev1alpha1.StatusBase{
+						Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+					},
+					LocalhostProfile: "operator/team-a/profile.json",
+				},
+			}, nil)
+			mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+				Spec: spodv1alpha1.SPODSpec{EnableNodeAffinity: tc.enabled},
+			}, tc.spodErr)
+
+			binder := &podBinder{impl: mock, log: logr.Discard()}
+			bindings := []v1alpha1.ProfileBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding"},
+				Spec: v1alpha1.ProfileBindingSpec{
+					ProfileRef: v1alpha1.ProfileRef{Kind: v1alpha1.ProfileBindingKindSeccompProfile, Name: "profile"},
+					Image:      "foo",
+				},
+			}}
+			req := &admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Name:      "pod",
+				Namespace: "team-a",
+				Operation: tc.operation,
+			}}
+			pod, _, resp := binder.updatePod(context.Background(), bindings, nil, req)
+			require.Empty(t, resp.Result)
+			require.NotNil(t, pod.Spec.Containers[0].SecurityContext.SeccompProfile)
+
+			if !tc.expected {
+				require.Nil(t, pod.Spec.Affinity)
+				return
+			}
+			require.Equal(t, []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key: seccompLabel, Operator: corev1.NodeSelectorOpExists,
+				}},
+			}}, pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+		})
+	}
+}
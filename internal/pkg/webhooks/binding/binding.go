@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
@@ -30,12 +32,15 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
@@ -47,19 +52,31 @@ const finalizer = "active-workload-lock"
 
 var ErrProfWithoutStatus = errors.New("profile hasn't been initialized with status")
 
+// appArmorFieldsVersion is the first Kubernetes version supporting the
+// appArmorProfile security context fields.
+var appArmorFieldsVersion = utilversion.MustParseGeneric("1.30.0")
+
 type podBinder struct {
 	impl
 	log logr.Logger
+
+	// appArmorAnnotations caches the result of useAppArmorAnnotations once
+	// the server version could be retrieved.
+	appArmorAnnotations   *bool
+	appArmorAnnotationsMu sync.Mutex
 }
 
-func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+func RegisterWebhook(
+	server webhook.Server, scheme *runtime.Scheme, c client.Client, dc discovery.ServerVersionInterface,
+) {
 	server.Register(
 		"/mutate-v1-pod-binding",
 		&webhook.Admission{
 			Handler: &podBinder{
 				impl: &defaultImpl{
-					client:  c,
-					decoder: admission.NewDecoder(scheme),
+					client:    c,
+					decoder:   admission.NewDecoder(scheme),
+					discovery: dc,
 				},
 				log: logf.Log.WithName("binding"),
 			},
@@ -97,11 +114,15 @@ func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resources=leases,verbs=create
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resourceNames=security-profiles-operator-webhook-lock,resources=leases,verbs=get;patch;update
 
@@ -118,9 +139,15 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	}
 	profilebindings := profileBindings.Items
 
-	pod, admissionResponse := p.updatePod(ctx, profilebindings, &req)
+	clusterProfileBindings, err := p.ListClusterProfileBindings(ctx)
+	if err != nil {
+		p.log.Error(err, "could not list cluster profile bindings")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	pod, warnings, admissionResponse := p.updatePod(ctx, profilebindings, clusterProfileBindings.Items, &req)
 	if !cmp.Equal(admissionResponse, admission.Response{}) {
-		return admissionResponse
+		return admissionResponse.WithWarnings(warnings...)
 	}
 
 	marshaledPod, err := json.Marshal(pod)
@@ -129,101 +156,227 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod).WithWarnings(warnings...)
 }
 
 func (p *podBinder) updatePod(
 	ctx context.Context,
 	profilebindings []profilebindingv1alpha1.ProfileBinding,
+	clusterBindings []profilebindingv1alpha1.ClusterProfileBinding,
 	req *admission.Request,
-) (*corev1.Pod, admission.Response) {
+) (*corev1.Pod, []string, admission.Response) {
 	var err error
-	var podBindProfile *interface{}
 	var containers sync.Map
-	var podProfileBinding *profilebindingv1alpha1.ProfileBinding
 	podID := req.Namespace + "/" + req.Name
 	pod := &corev1.Pod{}
-	podChanged := false
 	if req.Operation != "DELETE" {
 		pod, err = p.impl.DecodePod(*req)
 		if err != nil {
 			p.log.Error(err, "failed to decode pod")
-			return pod, admission.Errored(http.StatusBadRequest, err)
+			return pod, nil, admission.Errored(http.StatusBadRequest, err)
 		}
 		initContainerMap(&containers, &pod.Spec)
 	}
+
+	containerBindings := map[containerTarget]*candidate{}
+	podBindings := map[profilebindingv1alpha1.ProfileBindingKind]*candidate{}
+	warnings := []string{}
 	for i := range profilebindings {
-		profileKind := profilebindings[i].Spec.ProfileRef.Kind
-		if profileKind != profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
-			if profileKind != profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
-				p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
-				continue
-			}
+		pb := &profilebindings[i]
+		profileKind := pb.Spec.ProfileRef.Kind
+		switch profileKind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindSelinuxProfile,
+			profilebindingv1alpha1.ProfileBindingKindAppArmorProfile:
+		default:
+			p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
+			continue
 		}
 
-		profileName := profilebindings[i].Spec.ProfileRef.Name
 		if req.Operation == "DELETE" {
-			if err := p.removePodFromBinding(ctx, podID, &profilebindings[i]); err != nil {
-				return pod, admission.Errored(http.StatusInternalServerError, err)
+			if err := p.removePodFromBinding(ctx, podID, pb); err != nil {
+				return pod, nil, admission.Errored(http.StatusInternalServerError, err)
 			}
 			continue
 		}
-		namespacedName := types.NamespacedName{Namespace: req.Namespace, Name: profileName}
-		var bindProfile interface{}
-		var err error
 
-		if profileKind == profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
-			bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
+		matches, err := matchesPod(pb, pod.Labels)
+		if err != nil {
+			p.log.Error(err, "invalid pod selector", "profileBinding", pb.Name)
+			continue
+		}
+		if !matches {
+			continue
 		}
 
-		if profileKind == profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
-			bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
+		if isPodDefault(pb) {
+			c := &candidate{binding: pb, precedence: precedence{selector: pb.Spec.PodSelector != nil}}
+			warnings = selectCandidate(podBindings, profileKind, c, "pod", warnings)
+			continue
 		}
 
+		containers.Range(func(_, value any) bool {
+			cList, ok := value.(containerList)
+			if !ok {
+				return true
+			}
+			for _, container := range cList {
+				prec, ok := matchContainer(pb, container)
+				if !ok {
+					continue
+				}
+				c := &candidate{binding: pb, precedence: prec}
+				target := containerTarget{container: container, kind: profileKind}
+				warnings = selectCandidate(containerBindings, target, c, "container "+container.Name, warnings)
+			}
+			return true
+		})
+	}
+
+	for _, w := range warnings {
+		p.log.Info(w, "pod", podID)
+	}
+
+	profiles := map[profileKey]interface{}{}
+	bound := map[profileKey]bool{}
+	changedBindings := []*profilebindingv1alpha1.ProfileBinding{}
+	for target, c := range containerBindings {
+		key := bindingProfileKey(req.Namespace, c.binding)
+		bindProfile, err := p.getBindProfile(ctx, profiles, key)
 		if err != nil {
-			p.log.Error(err, fmt.Sprintf("failed to get %v %#v", profileKind, namespacedName))
-			return pod, admission.Errored(http.StatusInternalServerError, err)
+			return pod, warnings, admission.Errored(http.StatusInternalServerError, err)
 		}
-
-		if profilebindings[i].Spec.Image == profilebindingv1alpha1.SelectAllContainersImage {
-			podBindProfile = &bindProfile
-			podProfileBinding = &profilebindings[i]
+		if !p.addSecurityContext(pod, target.container, bindProfile) {
 			continue
 		}
-		value, ok := containers.Load(profilebindings[i].Spec.Image)
-		if !ok {
-			continue
+		bound[key] = true
+		if !slices.Contains(changedBindings, c.binding) {
+			changedBindings = append(changedBindings, c.binding)
 		}
-		containers, ok := value.(containerList)
-		if !ok {
-			continue
+	}
+
+	if len(changedBindings) == 0 {
+		// The "*" bindings only apply if no other binding matched
+		for _, c := range podBindings {
+			key := bindingProfileKey(req.Namespace, c.binding)
+			bindProfile, err := p.getBindProfile(ctx, profiles, key)
+			if err != nil {
+				return pod, warnings, admission.Errored(http.StatusInternalServerError, err)
+			}
+			if p.addPodSecurityContext(pod, bindProfile) {
+				bound[key] = true
+				changedBindings = append(changedBindings, c.binding)
+			}
 		}
+	}
 
-		for j := range containers {
-			podChanged = p.addSecurityContext(containers[j], bindProfile)
+	// Cluster bindings apply to all profile kinds not used by the pod yet.
+	// The security context of a pod is immutable, so they are only applied on
+	// creation.
+	clusterChanged := false
+	if req.Operation == "CREATE" {
+		clusterChanged, warnings, err = p.applyClusterBindings(
+			ctx, pod, podID, req.Namespace, clusterBindings, profiles, bound, warnings,
+		)
+		if err != nil {
+			return pod, warnings, admission.Errored(http.StatusInternalServerError, err)
 		}
-		if podChanged {
-			if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
-				return pod, admission.Errored(http.StatusInternalServerError, err)
-			}
+	}
+
+	// The node affinity of a pod is immutable, so it can only be set on creation
+	if req.Operation == "CREATE" && len(bound) > 0 && p.nodeAffinityEnabled(ctx) {
+		addNodeAffinity(pod, bound)
+	}
+
+	if len(changedBindings) == 0 && !clusterChanged {
+		return pod, warnings, admission.Allowed("pod unchanged")
+	}
+
+	slices.SortFunc(changedBindings, func(a, b *profilebindingv1alpha1.ProfileBinding) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, pb := range changedBindings {
+		if err := p.addPodToBinding(ctx, podID, pb); err != nil {
+			return pod, warnings, admission.Errored(http.StatusInternalServerError, err)
 		}
 	}
+	return pod, warnings, admission.Response{}
+}
+
+// containerTarget is a container and the profile kind to bind to it.
+type containerTarget struct {
+	container *corev1.Container
+	kind      profilebindingv1alpha1.ProfileBindingKind
+}
 
-	if podChanged {
-		return pod, admission.Response{}
+// selectCandidate stores the candidate for the key if it takes precedence
+// over the already selected one, and reports the shadowed binding.
+func selectCandidate[K comparable](
+	selected map[K]*candidate, key K, c *candidate, target string, warnings []string,
+) []string {
+	current, ok := selected[key]
+	if !ok {
+		selected[key] = c
+		return warnings
 	}
 
-	if podBindProfile == nil || podProfileBinding == nil {
-		return pod, admission.Allowed("pod unchanged")
+	winner, loser := current, c
+	if c.wins(current) {
+		winner, loser = c, current
+		selected[key] = c
 	}
+	return append(warnings, fmt.Sprintf(
+		"profile binding %s takes precedence over %s for %s",
+		winner.binding.Name, loser.binding.Name, target,
+	))
+}
+
+// profileKey identifies a profile to bind.
+type profileKey struct {
+	kind profilebindingv1alpha1.ProfileBindingKind
+	types.NamespacedName
+}
 
-	if !p.addPodSecurityContext(pod, *podBindProfile) {
-		return pod, admission.Allowed("pod unchanged")
+// bindingProfileKey returns the key of the profile referenced by the binding.
+func bindingProfileKey(namespace string, pb *profilebindingv1alpha1.ProfileBinding) profileKey {
+	return profileKey{
+		kind:           pb.Spec.ProfileRef.Kind,
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: pb.Spec.ProfileRef.Name},
 	}
-	if err := p.addPodToBinding(ctx, podID, podProfileBinding); err != nil {
-		return pod, admission.Errored(http.StatusInternalServerError, err)
+}
+
+// getBindProfile returns the profile for the key, profiles already retrieved
+// during the admission are reused.
+func (p *podBinder) getBindProfile(
+	ctx context.Context,
+	profiles map[profileKey]interface{},
+	key profileKey,
+) (interface{}, error) {
+	if bindProfile, ok := profiles[key]; ok {
+		return bindProfile, nil
 	}
-	return pod, admission.Response{}
+
+	profileKind := key.kind
+	namespacedName := key.NamespacedName
+	var bindProfile interface{}
+	var err error
+
+	switch profileKind {
+	case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+		bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
+	case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+		bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
+	case profilebindingv1alpha1.ProfileBindingKindAppArmorProfile:
+		bindProfile, err = p.getAppArmorProfile(ctx, namespacedName)
+	}
+
+	if err != nil {
+		p.log.Error(err, fmt.Sprintf("failed to get %v %#v", profileKind, namespacedName))
+		return nil, err
+	}
+
+	profiles[key] = bindProfile
+	return bindProfile, nil
 }
 
 func (p *podBinder) getSeccompProfile(
@@ -268,8 +421,58 @@ func (p *podBinder) getSelinuxProfile(
 	return selinuxProfile, err
 }
 
+func (p *podBinder) getAppArmorProfile(
+	ctx context.Context,
+	key types.NamespacedName,
+) (appArmorProfile *apparmorprofileapi.AppArmorProfile, err error) {
+	err = util.Retry(
+		func() (retryErr error) {
+			appArmorProfile, retryErr = p.GetAppArmorProfile(ctx, key)
+			if retryErr != nil {
+				return fmt.Errorf("getting profile: %w", retryErr)
+			}
+			if appArmorProfile.Status.Status == "" {
+				return fmt.Errorf("getting profile: %w", ErrProfWithoutStatus)
+			}
+			return nil
+		}, func(inErr error) bool {
+			return errors.Is(inErr, ErrProfWithoutStatus) || kerrors.IsNotFound(inErr)
+		})
+	//nolint:wrapcheck // error is already wrapped
+	return appArmorProfile, err
+}
+
+// useAppArmorAnnotations returns true if the cluster does not support the
+// appArmorProfile security context fields yet, which requires to use the
+// deprecated annotations instead. Failed server version lookups are retried
+// with the next call.
+func (p *podBinder) useAppArmorAnnotations() bool {
+	p.appArmorAnnotationsMu.Lock()
+	defer p.appArmorAnnotationsMu.Unlock()
+
+	if p.appArmorAnnotations != nil {
+		return *p.appArmorAnnotations
+	}
+
+	info, err := p.ServerVersion()
+	if err != nil {
+		p.log.Error(err, "cannot determine server version, using apparmor security context fields")
+		return false
+	}
+
+	useAnnotations := false
+	serverVersion, err := utilversion.ParseGeneric(info.GitVersion)
+	if err != nil {
+		p.log.Error(err, "cannot parse server version, using apparmor security context fields")
+	} else {
+		useAnnotations = serverVersion.LessThan(appArmorFieldsVersion)
+	}
+	p.appArmorAnnotations = &useAnnotations
+	return useAnnotations
+}
+
 func (p *podBinder) addSecurityContext(
-	c *corev1.Container, bindProfile interface{},
+	pod *corev1.Pod, c *corev1.Container, bindProfile interface{},
 ) bool {
 	var podChanged bool
 
@@ -278,6 +481,8 @@ func (p *podBinder) addSecurityContext(
 		podChanged = p.addSeccompContext(c, v)
 	case *selinuxprofileapi.SelinuxProfile:
 		podChanged = p.addSelinuxContext(c, v)
+	case *apparmorprofileapi.AppArmorProfile:
+		podChanged = p.addAppArmorContext(pod, c, v)
 	default:
 		p.log.Info("Unexpected Profile Type")
 		return false
@@ -327,6 +532,38 @@ func (p *podBinder) addSelinuxContext(
 	return podChanged
 }
 
+func (p *podBinder) addAppArmorContext(
+	pod *corev1.Pod, c *corev1.Container, appArmorProfile *apparmorprofileapi.AppArmorProfile,
+) bool {
+	podChanged := false
+	profileRef := appArmorProfile.GetProfileName()
+	annotation := corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix + c.Name
+
+	if _, ok := pod.Annotations[annotation]; ok ||
+		(c.SecurityContext != nil && c.SecurityContext.AppArmorProfile != nil) {
+		p.log.Info("cannot override existing apparmor profile for pod or container")
+		return podChanged
+	}
+
+	if p.useAppArmorAnnotations() {
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[annotation] = corev1.DeprecatedAppArmorBetaProfileNamePrefix + profileRef
+		return true
+	}
+
+	if c.SecurityContext == nil {
+		c.SecurityContext = &corev1.SecurityContext{}
+	}
+	c.SecurityContext.AppArmorProfile = &corev1.AppArmorProfile{
+		Type:             corev1.AppArmorProfileTypeLocalhost,
+		LocalhostProfile: &profileRef,
+	}
+	podChanged = true
+	return podChanged
+}
+
 func (p *podBinder) addPodSecurityContext(
 	pod *corev1.Pod, bindProfile interface{},
 ) bool {
@@ -337,6 +574,8 @@ func (p *podBinder) addPodSecurityContext(
 		podChanged = p.addPodSeccompContext(pod, v)
 	case *selinuxprofileapi.SelinuxProfile:
 		podChanged = p.addPodSelinuxContext(pod, v)
+	case *apparmorprofileapi.AppArmorProfile:
+		podChanged = p.addPodAppArmorContext(pod, v)
 	default:
 		p.log.Info("Unexpected Profile Type")
 		return false
@@ -386,33 +625,86 @@ func (p *podBinder) addPodSelinuxContext(
 	return podChanged
 }
 
+func (p *podBinder) addPodAppArmorContext(
+	pod *corev1.Pod, appArmorProfile *apparmorprofileapi.AppArmorProfile,
+) bool {
+	if p.useAppArmorAnnotations() {
+		// There is no pod level annotation, so all containers get annotated
+		podChanged := false
+		for i := range pod.Spec.InitContainers {
+			podChanged = p.addAppArmorContext(pod, &pod.Spec.InitContainers[i], appArmorProfile) || podChanged
+		}
+		for i := range pod.Spec.Containers {
+			podChanged = p.addAppArmorContext(pod, &pod.Spec.Containers[i], appArmorProfile) || podChanged
+		}
+		return podChanged
+	}
+
+	podChanged := false
+	profileRef := appArmorProfile.GetProfileName()
+	ap := corev1.AppArmorProfile{
+		Type:             corev1.AppArmorProfileTypeLocalhost,
+		LocalhostProfile: &profileRef,
+	}
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if pod.Spec.SecurityContext.AppArmorProfile != nil {
+		p.log.Info("cannot override existing apparmor profile for pod or container")
+	} else {
+		pod.Spec.SecurityContext.AppArmorProfile = &ap
+		podChanged = true
+	}
+	return podChanged
+}
+
 func (p *podBinder) addPodToBinding(
 	ctx context.Context,
 	podID string,
 	pb *profilebindingv1alpha1.ProfileBinding,
 ) error {
-	pb.Status.ActiveWorkloads = utils.AppendIfNotExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.impl.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	return p.addActiveWorkload(ctx, podID, pb, &pb.Status.ActiveWorkloads, "profilebinding")
+}
+
+func (p *podBinder) removePodFromBinding(
+	ctx context.Context,
+	podID string,
+	pb *profilebindingv1alpha1.ProfileBinding,
+) error {
+	return p.removeActiveWorkload(ctx, podID, pb, &pb.Status.ActiveWorkloads, "profilebinding")
+}
+
+func (p *podBinder) addActiveWorkload(
+	ctx context.Context,
+	podID string,
+	obj client.Object,
+	activeWorkloads *[]string,
+	name string,
+) error {
+	*activeWorkloads = utils.AppendIfNotExists(*activeWorkloads, podID)
+	if err := p.impl.UpdateResourceStatus(ctx, p.log, obj, name+" status"); err != nil {
 		return fmt.Errorf("add pod to binding: %w", err)
 	}
-	if !controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.AddFinalizer(pb, finalizer)
+	if !controllerutil.ContainsFinalizer(obj, finalizer) {
+		controllerutil.AddFinalizer(obj, finalizer)
 	}
-	return p.impl.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.impl.UpdateResource(ctx, p.log, obj, name)
 }
 
-func (p *podBinder) removePodFromBinding(
+func (p *podBinder) removeActiveWorkload(
 	ctx context.Context,
 	podID string,
-	pb *profilebindingv1alpha1.ProfileBinding,
+	obj client.Object,
+	activeWorkloads *[]string,
+	name string,
 ) error {
-	pb.Status.ActiveWorkloads = utils.RemoveIfExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.impl.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	*activeWorkloads = utils.RemoveIfExists(*activeWorkloads, podID)
+	if err := p.impl.UpdateResourceStatus(ctx, p.log, obj, name+" status"); err != nil {
 		return fmt.Errorf("remove pod from binding: %w", err)
 	}
-	if len(pb.Status.ActiveWorkloads) == 0 &&
-		controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.RemoveFinalizer(pb, finalizer)
+	if len(*activeWorkloads) == 0 &&
+		controllerutil.ContainsFinalizer(obj, finalizer) {
+		controllerutil.RemoveFinalizer(obj, finalizer)
 	}
-	return p.impl.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.impl.UpdateResource(ctx, p.log, obj, name)
 }
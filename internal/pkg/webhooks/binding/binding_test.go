@@ -30,8 +30,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -84,6 +87,15 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
 			},
 		},
+		{ // error could not list cluster profile bindings
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
 		{ // error failed to decode pod
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
@@ -488,6 +500,7 @@ func TestHandle(t *testing.T) {
 		},
 	} {
 		mock := &bindingfakes.FakeImpl{}
+		mock.ListClusterProfileBindingsReturns(&v1alpha1.ClusterProfileBindingList{}, nil)
 		tc.prepare(mock)
 
 		binder := podBinder{impl: mock, log: logr.Discard()}
@@ -496,6 +509,216 @@ func TestHandle(t *testing.T) {
 	}
 }
 
+func TestUpdatePodAppArmor(t *testing.T) {
+	t.Parallel()
+
+	const profileName = "nginx-profile"
+	annotation := corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix + "container"
+	localhostProfile := &corev1.AppArmorProfile{
+		Type:             corev1.AppArmorProfileTypeLocalhost,
+		LocalhostProfile: func() *string { s := profileName; return &s }(),
+	}
+
+	for _, tc := range []struct {
+		name          string
+		image         string
+		serverVersion *version.Info
+		versionErr    error
+		pod           func() *corev1.Pod
+		assert        func(*corev1.Pod, admission.Response)
+	}{
+		{
+			name:          "container security context",
+			image:         "foo",
+			serverVersion: &version.Info{GitVersion: "v1.30.2"},
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, localhostProfile, pod.Spec.Containers[0].SecurityContext.AppArmorProfile)
+				require.Empty(t, pod.Annotations)
+			},
+		},
+		{
+			name:          "pod security context",
+			image:         v1alpha1.SelectAllContainersImage,
+			serverVersion: &version.Info{GitVersion: "v1.31.0"},
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, localhostProfile, pod.Spec.SecurityContext.AppArmorProfile)
+				require.Nil(t, pod.Spec.Containers[0].SecurityContext)
+			},
+		},
+		{
+			name:          "container annotation on legacy cluster",
+			image:         "foo",
+			serverVersion: &version.Info{GitVersion: "v1.29.4+k3s1"},
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "localhost/"+profileName, pod.Annotations[annotation])
+				require.Nil(t, pod.Spec.Containers[0].SecurityContext)
+			},
+		},
+		{
+			name:          "all containers annotated on legacy cluster",
+			image:         v1alpha1.SelectAllContainersImage,
+			serverVersion: &version.Info{GitVersion: "v1.29.0"},
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "localhost/"+profileName, pod.Annotations[annotation])
+				require.Nil(t, pod.Spec.SecurityContext)
+			},
+		},
+		{
+			name:       "fields used if server version is unknown",
+			image:      "foo",
+			versionErr: errTest,
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, localhostProfile, pod.Spec.Containers[0].SecurityContext.AppArmorProfile)
+			},
+		},
+		{
+			name:          "existing annotation not overridden",
+			image:         "foo",
+			serverVersion: &version.Info{GitVersion: "v1.30.0"},
+			pod: func() *corev1.Pod {
+				pod := testPod.DeepCopy()
+				pod.Annotations = map[string]string{annotation: "runtime/default"}
+				return pod
+			},
+			assert: func(pod *corev1.Pod, resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "runtime/default", pod.Annotations[annotation])
+				require.Nil(t, pod.Spec.Containers[0].SecurityContext)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			pod := testPod.DeepCopy()
+			if tc.pod != nil {
+				pod = tc.pod()
+			}
+			mock.DecodePodReturns(pod, nil)
+			mock.ServerVersionReturns(tc.serverVersion, tc.versionErr)
+			mock.GetAppArmorProfileReturns(&apparmorprofileapi.AppArmorProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: profileName},
+				Status: apparmorprofileapi.AppArmorProfileStatus{
+					StatusBase: profilebasev1alpha1.StatusBase{
+						Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+					},
+				},
+			}, nil)
+
+			binder := &podBinder{impl: mock, log: logr.Discard()}
+			bindings := []v1alpha1.ProfileBinding{{
+				Spec: v1alpha1.ProfileBindingSpec{
+					ProfileRef: v1alpha1.ProfileRef{
+						Kind: v1alpha1.ProfileBindingKindAppArmorProfile,
+						Name: profileName,
+					},
+					Image: tc.image,
+				},
+			}}
+			res, _, resp := binder.updatePod(context.Background(), bindings, nil, &admission.Request{})
+			tc.assert(res, resp)
+		})
+	}
+}
+
+func TestUseAppArmorAnnotations(t *testing.T) {
+	t.Parallel()
+
+	mock := &bindingfakes.FakeImpl{}
+	mock.ServerVersionReturnsOnCall(0, nil, errTest)
+	mock.ServerVersionReturnsOnCall(1, &version.Info{GitVersion: "v1.29.0"}, nil)
+	binder := &podBinder{impl: mock, log: logr.Discard()}
+
+	require.False(t, binder.useAppArmorAnnotations())
+	require.True(t, binder.useAppArmorAnnotations())
+	require.True(t, binder.useAppArmorAnnotations())
+	require.Equal(t, 2, mock.ServerVersionCallCount())
+}
+
+func TestUpdatePodPrecedence(t *testing.T) {
+	t.Parallel()
+
+	seccompBinding := func(name string, spec v1alpha1.ProfileBindingSpec) v1alpha1.ProfileBinding {
+		spec.ProfileRef = v1alpha1.ProfileRef{Kind: v1alpha1.ProfileBindingKindSeccompProfile, Name: name}
+		return v1alpha1.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+
+	for _, tc := range []struct {
+		name             string
+		bindings         []v1alpha1.ProfileBinding
+		expectedProfile  string
+		expectedWarnings int
+	}{
+		{
+			name: "container name wins over image",
+			bindings: []v1alpha1.ProfileBinding{
+				seccompBinding("by-image", v1alpha1.ProfileBindingSpec{Image: "foo"}),
+				seccompBinding("by-name", v1alpha1.ProfileBindingSpec{ContainerNames: []string{"container"}}),
+			},
+			expectedProfile:  "by-name",
+			expectedWarnings: 1,
+		},
+		{
+			name: "pod selector mismatch",
+			bindings: []v1alpha1.ProfileBinding{
+				seccompBinding("by-image", v1alpha1.ProfileBindingSpec{Image: "foo"}),
+				seccompBinding("by-selector", v1alpha1.ProfileBindingSpec{
+					ContainerNames: []string{"container"},
+					PodSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+				}),
+			},
+			expectedProfile: "by-image",
+		},
+		{
+			name: "container binding wins over pod default",
+			bindings: []v1alpha1.ProfileBinding{
+				seccompBinding("default", v1alpha1.ProfileBindingSpec{Image: v1alpha1.SelectAllContainersImage}),
+				seccompBinding("by-glob", v1alpha1.ProfileBindingSpec{Image: "f*"}),
+			},
+			expectedProfile: "by-glob",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			pod := testPod.DeepCopy()
+			pod.Labels = map[string]string{"app": "nginx"}
+			mock.DecodePodReturns(pod, nil)
+			mock.GetSeccompProfileCalls(func(
+				_ context.Context, key types.NamespacedName,
+			) (*seccompprofileapi.SeccompProfile, error) {
+				return &seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: key.Name},
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+						LocalhostProfile: key.Name,
+					},
+				}, nil
+			})
+
+			binder := &podBinder{impl: mock, log: logr.Discard()}
+			res, warnings, resp := binder.updatePod(context.Background(), tc.bindings, nil, &admission.Request{})
+			require.Empty(t, resp.Result)
+			require.Len(t, warnings, tc.expectedWarnings)
+			require.Equal(t, tc.expectedProfile, *res.Spec.Containers[0].SecurityContext.SeccompProfile.LocalhostProfile)
+			require.Nil(t, res.Spec.SecurityContext)
+
+			require.Equal(t, 1, mock.UpdateResourceStatusCallCount())
+			_, _, obj, _ := mock.UpdateResourceStatusArgsForCall(0)
+			require.Equal(t, tc.expectedProfile, obj.GetName())
+		})
+	}
+}
+
 func TestNewContainerMap(t *testing.T) {
 	t.Parallel()
 
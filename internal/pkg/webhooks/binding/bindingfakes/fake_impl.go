@@ -24,11 +24,14 @@ import (
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
-	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	v1alpha1a "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	"sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	v1alpha1b "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
 type FakeImpl struct {
@@ -45,6 +48,61 @@ type FakeImpl struct {
 		result1 *v1.Pod
 		result2 error
 	}
+	GetAppArmorProfileStub        func(context.Context, types.NamespacedName) (*v1alpha1.AppArmorProfile, error)
+	getAppArmorProfileMutex       sync.RWMutex
+	getAppArmorProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}
+	getAppArmorProfileReturns struct {
+		result1 *v1alpha1.AppArmorProfile
+		result2 error
+	}
+	getAppArmorProfileReturnsOnCall map[int]struct {
+		result1 *v1alpha1.AppArmorProfile
+		result2 error
+	}
+	GetClusterProfileBindingStub        func(context.Context, string) (*v1alpha1a.ClusterProfileBinding, error)
+	getClusterProfileBindingMutex       sync.RWMutex
+	getClusterProfileBindingArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getClusterProfileBindingReturns struct {
+		result1 *v1alpha1a.ClusterProfileBinding
+		result2 error
+	}
+	getClusterProfileBindingReturnsOnCall map[int]struct {
+		result1 *v1alpha1a.ClusterProfileBinding
+		result2 error
+	}
+	GetNamespaceStub        func(context.Context, string) (*v1.Namespace, error)
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNamespaceReturns struct {
+		result1 *v1.Namespace
+		result2 error
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 *v1.Namespace
+		result2 error
+	}
+	GetSPOdStub        func(context.Context) (*v1alpha1b.SecurityProfilesOperatorDaemon, error)
+	getSPOdMutex       sync.RWMutex
+	getSPOdArgsForCall []struct {
+		arg1 context.Context
+	}
+	getSPOdReturns struct {
+		result1 *v1alpha1b.SecurityProfilesOperatorDaemon
+		result2 error
+	}
+	getSPOdReturnsOnCall map[int]struct {
+		result1 *v1alpha1b.SecurityProfilesOperatorDaemon
+		result2 error
+	}
 	GetSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1beta1.SeccompProfile, error)
 	getSeccompProfileMutex       sync.RWMutex
 	getSeccompProfileArgsForCall []struct {
@@ -73,18 +131,43 @@ type FakeImpl struct {
 		result1 *v1alpha2.SelinuxProfile
 		result2 error
 	}
-	ListProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
+	ListClusterProfileBindingsStub        func(context.Context) (*v1alpha1a.ClusterProfileBindingList, error)
+	listClusterProfileBindingsMutex       sync.RWMutex
+	listClusterProfileBindingsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listClusterProfileBindingsReturns struct {
+		result1 *v1alpha1a.ClusterProfileBindingList
+		result2 error
+	}
+	listClusterProfileBindingsReturnsOnCall map[int]struct {
+		result1 *v1alpha1a.ClusterProfileBindingList
+		result2 error
+	}
+	ListProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1alpha1a.ProfileBindingList, error)
 	listProfileBindingsMutex       sync.RWMutex
 	listProfileBindingsArgsForCall []struct {
 		arg1 context.Context
 		arg2 []client.ListOption
 	}
 	listProfileBindingsReturns struct {
-		result1 *v1alpha1.ProfileBindingList
+		result1 *v1alpha1a.ProfileBindingList
 		result2 error
 	}
 	listProfileBindingsReturnsOnCall map[int]struct {
-		result1 *v1alpha1.ProfileBindingList
+		result1 *v1alpha1a.ProfileBindingList
+		result2 error
+	}
+	ServerVersionStub        func() (*version.Info, error)
+	serverVersionMutex       sync.RWMutex
+	serverVersionArgsForCall []struct {
+	}
+	serverVersionReturns struct {
+		result1 *version.Info
+		result2 error
+	}
+	serverVersionReturnsOnCall map[int]struct {
+		result1 *version.Info
 		result2 error
 	}
 	UpdateResourceStub        func(context.Context, logr.Logger, client.Object, string) error
@@ -183,6 +266,265 @@ func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1.Pod, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) GetAppArmorProfile(arg1 context.Context, arg2 types.NamespacedName) (*v1alpha1.AppArmorProfile, error) {
+	fake.getAppArmorProfileMutex.Lock()
+	ret, specificReturn := fake.getAppArmorProfileReturnsOnCall[len(fake.getAppArmorProfileArgsForCall)]
+	fake.getAppArmorProfileArgsForCall = append(fake.getAppArmorProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+	}{arg1, arg2})
+	stub := fake.GetAppArmorProfileStub
+	fakeReturns := fake.getAppArmorProfileReturns
+	fake.recordInvocation("GetAppArmorProfile", []interface{}{arg1, arg2})
+	fake.getAppArmorProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetAppArmorProfileCallCount() int {
+	fake.getAppArmorProfileMutex.RLock()
+	defer fake.getAppArmorProfileMutex.RUnlock()
+	return len(fake.getAppArmorProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetAppArmorProfileCalls(stub func(context.Context, types.NamespacedName) (*v1alpha1.AppArmorProfile, error)) {
+	fake.getAppArmorProfileMutex.Lock()
+	defer fake.getAppArmorProfileMutex.Unlock()
+	fake.GetAppArmorProfileStub = stub
+}
+
+func (fake *FakeImpl) GetAppArmorProfileArgsForCall(i int) (context.Context, types.NamespacedName) {
+	fake.getAppArmorProfileMutex.RLock()
+	defer fake.getAppArmorProfileMutex.RUnlock()
+	argsForCall := fake.getAppArmorProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetAppArmorProfileReturns(result1 *v1alpha1.AppArmorProfile, result2 error) {
+	fake.getAppArmorProfileMutex.Lock()
+	defer fake.getAppArmorProfileMutex.Unlock()
+	fake.GetAppArmorProfileStub = nil
+	fake.getAppArmorProfileReturns = struct {
+		result1 *v1alpha1.AppArmorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetAppArmorProfileReturnsOnCall(i int, result1 *v1alpha1.AppArmorProfile, result2 error) {
+	fake.getAppArmorProfileMutex.Lock()
+	defer fake.getAppArmorProfileMutex.Unlock()
+	fake.GetAppArmorProfileStub = nil
+	if fake.getAppArmorProfileReturnsOnCall == nil {
+		fake.getAppArmorProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.AppArmorProfile
+			result2 error
+		})
+	}
+	fake.getAppArmorProfileReturnsOnCall[i] = struct {
+		result1 *v1alpha1.AppArmorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterProfileBinding(arg1 context.Context, arg2 string) (*v1alpha1a.ClusterProfileBinding, error) {
+	fake.getClusterProfileBindingMutex.Lock()
+	ret, specificReturn := fake.getClusterProfileBindingReturnsOnCall[len(fake.getClusterProfileBindingArgsForCall)]
+	fake.getClusterProfileBindingArgsForCall = append(fake.getClusterProfileBindingArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetClusterProfileBindingStub
+	fakeReturns := fake.getClusterProfileBindingReturns
+	fake.recordInvocation("GetClusterProfileBinding", []interface{}{arg1, arg2})
+	fake.getClusterProfileBindingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetClusterProfileBindingCallCount() int {
+	fake.getClusterProfileBindingMutex.RLock()
+	defer fake.getClusterProfileBindingMutex.RUnlock()
+	return len(fake.getClusterProfileBindingArgsForCall)
+}
+
+func (fake *FakeImpl) GetClusterProfileBindingCalls(stub func(context.Context, string) (*v1alpha1a.ClusterProfileBinding, error)) {
+	fake.getClusterProfileBindingMutex.Lock()
+	defer fake.getClusterProfileBindingMutex.Unlock()
+	fake.GetClusterProfileBindingStub = stub
+}
+
+func (fake *FakeImpl) GetClusterProfileBindingArgsForCall(i int) (context.Context, string) {
+	fake.getClusterProfileBindingMutex.RLock()
+	defer fake.getClusterProfileBindingMutex.RUnlock()
+	argsForCall := fake.getClusterProfileBindingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetClusterProfileBindingReturns(result1 *v1alpha1a.ClusterProfileBinding, result2 error) {
+	fake.getClusterProfileBindingMutex.Lock()
+	defer fake.getClusterProfileBindingMutex.Unlock()
+	fake.GetClusterProfileBindingStub = nil
+	fake.getClusterProfileBindingReturns = struct {
+		result1 *v1alpha1a.ClusterProfileBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterProfileBindingReturnsOnCall(i int, result1 *v1alpha1a.ClusterProfileBinding, result2 error) {
+	fake.getClusterProfileBindingMutex.Lock()
+	defer fake.getClusterProfileBindingMutex.Unlock()
+	fake.GetClusterProfileBindingStub = nil
+	if fake.getClusterProfileBindingReturnsOnCall == nil {
+		fake.getClusterProfileBindingReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1a.ClusterProfileBinding
+			result2 error
+		})
+	}
+	fake.getClusterProfileBindingReturnsOnCall[i] = struct {
+		result1 *v1alpha1a.ClusterProfileBinding
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespace(arg1 context.Context, arg2 string) (*v1.Namespace, error) {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{arg1, arg2})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeImpl) GetNamespaceCalls(stub func(context.Context, string) (*v1.Namespace, error)) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeImpl) GetNamespaceArgsForCall(i int) (context.Context, string) {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	argsForCall := fake.getNamespaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetNamespaceReturns(result1 *v1.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 *v1.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespaceReturnsOnCall(i int, result1 *v1.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 *v1.Namespace
+			result2 error
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 *v1.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOd(arg1 context.Context) (*v1alpha1b.SecurityProfilesOperatorDaemon, error) {
+	fake.getSPOdMutex.Lock()
+	ret, specificReturn := fake.getSPOdReturnsOnCall[len(fake.getSPOdArgsForCall)]
+	fake.getSPOdArgsForCall = append(fake.getSPOdArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetSPOdStub
+	fakeReturns := fake.getSPOdReturns
+	fake.recordInvocation("GetSPOd", []interface{}{arg1})
+	fake.getSPOdMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetSPOdCallCount() int {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	return len(fake.getSPOdArgsForCall)
+}
+
+func (fake *FakeImpl) GetSPOdCalls(stub func(context.Context) (*v1alpha1b.SecurityProfilesOperatorDaemon, error)) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = stub
+}
+
+func (fake *FakeImpl) GetSPOdArgsForCall(i int) context.Context {
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
+	argsForCall := fake.getSPOdArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GetSPOdReturns(result1 *v1alpha1b.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	fake.getSPOdReturns = struct {
+		result1 *v1alpha1b.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOdReturnsOnCall(i int, result1 *v1alpha1b.SecurityProfilesOperatorDaemon, result2 error) {
+	fake.getSPOdMutex.Lock()
+	defer fake.getSPOdMutex.Unlock()
+	fake.GetSPOdStub = nil
+	if fake.getSPOdReturnsOnCall == nil {
+		fake.getSPOdReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1b.SecurityProfilesOperatorDaemon
+			result2 error
+		})
+	}
+	fake.getSPOdReturnsOnCall[i] = struct {
+		result1 *v1alpha1b.SecurityProfilesOperatorDaemon
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GetSeccompProfile(arg1 context.Context, arg2 types.NamespacedName) (*v1beta1.SeccompProfile, error) {
 	fake.getSeccompProfileMutex.Lock()
 	ret, specificReturn := fake.getSeccompProfileReturnsOnCall[len(fake.getSeccompProfileArgsForCall)]
@@ -313,7 +655,71 @@ func (fake *FakeImpl) GetSelinuxProfileReturnsOnCall(i int, result1 *v1alpha2.Se
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1alpha1.ProfileBindingList, error) {
+func (fake *FakeImpl) ListClusterProfileBindings(arg1 context.Context) (*v1alpha1a.ClusterProfileBindingList, error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	ret, specificReturn := fake.listClusterProfileBindingsReturnsOnCall[len(fake.listClusterProfileBindingsArgsForCall)]
+	fake.listClusterProfileBindingsArgsForCall = append(fake.listClusterProfileBindingsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListClusterProfileBindingsStub
+	fakeReturns := fake.listClusterProfileBindingsReturns
+	fake.recordInvocation("ListClusterProfileBindings", []interface{}{arg1})
+	fake.listClusterProfileBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCallCount() int {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	return len(fake.listClusterProfileBindingsArgsForCall)
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCalls(stub func(context.Context) (*v1alpha1a.ClusterProfileBindingList, error)) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = stub
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsArgsForCall(i int) context.Context {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	argsForCall := fake.listClusterProfileBindingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturns(result1 *v1alpha1a.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	fake.listClusterProfileBindingsReturns = struct {
+		result1 *v1alpha1a.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturnsOnCall(i int, result1 *v1alpha1a.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	if fake.listClusterProfileBindingsReturnsOnCall == nil {
+		fake.listClusterProfileBindingsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1a.ClusterProfileBindingList
+			result2 error
+		})
+	}
+	fake.listClusterProfileBindingsReturnsOnCall[i] = struct {
+		result1 *v1alpha1a.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1alpha1a.ProfileBindingList, error) {
 	fake.listProfileBindingsMutex.Lock()
 	ret, specificReturn := fake.listProfileBindingsReturnsOnCall[len(fake.listProfileBindingsArgsForCall)]
 	fake.listProfileBindingsArgsForCall = append(fake.listProfileBindingsArgsForCall, struct {
@@ -339,7 +745,7 @@ func (fake *FakeImpl) ListProfileBindingsCallCount() int {
 	return len(fake.listProfileBindingsArgsForCall)
 }
 
-func (fake *FakeImpl) ListProfileBindingsCalls(stub func(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)) {
+func (fake *FakeImpl) ListProfileBindingsCalls(stub func(context.Context, ...client.ListOption) (*v1alpha1a.ProfileBindingList, error)) {
 	fake.listProfileBindingsMutex.Lock()
 	defer fake.listProfileBindingsMutex.Unlock()
 	fake.ListProfileBindingsStub = stub
@@ -352,28 +758,84 @@ func (fake *FakeImpl) ListProfileBindingsArgsForCall(i int) (context.Context, []
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeImpl) ListProfileBindingsReturns(result1 *v1alpha1.ProfileBindingList, result2 error) {
+func (fake *FakeImpl) ListProfileBindingsReturns(result1 *v1alpha1a.ProfileBindingList, result2 error) {
 	fake.listProfileBindingsMutex.Lock()
 	defer fake.listProfileBindingsMutex.Unlock()
 	fake.ListProfileBindingsStub = nil
 	fake.listProfileBindingsReturns = struct {
-		result1 *v1alpha1.ProfileBindingList
+		result1 *v1alpha1a.ProfileBindingList
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) ListProfileBindingsReturnsOnCall(i int, result1 *v1alpha1.ProfileBindingList, result2 error) {
+func (fake *FakeImpl) ListProfileBindingsReturnsOnCall(i int, result1 *v1alpha1a.ProfileBindingList, result2 error) {
 	fake.listProfileBindingsMutex.Lock()
 	defer fake.listProfileBindingsMutex.Unlock()
 	fake.ListProfileBindingsStub = nil
 	if fake.listProfileBindingsReturnsOnCall == nil {
 		fake.listProfileBindingsReturnsOnCall = make(map[int]struct {
-			result1 *v1alpha1.ProfileBindingList
+			result1 *v1alpha1a.ProfileBindingList
 			result2 error
 		})
 	}
 	fake.listProfileBindingsReturnsOnCall[i] = struct {
-		result1 *v1alpha1.ProfileBindingList
+		result1 *v1alpha1a.ProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ServerVersion() (*version.Info, error) {
+	fake.serverVersionMutex.Lock()
+	ret, specificReturn := fake.serverVersionReturnsOnCall[len(fake.serverVersionArgsForCall)]
+	fake.serverVersionArgsForCall = append(fake.serverVersionArgsForCall, struct {
+	}{})
+	stub := fake.ServerVersionStub
+	fakeReturns := fake.serverVersionReturns
+	fake.recordInvocation("ServerVersion", []interface{}{})
+	fake.serverVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ServerVersionCallCount() int {
+	fake.serverVersionMutex.RLock()
+	defer fake.serverVersionMutex.RUnlock()
+	return len(fake.serverVersionArgsForCall)
+}
+
+func (fake *FakeImpl) ServerVersionCalls(stub func() (*version.Info, error)) {
+	fake.serverVersionMutex.Lock()
+	defer fake.serverVersionMutex.Unlock()
+	fake.ServerVersionStub = stub
+}
+
+func (fake *FakeImpl) ServerVersionReturns(result1 *version.Info, result2 error) {
+	fake.serverVersionMutex.Lock()
+	defer fake.serverVersionMutex.Unlock()
+	fake.ServerVersionStub = nil
+	fake.serverVersionReturns = struct {
+		result1 *version.Info
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ServerVersionReturnsOnCall(i int, result1 *version.Info, result2 error) {
+	fake.serverVersionMutex.Lock()
+	defer fake.serverVersionMutex.Unlock()
+	fake.ServerVersionStub = nil
+	if fake.serverVersionReturnsOnCall == nil {
+		fake.serverVersionReturnsOnCall = make(map[int]struct {
+			result1 *version.Info
+			result2 error
+		})
+	}
+	fake.serverVersionReturnsOnCall[i] = struct {
+		result1 *version.Info
 		result2 error
 	}{result1, result2}
 }
@@ -511,12 +973,24 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.decodePodMutex.RLock()
 	defer fake.decodePodMutex.RUnlock()
+	fake.getAppArmorProfileMutex.RLock()
+	defer fake.getAppArmorProfileMutex.RUnlock()
+	fake.getClusterProfileBindingMutex.RLock()
+	defer fake.getClusterProfileBindingMutex.RUnlock()
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	fake.getSPOdMutex.RLock()
+	defer fake.getSPOdMutex.RUnlock()
 	fake.getSeccompProfileMutex.RLock()
 	defer fake.getSeccompProfileMutex.RUnlock()
 	fake.getSelinuxProfileMutex.RLock()
 	defer fake.getSelinuxProfileMutex.RUnlock()
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
 	fake.listProfileBindingsMutex.RLock()
 	defer fake.listProfileBindingsMutex.RUnlock()
+	fake.serverVersionMutex.RLock()
+	defer fake.serverVersionMutex.RUnlock()
 	fake.updateResourceMutex.RLock()
 	defer fake.updateResourceMutex.RUnlock()
 	fake.updateResourceStatusMutex.RLock()
@@ -0,0 +1,252 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+)
+
+// applyClusterBindings binds the profiles of the matching cluster bindings to
+// the pod, unless it already uses a profile of the same kind. The bound
+// profiles get recorded in bound, while the applied bindings are recorded in
+// the ClusterProfileBindingsAnnotation of the pod. Bindings whose profile
+// cannot be retrieved are skipped with a warning. It returns true if the pod
+// got changed.
+func (p *podBinder) applyClusterBindings(
+	ctx context.Context,
+	pod *corev1.Pod,
+	podID string,
+	namespace string,
+	clusterBindings []profilebindingv1alpha1.ClusterProfileBinding,
+	profiles map[profileKey]interface{},
+	bound map[profileKey]bool,
+	warnings []string,
+) (bool, []string, error) {
+	var namespaceLabels labels.Set
+	selected := map[profilebindingv1alpha1.ProfileBindingKind]*profilebindingv1alpha1.ClusterProfileBinding{}
+	for i := range clusterBindings {
+		cpb := &clusterBindings[i]
+		profileKind := cpb.Spec.ProfileRef.Kind
+		switch profileKind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindSelinuxProfile,
+			profilebindingv1alpha1.ProfileBindingKindAppArmorProfile:
+		default:
+			p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
+			continue
+		}
+
+		if cpb.Spec.NamespaceSelector != nil && namespaceLabels == nil {
+			ns, err := p.GetNamespace(ctx, namespace)
+			if err != nil {
+				return false, warnings, fmt.Errorf("get namespace of pod: %w", err)
+			}
+			namespaceLabels = labels.Set(ns.Labels)
+		}
+
+		matches, err := matchesSelectors(cpb, namespaceLabels, pod.Labels)
+		if err != nil {
+			p.log.Error(err, "invalid selector", "clusterProfileBinding", cpb.Name)
+			continue
+		}
+		if !matches {
+			continue
+		}
+
+		current, ok := selected[profileKind]
+		if !ok {
+			selected[profileKind] = cpb
+			continue
+		}
+		winner, loser := current, cpb
+		if clusterBindingWins(cpb, current) {
+			winner, loser = cpb, current
+			selected[profileKind] = cpb
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"cluster profile binding %s takes precedence over %s for pod",
+			winner.Name, loser.Name,
+		))
+	}
+
+	bindings := make([]*profilebindingv1alpha1.ClusterProfileBinding, 0, len(selected))
+	for _, cpb := range selected {
+		bindings = append(bindings, cpb)
+	}
+	slices.SortFunc(bindings, func(a, b *profilebindingv1alpha1.ClusterProfileBinding) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	applied := []string{}
+	for _, cpb := range bindings {
+		if hasProfile(pod, cpb.Spec.ProfileRef.Kind) {
+			p.log.Info("pod already uses a profile, skipping cluster profile binding",
+				"pod", podID, "clusterProfileBinding", cpb.Name)
+			continue
+		}
+
+		key := profileKey{
+			kind: cpb.Spec.ProfileRef.Kind,
+			NamespacedName: types.NamespacedName{
+				Namespace: cpb.Spec.ProfileRef.Namespace,
+				Name:      cpb.Spec.ProfileRef.Name,
+			},
+		}
+		bindProfile, err := p.getBindProfile(ctx, profiles, key)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"cluster profile binding %s skipped, cannot get %s %s",
+				cpb.Name, key.kind, key.NamespacedName,
+			))
+			continue
+		}
+		if !p.addPodSecurityContext(pod, bindProfile) {
+			continue
+		}
+		bound[key] = true
+		applied = append(applied, cpb.Name)
+		p.addBoundNamespace(ctx, cpb, namespace)
+	}
+
+	if len(applied) == 0 {
+		return false, warnings, nil
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[profilebindingv1alpha1.ClusterProfileBindingsAnnotation] = strings.Join(applied, ",")
+
+	return true, warnings, nil
+}
+
+// addBoundNamespace records the namespace of a bound pod in the status of the
+// cluster binding. The status is only updated for namespaces which are not
+// recorded yet. Errors are only logged, because they must not block the pod.
+func (p *podBinder) addBoundNamespace(
+	ctx context.Context, cpb *profilebindingv1alpha1.ClusterProfileBinding, namespace string,
+) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if slices.Contains(cpb.Status.BoundNamespaces, namespace) {
+			return nil
+		}
+		cpb.Status.BoundNamespaces = append(cpb.Status.BoundNamespaces, namespace)
+		slices.Sort(cpb.Status.BoundNamespaces)
+
+		err := p.impl.UpdateResourceStatus(ctx, p.log, cpb, "clusterprofilebinding status")
+		if kerrors.IsConflict(err) {
+			latest, getErr := p.impl.GetClusterProfileBinding(ctx, cpb.Name)
+			if getErr != nil {
+				return getErr
+			}
+			*cpb = *latest
+		}
+		return err
+	})
+	if err != nil {
+		p.log.Error(err, "cannot record namespace in cluster profile binding status",
+			"clusterProfileBinding", cpb.Name, "namespace", namespace)
+	}
+}
+
+// matchesSelectors returns true if the namespace and pod selectors of the
+// cluster binding match.
+func matchesSelectors(
+	cpb *profilebindingv1alpha1.ClusterProfileBinding, namespaceLabels, podLabels labels.Set,
+) (bool, error) {
+	for _, match := range []struct {
+		selector *metav1.LabelSelector
+		labels   labels.Set
+	}{
+		{cpb.Spec.NamespaceSelector, namespaceLabels},
+		{cpb.Spec.PodSelector, podLabels},
+	} {
+		if match.selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(match.selector)
+		if err != nil {
+			return false, fmt.Errorf("convert selector: %w", err)
+		}
+		if !selector.Matches(match.labels) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// clusterBindingWins returns true if the cluster binding takes precedence over
+// the other one.
+func clusterBindingWins(cpb, other *profilebindingv1alpha1.ClusterProfileBinding) bool {
+	if (cpb.Spec.PodSelector != nil) != (other.Spec.PodSelector != nil) {
+		return cpb.Spec.PodSelector != nil
+	}
+	return cpb.Name < other.Name
+}
+
+// hasProfile returns true if the pod or one of its containers already uses a
+// profile of the kind.
+func hasProfile(pod *corev1.Pod, kind profilebindingv1alpha1.ProfileBindingKind) bool {
+	if kind == profilebindingv1alpha1.ProfileBindingKindAppArmorProfile {
+		for annotation := range pod.Annotations {
+			if strings.HasPrefix(annotation, corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix) {
+				return true
+			}
+		}
+	}
+
+	if sc := pod.Spec.SecurityContext; sc != nil &&
+		usesProfile(kind, sc.SeccompProfile != nil, sc.SELinuxOptions != nil, sc.AppArmorProfile != nil) {
+		return true
+	}
+
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			if sc := containers[i].SecurityContext; sc != nil &&
+				usesProfile(kind, sc.SeccompProfile != nil, sc.SELinuxOptions != nil, sc.AppArmorProfile != nil) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// usesProfile returns true if a security context sets the profile of the kind.
+func usesProfile(kind profilebindingv1alpha1.ProfileBindingKind, seccomp, selinux, apparmor bool) bool {
+	switch kind {
+	case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
+		return seccomp
+	case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
+		return selinux
+	case profilebindingv1alpha1.ProfileBindingKindAppArmorProfile:
+		return apparmor
+	}
+	return false
+}
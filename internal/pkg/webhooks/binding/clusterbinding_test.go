@@ -0,0 +1,340 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+)
+
+func TestUpdatePodClusterBindings(t *testing.T) {
+	t.Parallel()
+
+	clusterBinding := func(
+		name string, kind v1alpha1.ProfileBindingKind, spec v1alpha1.ClusterProfileBindingSpec,
+	) v1alpha1.ClusterProfileBinding {
+		spec.ProfileRef = v1alpha1.ClusterProfileRef{Kind: kind, Name: name, Namespace: "defaults"}
+		return v1alpha1.ClusterProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+	teamSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	for _, tc := range []struct {
+		name            string
+		operation       admissionv1.Operation
+		pod             func() *corev1.Pod
+		bindings        []v1alpha1.ProfileBinding
+		clusterBindings []v1alpha1.ClusterProfileBinding
+		prepare         func(*bindingfakes.FakeImpl)
+		assert          func(*corev1.Pod, []string, admission.Response, *bindingfakes.FakeImpl)
+	}{
+		{
+			name: "default profile in selected namespace",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{NamespaceSelector: teamSelector}),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "defaults/default", *pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile)
+				require.Equal(t, "default", pod.Annotations[v1alpha1.ClusterProfileBindingsAnnotation])
+
+				require.Equal(t, 1, mock.UpdateResourceStatusCallCount())
+				_, _, obj, _ := mock.UpdateResourceStatusArgsForCall(0)
+				cpb, ok := obj.(*v1alpha1.ClusterProfileBinding)
+				require.True(t, ok)
+				require.Equal(t, []string{"team-a"}, cpb.Status.BoundNamespaces)
+				require.Empty(t, cpb.Finalizers)
+				require.Zero(t, mock.UpdateResourceCallCount())
+			},
+		},
+		{
+			name: "namespace already recorded",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				func() v1alpha1.ClusterProfileBinding {
+					cpb := clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+						v1alpha1.ClusterProfileBindingSpec{})
+					cpb.Status.BoundNamespaces = []string{"team-a"}
+					return cpb
+				}(),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "defaults/default", *pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile)
+				require.Zero(t, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name: "retry status update on conflict",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.UpdateResourceStatusReturnsOnCall(0, kerrors.NewConflict(
+					schema.GroupResource{Resource: "clusterprofilebindings"}, "default", errTest,
+				))
+				latest := clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{})
+				latest.Status.BoundNamespaces = []string{"team-b"}
+				mock.GetClusterProfileBindingReturns(&latest, nil)
+			},
+			assert: func(_ *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, 1, mock.GetClusterProfileBindingCallCount())
+				require.Equal(t, 2, mock.UpdateResourceStatusCallCount())
+				_, _, obj, _ := mock.UpdateResourceStatusArgsForCall(1)
+				cpb, ok := obj.(*v1alpha1.ClusterProfileBinding)
+				require.True(t, ok)
+				require.Equal(t, []string{"team-a", "team-b"}, cpb.Status.BoundNamespaces)
+			},
+		},
+		{
+			name: "failed status update does not block the pod",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.UpdateResourceStatusReturns(errTest)
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "defaults/default", *pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile)
+				require.Equal(t, 1, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name: "namespace not selected",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+					}),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Nil(t, pod.Spec.SecurityContext)
+				require.Zero(t, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name: "pod already uses a profile",
+			pod: func() *corev1.Pod {
+				pod := testPod.DeepCopy()
+				pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				}
+				return pod
+			},
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Nil(t, pod.Spec.SecurityContext)
+				require.Zero(t, mock.GetSeccompProfileCallCount())
+			},
+		},
+		{
+			name: "namespaced binding takes precedence for its kind",
+			bindings: []v1alpha1.ProfileBinding{{
+				ObjectMeta: metav1.ObjectMeta{Name: "namespaced"},
+				Spec: v1alpha1.ProfileBindingSpec{
+					ProfileRef: v1alpha1.ProfileRef{Kind: v1alpha1.ProfileBindingKindSeccompProfile, Name: "namespaced"},
+					Image:      "foo",
+				},
+			}},
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+				clusterBinding("selinux", v1alpha1.ProfileBindingKindSelinuxProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "team-a/namespaced",
+					*pod.Spec.Containers[0].SecurityContext.SeccompProfile.LocalhostProfile)
+				require.Nil(t, pod.Spec.SecurityContext.SeccompProfile)
+				require.Equal(t, "selinux.process", pod.Spec.SecurityContext.SELinuxOptions.Type)
+				require.Equal(t, 2, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name: "pod selector takes precedence",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("a-default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+				clusterBinding("b-nginx", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{
+						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+					}),
+			},
+			assert: func(pod *corev1.Pod, warnings []string, resp admission.Response, _ *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Equal(t, "defaults/b-nginx", *pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile)
+				require.Equal(t, []string{
+					"cluster profile binding b-nginx takes precedence over a-default for pod",
+				}, warnings)
+			},
+		},
+		{
+			name: "binding with missing profile is skipped",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+				clusterBinding("selinux", v1alpha1.ProfileBindingKindSelinuxProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.GetSeccompProfileReturns(nil, errTest)
+			},
+			assert: func(pod *corev1.Pod, warnings []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Empty(t, resp.Result)
+				require.Nil(t, pod.Spec.SecurityContext.SeccompProfile)
+				require.Equal(t, "selinux.process", pod.Spec.SecurityContext.SELinuxOptions.Type)
+				require.Equal(t, "selinux", pod.Annotations[v1alpha1.ClusterProfileBindingsAnnotation])
+				require.Equal(t, []string{
+					"cluster profile binding default skipped, cannot get SeccompProfile defaults/default",
+				}, warnings)
+				require.Equal(t, 1, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name: "all bindings with missing profiles",
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.GetSeccompProfileReturns(nil, errTest)
+			},
+			assert: func(pod *corev1.Pod, warnings []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Nil(t, pod.Spec.SecurityContext)
+				require.Empty(t, pod.Annotations)
+				require.Len(t, warnings, 1)
+				require.Zero(t, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name:      "updated pod is not changed",
+			operation: admissionv1.Update,
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				clusterBinding("default", v1alpha1.ProfileBindingKindSeccompProfile,
+					v1alpha1.ClusterProfileBindingSpec{}),
+			},
+			assert: func(pod *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+				require.Nil(t, pod.Spec.SecurityContext)
+				require.Zero(t, mock.GetSeccompProfileCallCount())
+				require.Zero(t, mock.UpdateResourceStatusCallCount())
+			},
+		},
+		{
+			name:      "deleted pod does not update cluster bindings",
+			operation: admissionv1.Delete,
+			clusterBindings: []v1alpha1.ClusterProfileBinding{
+				func() v1alpha1.ClusterProfileBinding {
+					cpb := clusterBinding("bound", v1alpha1.ProfileBindingKindSeccompProfile,
+						v1alpha1.ClusterProfileBindingSpec{})
+					cpb.Status.BoundNamespaces = []string{"team-a"}
+					return cpb
+				}(),
+			},
+			assert: func(_ *corev1.Pod, _ []string, resp admission.Response, mock *bindingfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Zero(t, mock.UpdateResourceStatusCallCount())
+				require.Zero(t, mock.UpdateResourceCallCount())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			pod := testPod.DeepCopy()
+			if tc.pod != nil {
+				pod = tc.pod()
+			}
+			pod.Labels = map[string]string{"app": "nginx"}
+			mock.DecodePodReturns(pod, nil)
+			mock.GetNamespaceReturns(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}},
+			}, nil)
+			mock.GetSeccompProfileCalls(func(
+				_ context.Context, key types.NamespacedName,
+			) (*seccompprofileapi.SeccompProfile, error) {
+				return &seccompprofileapi.SeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+						LocalhostProfile: key.String(),
+					},
+				}, nil
+			})
+			mock.GetSelinuxProfileReturns(&selinuxprofileapi.SelinuxProfile{
+				Status: selinuxprofileapi.SelinuxProfileStatus{
+					StatusBase: profilebasev1alpha1.StatusBase{
+						Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+					},
+					Usage: "selinux.process",
+				},
+			}, nil)
+
+			mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+
+			operation := tc.operation
+			if operation == "" {
+				operation = admissionv1.Create
+			}
+
+			binder := &podBinder{impl: mock, log: logr.Discard()}
+			req := &admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Name:      "pod",
+				Namespace: "team-a",
+				Operation: operation,
+			}}
+			res, warnings, resp := binder.updatePod(context.Background(), tc.bindings, tc.clusterBindings, req)
+			tc.assert(res, warnings, resp, mock)
+		})
+	}
+}
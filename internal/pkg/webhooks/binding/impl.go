@@ -23,29 +23,41 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
 type defaultImpl struct {
-	client  client.Client
-	decoder admission.Decoder
+	client    client.Client
+	decoder   admission.Decoder
+	discovery discovery.ServerVersionInterface
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
 //counterfeiter:generate . impl
 type impl interface {
 	ListProfileBindings(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
+	ListClusterProfileBindings(context.Context) (*v1alpha1.ClusterProfileBindingList, error)
+	GetClusterProfileBinding(context.Context, string) (*v1alpha1.ClusterProfileBinding, error)
+	GetNamespace(context.Context, string) (*corev1.Namespace, error)
 	UpdateResource(context.Context, logr.Logger, client.Object, string) error
 	UpdateResourceStatus(context.Context, logr.Logger, client.Object, string) error
 	DecodePod(admission.Request) (*corev1.Pod, error)
 	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
 	GetSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.SelinuxProfile, error)
+	GetAppArmorProfile(context.Context, types.NamespacedName) (*apparmorprofileapi.AppArmorProfile, error)
+	GetSPOd(context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
+	ServerVersion() (*version.Info, error)
 }
 
 func (d *defaultImpl) ListProfileBindings(
@@ -58,6 +70,34 @@ func (d *defaultImpl) ListProfileBindings(
 	return profileBindings, nil
 }
 
+func (d *defaultImpl) ListClusterProfileBindings(
+	ctx context.Context,
+) (*v1alpha1.ClusterProfileBindingList, error) {
+	clusterProfileBindings := &v1alpha1.ClusterProfileBindingList{}
+	if err := d.client.List(ctx, clusterProfileBindings); err != nil {
+		return nil, fmt.Errorf("list cluster profile bindings: %w", err)
+	}
+	return clusterProfileBindings, nil
+}
+
+func (d *defaultImpl) GetClusterProfileBinding(
+	ctx context.Context, name string,
+) (*v1alpha1.ClusterProfileBinding, error) {
+	clusterProfileBinding := &v1alpha1.ClusterProfileBinding{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, clusterProfileBinding); err != nil {
+		return nil, fmt.Errorf("get cluster profile binding: %w", err)
+	}
+	return clusterProfileBinding, nil
+}
+
+func (d *defaultImpl) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+	return namespace, nil
+}
+
 func (d *defaultImpl) UpdateResource(
 	ctx context.Context,
 	logger logr.Logger,
@@ -105,3 +145,30 @@ func (d *defaultImpl) GetSelinuxProfile(
 	}
 	return selinuxProfile, nil
 }
+
+func (d *defaultImpl) GetAppArmorProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*apparmorprofileapi.AppArmorProfile, error) {
+	appArmorProfile := &apparmorprofileapi.AppArmorProfile{}
+	if err := d.client.Get(ctx, key, appArmorProfile); err != nil {
+		return nil, fmt.Errorf("get apparmor profile: %w", err)
+	}
+	return appArmorProfile, nil
+}
+
+func (d *defaultImpl) GetSPOd(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: config.GetOperatorNamespace()}
+	if err := d.client.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
+func (d *defaultImpl) ServerVersion() (*version.Info, error) {
+	info, err := d.discovery.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("get server version: %w", err)
+	}
+	return info, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+)
+
+// imageMatch is the kind of match between a binding image and a container
+// image, more specific matches have higher values.
+type imageMatch int
+
+const (
+	imageMatchNone imageMatch = iota
+	imageMatchAny
+	imageMatchGlob
+	imageMatchRepository
+	imageMatchExact
+)
+
+// precedence of a binding for a container. Bindings with higher precedence
+// win over the other bindings of the same profile kind.
+type precedence struct {
+	container bool
+	image     imageMatch
+	selector  bool
+}
+
+// compare returns a positive number if p takes precedence over o, a negative
+// number if o takes precedence over p and zero otherwise.
+func (p precedence) compare(o precedence) int {
+	if p.container != o.container {
+		if p.container {
+			return 1
+		}
+		return -1
+	}
+	if p.image != o.image {
+		return int(p.image) - int(o.image)
+	}
+	if p.selector != o.selector {
+		if p.selector {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+// candidate is a binding matching a container or pod.
+type candidate struct {
+	binding    *profilebindingv1alpha1.ProfileBinding
+	precedence precedence
+}
+
+// wins returns true if the candidate takes precedence over the other one.
+// The binding name decides if both are equally specific.
+func (c *candidate) wins(o *candidate) bool {
+	if cmp := c.precedence.compare(o.precedence); cmp != 0 {
+		return cmp > 0
+	}
+	return c.binding.Name < o.binding.Name
+}
+
+// isPodDefault returns true if the binding applies to the pod security context.
+func isPodDefault(pb *profilebindingv1alpha1.ProfileBinding) bool {
+	return pb.Spec.Image == profilebindingv1alpha1.SelectAllContainersImage &&
+		len(pb.Spec.ContainerNames) == 0
+}
+
+// matchesPod returns true if the pod selector of the binding matches the pod
+// labels.
+func matchesPod(pb *profilebindingv1alpha1.ProfileBinding, podLabels labels.Set) (bool, error) {
+	if pb.Spec.PodSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(pb.Spec.PodSelector)
+	if err != nil {
+		return false, fmt.Errorf("convert pod selector: %w", err)
+	}
+	return selector.Matches(podLabels), nil
+}
+
+// matchContainer returns the precedence of the binding for the container and
+// whether it matches at all.
+func matchContainer(pb *profilebindingv1alpha1.ProfileBinding, c *corev1.Container) (precedence, bool) {
+	res := precedence{selector: pb.Spec.PodSelector != nil}
+
+	if len(pb.Spec.ContainerNames) > 0 {
+		if !slices.Contains(pb.Spec.ContainerNames, c.Name) {
+			return res, false
+		}
+		res.container = true
+	}
+
+	res.image = matchImage(pb.Spec.Image, c.Image)
+	return res, res.image != imageMatchNone
+}
+
+// matchImage matches the image of a binding against a container image.
+func matchImage(pattern, image string) imageMatch {
+	if pattern == "" || pattern == profilebindingv1alpha1.SelectAllContainersImage {
+		return imageMatchAny
+	}
+
+	if pattern == image {
+		return imageMatchExact
+	}
+
+	repository := imageRepository(image)
+	patternHasTag := imageRepository(pattern) != pattern
+	if !patternHasTag && pattern == repository {
+		return imageMatchRepository
+	}
+
+	if strings.ContainsAny(pattern, "*?[") {
+		if matched, err := path.Match(pattern, image); err == nil && matched {
+			return imageMatchGlob
+		}
+		if matched, err := path.Match(pattern, repository); err == nil && matched && !patternHasTag {
+			return imageMatchGlob
+		}
+	}
+
+	return imageMatchNone
+}
+
+// imageRepository returns the image without tag and digest.
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+)
+
+func TestMatchImage(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern, image string
+		expected       imageMatch
+	}{
+		{"", "nginx:1.19.1", imageMatchAny},
+		{"*", "nginx:1.19.1", imageMatchAny},
+		{"nginx:1.19.1", "nginx:1.19.1", imageMatchExact},
+		{"nginx", "nginx:1.19.1", imageMatchRepository},
+		{"nginx", "nginx@sha256:0123456789abcdef", imageMatchRepository},
+		{"nginx", "nginx:1.19.1@sha256:0123456789abcdef", imageMatchRepository},
+		{"localhost:5000/nginx", "localhost:5000/nginx:1.19.1", imageMatchRepository},
+		{"nginx:1.19.2", "nginx:1.19.1", imageMatchNone},
+		{"nginx", "nginx-unprivileged:1.19.1", imageMatchNone},
+		{"quay.io/my-org/*", "quay.io/my-org/app:v1", imageMatchGlob},
+		{"quay.io/my-org/*", "quay.io/my-org/app@sha256:0123456789abcdef", imageMatchGlob},
+		{"quay.io/my-org/*", "quay.io/other-org/app:v1", imageMatchNone},
+		{"nginx:1.*", "nginx:1.19.1", imageMatchGlob},
+		{"nginx:1.*", "nginx:2.0.0", imageMatchNone},
+	} {
+		require.Equal(t, tc.expected, matchImage(tc.pattern, tc.image), "%s %s", tc.pattern, tc.image)
+	}
+}
+
+func TestMatchContainer(t *testing.T) {
+	t.Parallel()
+
+	container := &corev1.Container{Name: "web", Image: "nginx:1.19.1"}
+	for _, tc := range []struct {
+		name     string
+		spec     v1alpha1.ProfileBindingSpec
+		expected precedence
+		matches  bool
+	}{
+		{
+			name:     "exact image",
+			spec:     v1alpha1.ProfileBindingSpec{Image: "nginx:1.19.1"},
+			expected: precedence{image: imageMatchExact},
+			matches:  true,
+		},
+		{
+			name:     "container name",
+			spec:     v1alpha1.ProfileBindingSpec{ContainerNames: []string{"sidecar", "web"}},
+			expected: precedence{container: true, image: imageMatchAny},
+			matches:  true,
+		},
+		{
+			name: "container name mismatch",
+			spec: v1alpha1.ProfileBindingSpec{ContainerNames: []string{"sidecar"}},
+		},
+		{
+			name: "image mismatch",
+			spec: v1alpha1.ProfileBindingSpec{ContainerNames: []string{"web"}, Image: "redis"},
+		},
+		{
+			name: "pod selector",
+			spec: v1alpha1.ProfileBindingSpec{
+				Image:       "nginx",
+				PodSelector: &metav1.LabelSelector{},
+			},
+			expected: precedence{image: imageMatchRepository, selector: true},
+			matches:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			prec, matches := matchContainer(&v1alpha1.ProfileBinding{Spec: tc.spec}, container)
+			require.Equal(t, tc.matches, matches)
+			if tc.matches {
+				require.Equal(t, tc.expected, prec)
+			}
+		})
+	}
+}
+
+func TestMatchesPod(t *testing.T) {
+	t.Parallel()
+
+	podLabels := labels.Set{"app": "nginx"}
+	pb := &v1alpha1.ProfileBinding{}
+
+	matches, err := matchesPod(pb, podLabels)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	pb.Spec.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "redis"}}
+	matches, err = matchesPod(pb, podLabels)
+	require.NoError(t, err)
+	require.False(t, matches)
+
+	pb.Spec.PodSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+		Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"nginx", "redis"},
+	}}}
+	matches, err = matchesPod(pb, podLabels)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	pb.Spec.PodSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+		Key: "app", Operator: "invalid",
+	}}}
+	_, err = matchesPod(pb, podLabels)
+	require.Error(t, err)
+}
+
+func TestCandidateWins(t *testing.T) {
+	t.Parallel()
+
+	candidateFor := func(name string, prec precedence) *candidate {
+		return &candidate{
+			binding:    &v1alpha1.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: name}},
+			precedence: prec,
+		}
+	}
+
+	for _, tc := range []struct {
+		name          string
+		winner, loser *candidate
+	}{
+		{
+			name:   "container name over exact image",
+			winner: candidateFor("b", precedence{container: true, image: imageMatchAny}),
+			loser:  candidateFor("a", precedence{image: imageMatchExact, selector: true}),
+		},
+		{
+			name:   "exact image over repository",
+			winner: candidateFor("b", precedence{image: imageMatchExact}),
+			loser:  candidateFor("a", precedence{image: imageMatchRepository, selector: true}),
+		},
+		{
+			name:   "repository over glob",
+			winner: candidateFor("b", precedence{image: imageMatchRepository}),
+			loser:  candidateFor("a", precedence{image: imageMatchGlob}),
+		},
+		{
+			name:   "pod selector on equal image match",
+			winner: candidateFor("b", precedence{image: imageMatchGlob, selector: true}),
+			loser:  candidateFor("a", precedence{image: imageMatchGlob}),
+		},
+		{
+			name:   "name on tie",
+			winner: candidateFor("a", precedence{image: imageMatchExact}),
+			loser:  candidateFor("b", precedence{image: imageMatchExact}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.True(t, tc.winner.wins(tc.loser))
+			require.False(t, tc.loser.wins(tc.winner))
+		})
+	}
+}
@@ -115,6 +115,11 @@ func (p *podSeccompRecorder) Handle(
 			continue
 		}
 
+		if item.IsFinished() && req.Operation != admissionv1.Delete {
+			// Finished recordings do not record new workloads
+			continue
+		}
+
 		selector, err := p.impl.LabelSelectorAsSelector(
 			&item.Spec.PodSelector,
 		)
@@ -320,6 +325,9 @@ func (p *podSeccompRecorder) setActiveWorkloads(
 	}
 
 	profileRecording.Status.ActiveWorkloads = newActiveWorkloads
+	if !profileRecording.IsFinished() {
+		profileRecording.Status.SetConditions(profilerecordingv1alpha1.RecordingCondition(len(newActiveWorkloads)))
+	}
 
 	return p.impl.UpdateResourceStatus(ctx, p.log, profileRecording, "profilerecording status")
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/armor2crd"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile/crd2armor"
+)
+
+// appArmorProfileValidator rejects AppArmorProfiles and RawAppArmorProfiles
+// which result in an invalid AppArmor profile, before they get installed on
+// the nodes.
+type appArmorProfileValidator struct {
+	decoder admission.Decoder
+	log     logr.Logger
+}
+
+//nolint:gocritic
+func (a *appArmorProfileValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	if req.Kind.Kind == "RawAppArmorProfile" {
+		return a.handleRaw(&req)
+	}
+	return a.handleProfile(&req)
+}
+
+func (a *appArmorProfileValidator) handleProfile(req *admission.Request) admission.Response {
+	profile := &apparmorprofileapi.AppArmorProfile{}
+	old := &apparmorprofileapi.AppArmorProfile{}
+	if res, ok := a.decode(req, profile, old); !ok {
+		return res
+	}
+	if skipValidation(req, profile, profile.Spec, old.Spec) {
+		return admission.Allowed("")
+	}
+
+	generated, err := crd2armor.GenerateProfile(
+		profile.GetProfileName(), profile.Spec.ComplainMode, &profile.Spec.Abstract,
+	)
+	if err != nil {
+		return admission.Denied("cannot generate apparmor profile: " + err.Error())
+	}
+	if err := armor2crd.Validate(generated); err != nil {
+		return admission.Denied("invalid apparmor profile: " + err.Error())
+	}
+	return admission.Allowed("")
+}
+
+func (a *appArmorProfileValidator) handleRaw(req *admission.Request) admission.Response {
+	profile := &apparmorprofileapi.RawAppArmorProfile{}
+	old := &apparmorprofileapi.RawAppArmorProfile{}
+	if res, ok := a.decode(req, profile, old); !ok {
+		return res
+	}
+	if skipValidation(req, profile, profile.Spec, old.Spec) {
+		return admission.Allowed("")
+	}
+
+	if err := armor2crd.ValidateProfile(profile.Spec.Policy, profile.GetProfileName()); err != nil {
+		return admission.Denied("invalid apparmor profile: " + err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// decode decodes the object of the request and the old object on updates.
+func (a *appArmorProfileValidator) decode(
+	req *admission.Request, obj, old runtime.Object,
+) (admission.Response, bool) {
+	if err := a.decoder.Decode(*req, obj); err != nil {
+		a.log.Error(err, "failed to decode apparmor profile")
+		return admission.Errored(http.StatusBadRequest, err), false
+	}
+	if req.Operation != admissionv1.Update {
+		return admission.Response{}, true
+	}
+	if err := a.decoder.DecodeRaw(req.OldObject, old); err != nil {
+		a.log.Error(err, "failed to decode old apparmor profile")
+		return admission.Errored(http.StatusBadRequest, err), false
+	}
+	return admission.Response{}, true
+}
+
+// skipValidation returns true if the profile is being deleted or the update
+// does not change its spec. This allows to remove finalizers from or update
+// the status of profiles which got invalid by a stricter validation.
+func skipValidation(req *admission.Request, obj metav1.Object, spec, oldSpec any) bool {
+	if obj.GetDeletionTimestamp() != nil {
+		return true
+	}
+	return req.Operation == admissionv1.Update && equality.Semantic.DeepEqual(spec, oldSpec)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+func TestAppArmorProfileHandle(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, apparmorprofileapi.AddToScheme(scheme))
+
+	profile := func(deleting bool, capabilities ...string) []byte {
+		p := &apparmorprofileapi.AppArmorProfile{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apparmorprofileapi.GroupVersion.String(),
+				Kind:       "AppArmorProfile",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec: apparmorprofileapi.AppArmorProfileSpec{
+				Abstract: apparmorprofileapi.AppArmorAbstract{
+					Capability: &apparmorprofileapi.AppArmorCapabilityRules{AllowedCapabilities: capabilities},
+				},
+			},
+		}
+		if deleting {
+			now := metav1.Now()
+			p.DeletionTimestamp = &now
+			p.Finalizers = []string{"test"}
+		}
+		b, err := json.Marshal(p)
+		require.NoError(t, err)
+		return b
+	}
+
+	rawProfile := func(policy string) []byte {
+		b, err := json.Marshal(&apparmorprofileapi.RawAppArmorProfile{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apparmorprofileapi.GroupVersion.String(),
+				Kind:       "RawAppArmorProfile",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       apparmorprofileapi.RawAppArmorProfileSpec{Policy: policy},
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	for _, tc := range []struct {
+		name      string
+		operation admissionv1.Operation
+		kind      string
+		object    []byte
+		oldObject []byte
+		allowed   bool
+		code      int32
+		reason    string
+	}{
+		{
+			name:      "valid profile",
+			operation: admissionv1.Create,
+			object:    profile(false, "net_bind_service"),
+			allowed:   true,
+		},
+		{
+			name:      "invalid profile",
+			operation: admissionv1.Update,
+			object:    profile(false, "CAP_NET_BIND_SERVICE"),
+			oldObject: profile(false, "net_bind_service"),
+			reason:    `unknown capability "CAP_NET_BIND_SERVICE"`,
+		},
+		{
+			name:      "unchanged invalid profile",
+			operation: admissionv1.Update,
+			object:    profile(false, "CAP_NET_BIND_SERVICE"),
+			oldObject: profile(false, "CAP_NET_BIND_SERVICE"),
+			allowed:   true,
+		},
+		{
+			name:      "deleted invalid profile",
+			operation: admissionv1.Update,
+			object:    profile(true, "CAP_NET_BIND_SERVICE"),
+			oldObject: profile(false, "net_bind_service"),
+			allowed:   true,
+		},
+		{
+			name:      "valid raw profile",
+			operation: admissionv1.Create,
+			kind:      "RawAppArmorProfile",
+			object:    rawProfile("profile test {\n  file,\n}\n"),
+			allowed:   true,
+		},
+		{
+			name:      "invalid raw profile",
+			operation: admissionv1.Update,
+			kind:      "RawAppArmorProfile",
+			object:    rawProfile("profile test {\n  /etc/test rz,\n}\n"),
+			oldObject: rawProfile("profile test {\n  file,\n}\n"),
+			reason:    `invalid permission 'z' in "rz"`,
+		},
+		{
+			name:      "raw profile with other name",
+			operation: admissionv1.Create,
+			kind:      "RawAppArmorProfile",
+			object:    rawProfile("profile other {\n}\n"),
+			reason:    `policy defines profile "other" instead of "test"`,
+		},
+		{
+			name:      "unchanged invalid raw profile",
+			operation: admissionv1.Update,
+			kind:      "RawAppArmorProfile",
+			object:    rawProfile("profile other {\n}\n"),
+			oldObject: rawProfile("profile other {\n}\n"),
+			allowed:   true,
+		},
+		{
+			name:      "delete",
+			operation: admissionv1.Delete,
+			allowed:   true,
+		},
+		{
+			name:      "decode error",
+			operation: admissionv1.Create,
+			object:    []byte("{"),
+			code:      http.StatusBadRequest,
+		},
+		{
+			name:      "decode error of old object",
+			operation: admissionv1.Update,
+			object:    profile(false, "net_bind_service"),
+			oldObject: []byte("{"),
+			code:      http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := &appArmorProfileValidator{decoder: admission.NewDecoder(scheme), log: logr.Discard()}
+			res := sut.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Kind:      metav1.GroupVersionKind{Kind: tc.kind},
+					Object:    runtime.RawExtension{Raw: tc.object},
+					OldObject: runtime.RawExtension{Raw: tc.oldObject},
+				},
+			})
+
+			require.Equal(t, tc.allowed, res.Allowed)
+			if tc.code != 0 {
+				require.Equal(t, tc.code, res.Result.Code)
+			}
+			if tc.reason != "" {
+				require.Contains(t, res.Result.Message, tc.reason)
+			}
+		})
+	}
+}
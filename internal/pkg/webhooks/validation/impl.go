@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+type defaultImpl struct {
+	client  client.Client
+	decoder admission.Decoder
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	DecodePod(admission.Request) (*corev1.Pod, error)
+	GetSPOd(context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
+	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
+	GetSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.SelinuxProfile, error)
+	GetRawSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.RawSelinuxProfile, error)
+	ListAppArmorProfiles(context.Context) (*apparmorprofileapi.AppArmorProfileList, error)
+	ListRawAppArmorProfiles(context.Context) (*apparmorprofileapi.RawAppArmorProfileList, error)
+	ListNodeStatuses(context.Context, client.Object) (*secprofnodestatusv1alpha1.SecurityProfileNodeStatusList, error)
+}
+
+//nolint:gocritic
+func (d *defaultImpl) DecodePod(req admission.Request) (*corev1.Pod, error) {
+	pod := &corev1.Pod{}
+	if err := d.decoder.Decode(req, pod); err != nil {
+		return nil, fmt.Errorf("decode pod: %w", err)
+	}
+	return pod, nil
+}
+
+func (d *defaultImpl) GetSPOd(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	key := types.NamespacedName{Name: config.SPOdName, Namespace: config.GetOperatorNamespace()}
+	if err := d.client.Get(ctx, key, spod); err != nil {
+		return nil, fmt.Errorf("get spod: %w", err)
+	}
+	return spod, nil
+}
+
+func (d *defaultImpl) GetSeccompProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*seccompprofileapi.SeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	if err := d.client.Get(ctx, key, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (d *defaultImpl) GetSelinuxProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*selinuxprofileapi.SelinuxProfile, error) {
+	selinuxProfile := &selinuxprofileapi.SelinuxProfile{}
+	if err := d.client.Get(ctx, key, selinuxProfile); err != nil {
+		return nil, fmt.Errorf("get selinux profile: %w", err)
+	}
+	return selinuxProfile, nil
+}
+
+func (d *defaultImpl) GetRawSelinuxProfile(
+	ctx context.Context, key types.NamespacedName,
+) (*selinuxprofileapi.RawSelinuxProfile, error) {
+	rawSelinuxProfile := &selinuxprofileapi.RawSelinuxProfile{}
+	if err := d.client.Get(ctx, key, rawSelinuxProfile); err != nil {
+		return nil, fmt.Errorf("get raw selinux profile: %w", err)
+	}
+	return rawSelinuxProfile, nil
+}
+
+func (d *defaultImpl) ListAppArmorProfiles(ctx context.Context) (*apparmorprofileapi.AppArmorProfileList, error) {
+	appArmorProfiles := &apparmorprofileapi.AppArmorProfileList{}
+	if err := d.client.List(ctx, appArmorProfiles); err != nil {
+		return nil, fmt.Errorf("list apparmor profiles: %w", err)
+	}
+	return appArmorProfiles, nil
+}
+
+func (d *defaultImpl) ListRawAppArmorProfiles(
+	ctx context.Context,
+) (*apparmorprofileapi.RawAppArmorProfileList, error) {
+	rawAppArmorProfiles := &apparmorprofileapi.RawAppArmorProfileList{}
+	if err := d.client.List(ctx, rawAppArmorProfiles); err != nil {
+		return nil, fmt.Errorf("list raw apparmor profiles: %w", err)
+	}
+	return rawAppArmorProfiles, nil
+}
+
+func (d *defaultImpl) ListNodeStatuses(
+	ctx context.Context, prof client.Object,
+) (*secprofnodestatusv1alpha1.SecurityProfileNodeStatusList, error) {
+	// The node statuses are labeled by the kind of the profile, which is not
+	// always set on objects returned by the client.
+	gvk, err := apiutil.GVKForObject(prof, d.client.Scheme())
+	if err != nil {
+		return nil, fmt.Errorf("get profile kind: %w", err)
+	}
+	prof.GetObjectKind().SetGroupVersionKind(gvk)
+
+	nodeStatuses := &secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := d.client.List(ctx, nodeStatuses,
+		client.InNamespace(prof.GetNamespace()),
+		client.MatchingLabels{
+			secprofnodestatusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(prof),
+		},
+	); err != nil {
+		return nil, fmt.Errorf("list node statuses: %w", err)
+	}
+	return nodeStatuses, nil
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	// webhookName is the name of the webhook in the validating webhook
+	// configuration and in the SPOD webhook options.
+	webhookName = "profile-validation.spo.io"
+
+	kindSeccomp  = "seccomp"
+	kindSelinux  = "selinux"
+	kindAppArmor = "apparmor"
+
+	selinuxUsageSuffix = ".process"
+	localhostPrefix    = "localhost/"
+)
+
+// profile is a security profile which can be referenced by pods.
+type profile interface {
+	profilebasev1alpha1.SecurityProfileBase
+	GetStatusBase() *profilebasev1alpha1.StatusBase
+}
+
+// reference is a profile referenced by a pod.
+type reference struct {
+	kind string
+	name string
+	// users are the pod and containers referencing the profile.
+	users []string
+}
+
+type podValidator struct {
+	impl
+	log logr.Logger
+}
+
+func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+	server.Register(
+		"/validate-v1-pod-profiles",
+		&webhook.Admission{
+			Handler: &podValidator{
+				impl: &defaultImpl{
+					client:  c,
+					decoder: admission.NewDecoder(scheme),
+				},
+				log: logf.Log.WithName("validation"),
+			},
+		},
+	)
+	server.Register(
+		"/validate-v1alpha1-apparmorprofile",
+		&webhook.Admission{
+			Handler: &appArmorProfileValidator{
+				decoder: admission.NewDecoder(scheme),
+				log:     logf.Log.WithName("apparmorprofile-validation"),
+			},
+		},
+	)
+}
+
+// Security Profiles Operator Webhook RBAC permissions
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawapparmorprofiles,verbs=get;list;watch
+
+//nolint:gocritic
+func (p *podValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != "CREATE" {
+		return admission.Allowed("")
+	}
+
+	pod, err := p.DecodePod(req)
+	if err != nil {
+		p.log.Error(err, "failed to decode pod")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	problems, err := p.validatePod(ctx, pod, req.Namespace)
+	if err != nil {
+		p.log.Error(err, "could not validate profiles of pod")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(problems) == 0 {
+		return admission.Allowed("")
+	}
+
+	if p.action(ctx) == spodv1alpha1.ValidationActionWarn {
+		return admission.Allowed("").WithWarnings(problems...)
+	}
+	return admission.Denied(strings.Join(problems, "; "))
+}
+
+// action returns the configured validation action, which defaults to deny.
+func (p *podValidator) action(ctx context.Context) spodv1alpha1.ValidationAction {
+	spod, err := p.GetSPOd(ctx)
+	if err != nil {
+		p.log.Error(err, "could not get validation action, denying pod")
+		return spodv1alpha1.ValidationActionDeny
+	}
+	for i := range spod.Spec.WebhookOpts {
+		opt := &spod.Spec.WebhookOpts[i]
+		if opt.Name == webhookName && opt.ValidationAction != nil {
+			return *opt.ValidationAction
+		}
+	}
+	return spodv1alpha1.ValidationActionDeny
+}
+
+// validatePod returns the problems of the operator profiles referenced by the
+// pod.
+func (p *podValidator) validatePod(ctx context.Context, pod *corev1.Pod, namespace string) ([]string, error) {
+	problems := []string{}
+	for _, ref := range podReferences(pod) {
+		var (
+			prof profile
+			err  error
+		)
+		switch ref.kind {
+		case kindSeccomp:
+			prof, err = p.seccompProfile(ctx, ref.name)
+		case kindSelinux:
+			prof, err = p.selinuxProfile(ctx, ref.name)
+		case kindAppArmor:
+			prof, err = p.appArmorProfile(ctx, ref.name, namespace)
+		}
+		if err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		problem, err := p.validateProfile(ctx, prof, err == nil)
+		if err != nil {
+			return nil, err
+		}
+		if problem != "" {
+			problems = append(problems, fmt.Sprintf(
+				"%s profile %s used by %s %s", ref.kind, ref.name, strings.Join(ref.users, ", "), problem,
+			))
+		}
+	}
+	return problems, nil
+}
+
+// validateProfile returns why the profile cannot be used by pods or an empty
+// string if it is ready.
+func (p *podValidator) validateProfile(ctx context.Context, prof profile, found bool) (string, error) {
+	if !found {
+		return "does not exist", nil
+	}
+	if prof == nil {
+		// Not managed by the operator
+		return "", nil
+	}
+	if prof.IsDisabled() {
+		return "is disabled", nil
+	}
+	if prof.IsPartial() {
+		return "is a partial profile which has not been merged yet", nil
+	}
+
+	nodeStatuses, err := p.ListNodeStatuses(ctx, prof)
+	if err != nil {
+		return "", err
+	}
+	nodes := []string{}
+	for i := range nodeStatuses.Items {
+		status := &nodeStatuses.Items[i]
+		if status.Status != secprofnodestatusv1alpha1.ProfileStateInstalled {
+			nodes = append(nodes, status.NodeName)
+		}
+	}
+	slices.Sort(nodes)
+
+	state := prof.GetStatusBase().Status
+	if len(nodes) > 0 {
+		return fmt.Sprintf("is not installed on nodes %s", strings.Join(nodes, ", ")), nil
+	}
+	if state != secprofnodestatusv1alpha1.ProfileStateInstalled {
+		if state == "" {
+			state = secprofnodestatusv1alpha1.ProfileStatePending
+		}
+		return fmt.Sprintf("is not installed (state: %s)", state), nil
+	}
+	return "", nil
+}
+
+// seccompProfile returns the seccomp profile of a localhost profile path in
+// the "operator/<namespace>/<name>.json" format.
+func (p *podValidator) seccompProfile(ctx context.Context, localhostProfile string) (profile, error) {
+	parts := strings.Split(localhostProfile, "/")
+	key := types.NamespacedName{Namespace: parts[1], Name: strings.TrimSuffix(parts[2], seccompprofileapi.ExtJSON)}
+	sp, err := p.GetSeccompProfile(ctx, key)
+	if kerrors.IsNotFound(err) && key.Name != parts[2] {
+		// The profile name may contain the extension already
+		key.Name = parts[2]
+		sp, err = p.GetSeccompProfile(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// selinuxProfile returns the SELinux profile of a SELinux type in the
+// "<name>_<namespace>.process" format. It returns nil if no profile of the
+// operator matches, because the type may be provided by the nodes.
+func (p *podValidator) selinuxProfile(ctx context.Context, usage string) (profile, error) {
+	policy := strings.TrimSuffix(usage, selinuxUsageSuffix)
+	i := strings.LastIndex(policy, "_")
+	key := types.NamespacedName{Namespace: policy[i+1:], Name: policy[:i]}
+
+	sp, err := p.GetSelinuxProfile(ctx, key)
+	if err == nil {
+		return sp, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	rsp, err := p.GetRawSelinuxProfile(ctx, key)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// appArmorProfile returns the AppArmor or raw AppArmor profile with the name,
+// preferring the one in the pod namespace. It returns nil if no profile of the
+// operator has the name, because the profile may be provided by the nodes.
+func (p *podValidator) appArmorProfile(ctx context.Context, name, namespace string) (profile, error) {
+	appArmorProfiles, err := p.ListAppArmorProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rawAppArmorProfiles, err := p.ListRawAppArmorProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []profile{}
+	for i := range appArmorProfiles.Items {
+		if aa := &appArmorProfiles.Items[i]; aa.GetProfileName() == name {
+			candidates = append(candidates, aa)
+		}
+	}
+	for i := range rawAppArmorProfiles.Items {
+		if raa := &rawAppArmorProfiles.Items[i]; raa.GetProfileName() == name {
+			candidates = append(candidates, raa)
+		}
+	}
+
+	var res profile
+	for _, candidate := range candidates {
+		if res == nil || candidate.GetNamespace() == namespace {
+			res = candidate
+		}
+	}
+	return res, nil
+}
+
+// podReferences returns the operator profiles referenced by the pod and its
+// containers.
+func podReferences(pod *corev1.Pod) []*reference {
+	refs := []*reference{}
+	add := func(kind, name, user string) {
+		for _, ref := range refs {
+			if ref.kind == kind && ref.name == name {
+				if !slices.Contains(ref.users, user) {
+					ref.users = append(ref.users, user)
+				}
+				return
+			}
+		}
+		refs = append(refs, &reference{kind: kind, name: name, users: []string{user}})
+	}
+
+	addSecurityContext := func(
+		user string, seccomp *corev1.SeccompProfile, selinux *corev1.SELinuxOptions, apparmor *corev1.AppArmorProfile,
+	) {
+		if name := seccompReference(seccomp); name != "" {
+			add(kindSeccomp, name, user)
+		}
+		if name := selinuxReference(selinux); name != "" {
+			add(kindSelinux, name, user)
+		}
+		if apparmor != nil && apparmor.Type == corev1.AppArmorProfileTypeLocalhost &&
+			apparmor.LocalhostProfile != nil && *apparmor.LocalhostProfile != "" {
+			add(kindAppArmor, *apparmor.LocalhostProfile, user)
+		}
+	}
+
+	if sc := pod.Spec.SecurityContext; sc != nil {
+		addSecurityContext("pod", sc.SeccompProfile, sc.SELinuxOptions, sc.AppArmorProfile)
+	}
+
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			c := &containers[i]
+			user := "container " + c.Name
+			if sc := c.SecurityContext; sc != nil {
+				addSecurityContext(user, sc.SeccompProfile, sc.SELinuxOptions, sc.AppArmorProfile)
+			}
+			annotation := corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix + c.Name
+			if value := pod.Annotations[annotation]; strings.HasPrefix(value, localhostPrefix) {
+				add(kindAppArmor, strings.TrimPrefix(value, localhostPrefix), user)
+			}
+		}
+	}
+
+	return refs
+}
+
+// seccompReference returns the localhost profile if it is managed by the
+// operator.
+func seccompReference(seccomp *corev1.SeccompProfile) string {
+	if seccomp == nil || seccomp.Type != corev1.SeccompProfileTypeLocalhost || seccomp.LocalhostProfile == nil {
+		return ""
+	}
+	localhostProfile := *seccomp.LocalhostProfile
+	parts := strings.Split(path.Clean(localhostProfile), "/")
+	const operatorPathParts = 3
+	if len(parts) != operatorPathParts || parts[0] != config.OperatorProfilesFolder {
+		return ""
+	}
+	return path.Clean(localhostProfile)
+}
+
+// selinuxReference returns the SELinux type if it has the format of the
+// operator managed types. Whether a profile exists for it is resolved by
+// selinuxProfile.
+func selinuxReference(selinux *corev1.SELinuxOptions) string {
+	if selinux == nil || !strings.HasSuffix(selinux.Type, selinuxUsageSuffix) {
+		return ""
+	}
+	policy := strings.TrimSuffix(selinux.Type, selinuxUsageSuffix)
+	if i := strings.LastIndex(policy, "_"); i <= 0 || i == len(policy)-1 {
+		return ""
+	}
+	return selinux.Type
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/validation/validationfakes"
+)
+
+var errTest = errors.New("error")
+
+func seccompPod(localhostProfile string) *corev1.Pod {
+	return &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "web",
+				SecurityContext: &corev1.SecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{
+						Type:             corev1.SeccompProfileTypeLocalhost,
+						LocalhostProfile: &localhostProfile,
+					},
+				},
+			}},
+		},
+	}
+}
+
+func seccompProfile(state secprofnodestatusv1alpha1.ProfileState) *seccompprofileapi.SeccompProfile {
+	return &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "team-a"},
+		Status: seccompprofileapi.SeccompProfileStatus{
+			StatusBase: profilebasev1alpha1.StatusBase{Status: state},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "")
+	for _, tc := range []struct {
+		name    string
+		prepare func(*validationfakes.FakeImpl)
+		assert  func(admission.Response, *validationfakes.FakeImpl)
+	}{
+		{
+			name: "installed seccomp profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(seccompProfile(secprofnodestatusv1alpha1.ProfileStateInstalled), nil)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Empty(t, resp.Warnings)
+				_, key := mock.GetSeccompProfileArgsForCall(0)
+				require.Equal(t, types.NamespacedName{Namespace: "team-a", Name: "profile"}, key)
+			},
+		},
+		{
+			name: "missing seccomp profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(nil, notFound)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t,
+					"seccomp profile operator/team-a/profile.json used by container web does not exist",
+					resp.Result.Message)
+				require.Equal(t, 2, mock.GetSeccompProfileCallCount())
+				_, key := mock.GetSeccompProfileArgsForCall(1)
+				require.Equal(t, "profile.json", key.Name)
+			},
+		},
+		{
+			name: "seccomp profile not managed by the operator",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("profiles/audit.json"), nil)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Zero(t, mock.GetSeccompProfileCallCount())
+			},
+		},
+		{
+			name: "disabled seccomp profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				sp := seccompProfile(secprofnodestatusv1alpha1.ProfileStateInstalled)
+				sp.Spec.Disabled = true
+				mock.GetSeccompProfileReturns(sp, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Contains(t, resp.Result.Message, "is disabled")
+			},
+		},
+		{
+			name: "partial seccomp profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				sp := seccompProfile(secprofnodestatusv1alpha1.ProfileStatePartial)
+				sp.Labels = map[string]string{profilebasev1alpha1.ProfilePartialLabel: "true"}
+				mock.GetSeccompProfileReturns(sp, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Contains(t, resp.Result.Message, "is a partial profile")
+			},
+		},
+		{
+			name: "seccomp profile not installed on all nodes",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(seccompProfile(secprofnodestatusv1alpha1.ProfileStateInProgress), nil)
+				mock.ListNodeStatusesReturns(&secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{
+					Items: []secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
+						{NodeName: "node-2", Status: secprofnodestatusv1alpha1.ProfileStatePending},
+						{NodeName: "node-1", Status: secprofnodestatusv1alpha1.ProfileStateInstalled},
+						{NodeName: "node-0", Status: secprofnodestatusv1alpha1.ProfileStateError},
+					},
+				}, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t,
+					"seccomp profile operator/team-a/profile.json used by container web "+
+						"is not installed on nodes node-0, node-2",
+					resp.Result.Message)
+			},
+		},
+		{
+			name: "seccomp profile pending without node statuses",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(seccompProfile(""), nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Contains(t, resp.Result.Message, "is not installed (state: Pending)")
+			},
+		},
+		{
+			name: "warn action",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(nil, notFound)
+				mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{
+					Spec: spodv1alpha1.SPODSpec{
+						WebhookOpts: []spodv1alpha1.WebhookOptions{{
+							Name:             webhookName,
+							ValidationAction: ptr.To(spodv1alpha1.ValidationActionWarn),
+						}},
+					},
+				}, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, []string{
+					"seccomp profile operator/team-a/profile.json used by container web does not exist",
+				}, resp.Warnings)
+			},
+		},
+		{
+			name: "selinux profile falls back to raw profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					Spec: corev1.PodSpec{
+						SecurityContext: &corev1.PodSecurityContext{
+							SELinuxOptions: &corev1.SELinuxOptions{Type: "my_profile_team-a.process"},
+						},
+						Containers: []corev1.Container{{Name: "web"}},
+					},
+				}, nil)
+				mock.GetSelinuxProfileReturns(nil, notFound)
+				mock.GetRawSelinuxProfileReturns(&selinuxprofileapi.RawSelinuxProfile{
+					Status: selinuxprofileapi.SelinuxProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+					},
+				}, nil)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				_, key := mock.GetRawSelinuxProfileArgsForCall(0)
+				require.Equal(t, types.NamespacedName{Namespace: "team-a", Name: "my_profile"}, key)
+			},
+		},
+		{
+			name: "selinux type not managed by the operator",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					Spec: corev1.PodSpec{
+						SecurityContext: &corev1.PodSecurityContext{
+							SELinuxOptions: &corev1.SELinuxOptions{Type: "spc_host.process"},
+						},
+						Containers: []corev1.Container{{Name: "web"}},
+					},
+				}, nil)
+				mock.GetSelinuxProfileReturns(nil, notFound)
+				mock.GetRawSelinuxProfileReturns(nil, notFound)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Empty(t, resp.Warnings)
+				require.Zero(t, mock.ListNodeStatusesCallCount())
+			},
+		},
+		{
+			name: "apparmor profile in pod namespace",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix + "web": "localhost/profile",
+					}},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web"}}},
+				}, nil)
+				mock.ListAppArmorProfilesReturns(&apparmorprofileapi.AppArmorProfileList{
+					Items: []apparmorprofileapi.AppArmorProfile{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "other"},
+							Status: apparmorprofileapi.AppArmorProfileStatus{
+								StatusBase: profilebasev1alpha1.StatusBase{
+									Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "team-a"},
+							Status: apparmorprofileapi.AppArmorProfileStatus{
+								StatusBase: profilebasev1alpha1.StatusBase{
+									Status: secprofnodestatusv1alpha1.ProfileStateError,
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t,
+					"apparmor profile profile used by container web is not installed (state: Error)",
+					resp.Result.Message)
+			},
+		},
+		{
+			name: "raw apparmor profile",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name: "web",
+						SecurityContext: &corev1.SecurityContext{
+							AppArmorProfile: &corev1.AppArmorProfile{
+								Type:             corev1.AppArmorProfileTypeLocalhost,
+								LocalhostProfile: ptr.To("raw-profile"),
+							},
+						},
+					}}},
+				}, nil)
+				mock.ListRawAppArmorProfilesReturns(&apparmorprofileapi.RawAppArmorProfileList{
+					Items: []apparmorprofileapi.RawAppArmorProfile{{
+						ObjectMeta: metav1.ObjectMeta{Name: "raw-profile", Namespace: "team-a"},
+						Status: apparmorprofileapi.AppArmorProfileStatus{
+							StatusBase: profilebasev1alpha1.StatusBase{
+								Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+							},
+						},
+					}},
+				}, nil)
+			},
+			assert: func(resp admission.Response, mock *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, 1, mock.ListNodeStatusesCallCount())
+			},
+		},
+		{
+			name: "raw apparmor profile not installed",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix + "web": "localhost/raw-profile",
+					}},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web"}}},
+				}, nil)
+				mock.ListRawAppArmorProfilesReturns(&apparmorprofileapi.RawAppArmorProfileList{
+					Items: []apparmorprofileapi.RawAppArmorProfile{{
+						ObjectMeta: metav1.ObjectMeta{Name: "raw-profile", Namespace: "team-a"},
+					}},
+				}, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t,
+					"apparmor profile raw-profile used by container web is not installed (state: Pending)",
+					resp.Result.Message)
+			},
+		},
+		{
+			name: "apparmor profile provided by the nodes",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(&corev1.Pod{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name: "web",
+						SecurityContext: &corev1.SecurityContext{
+							AppArmorProfile: &corev1.AppArmorProfile{
+								Type:             corev1.AppArmorProfileTypeLocalhost,
+								LocalhostProfile: ptr.To("host-profile"),
+							},
+						},
+					}}},
+				}, nil)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.True(t, resp.Allowed)
+			},
+		},
+		{
+			name: "failure on profile lookup",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(seccompPod("operator/team-a/profile.json"), nil)
+				mock.GetSeccompProfileReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t, int32(http.StatusInternalServerError), resp.Result.Code)
+			},
+		},
+		{
+			name: "failure on decode",
+			prepare: func(mock *validationfakes.FakeImpl) {
+				mock.DecodePodReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response, _ *validationfakes.FakeImpl) {
+				require.False(t, resp.Allowed)
+				require.Equal(t, int32(http.StatusBadRequest), resp.Result.Code)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &validationfakes.FakeImpl{}
+			mock.GetSPOdReturns(&spodv1alpha1.SecurityProfilesOperatorDaemon{}, nil)
+			mock.ListNodeStatusesReturns(&secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{}, nil)
+			mock.ListAppArmorProfilesReturns(&apparmorprofileapi.AppArmorProfileList{}, nil)
+			mock.ListRawAppArmorProfilesReturns(&apparmorprofileapi.RawAppArmorProfileList{}, nil)
+			tc.prepare(mock)
+
+			validator := &podValidator{impl: mock, log: logr.Discard()}
+			resp := validator.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Namespace: "team-a",
+					Operation: admissionv1.Create,
+				},
+			})
+			tc.assert(resp, mock)
+		})
+	}
+}
+
+func TestPodReferences(t *testing.T) {
+	t.Parallel()
+
+	pod := seccompPod("operator/team-a/profile.json")
+	pod.Spec.SecurityContext = &corev1.PodSecurityContext{
+		SeccompProfile: &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: ptr.To("operator/team-a/profile.json"),
+		},
+		SELinuxOptions: &corev1.SELinuxOptions{Type: "container_t"},
+	}
+	pod.Spec.InitContainers = []corev1.Container{{
+		Name: "init",
+		SecurityContext: &corev1.SecurityContext{
+			SELinuxOptions: &corev1.SELinuxOptions{Type: "profile_team-a.process"},
+		},
+	}}
+
+	refs := podReferences(pod)
+	require.Equal(t, []*reference{
+		{kind: kindSeccomp, name: "operator/team-a/profile.json", users: []string{"pod", "container web"}},
+		{kind: kindSelinux, name: "profile_team-a.process", users: []string{"container init"}},
+	}, refs)
+}